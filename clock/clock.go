@@ -0,0 +1,148 @@
+// Package clock keeps track of the offset between the local clock and an
+// exchange's server clock, so request signatures carry the server's time.
+package clock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrUnsynced is returned when a timestamp cannot be signed: the offset is
+// unknown and the last measured offset exceeds the exchange's receive window
+var ErrUnsynced = errors.New("clock not synchronized with the exchange")
+
+// DefaultInterval is the default time between two offset measurements
+const DefaultInterval = time.Minute
+
+// ServerTimeFunc returns the exchange's current server time
+type ServerTimeFunc func(ctx context.Context) (time.Time, error)
+
+// Clock measures the offset to an exchange's server clock. The zero offset is
+// used until the first measurement. All methods are safe for concurrent use
+// and on a nil Clock, which uses the local clock.
+type Clock struct {
+	serverTime ServerTimeFunc
+	window     time.Duration
+	maxAge     time.Duration
+	now        func() time.Time
+
+	mu       sync.RWMutex
+	offset   time.Duration
+	rtt      time.Duration
+	syncedAt time.Time
+}
+
+// New creates a Clock that measures with serverTime. window is the exchange's
+// receive window: the largest difference between a request timestamp and the
+// server time the exchange accepts.
+func New(serverTime ServerTimeFunc, window time.Duration) *Clock {
+	return &Clock{
+		serverTime: serverTime,
+		window:     window,
+		maxAge:     3 * DefaultInterval,
+		now:        time.Now,
+	}
+}
+
+// Sync measures the offset once. The server time is assumed to be taken half
+// way through the round trip.
+func (c *Clock) Sync(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+	start := c.now()
+	server, err := c.serverTime(ctx)
+	if err != nil {
+		return fmt.Errorf("clock: server time: %w", err)
+	}
+	end := c.now()
+
+	rtt := end.Sub(start)
+	c.mu.Lock()
+	c.offset = server.Sub(start.Add(rtt / 2))
+	c.rtt = rtt
+	c.syncedAt = end
+	c.mu.Unlock()
+	return nil
+}
+
+// Run measures the offset immediately and then every interval until ctx is
+// done. Failed measurements keep the previous offset.
+func (c *Clock) Run(ctx context.Context, interval time.Duration) {
+	if c == nil || interval <= 0 {
+		return
+	}
+	c.mu.Lock()
+	c.maxAge = 3 * interval
+	c.mu.Unlock()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		_ = c.Sync(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Now returns the current server time estimated from the local clock
+func (c *Clock) Now() time.Time {
+	if c == nil {
+		return time.Now()
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.now().Add(c.offset)
+}
+
+// SignTime returns the server time to sign a request with. It fails with
+// ErrUnsynced when the offset is unknown, because it was never measured or
+// the last measurement is stale, and the last measured offset is larger than
+// the receive window, so the exchange would reject the timestamp anyway.
+func (c *Clock) SignTime() (time.Time, error) {
+	if c == nil {
+		return time.Now(), nil
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	now := c.now()
+	known := !c.syncedAt.IsZero() && now.Sub(c.syncedAt) <= c.maxAge
+	if !known && c.window > 0 && abs(c.offset) > c.window {
+		return time.Time{}, fmt.Errorf("clock: last offset %s exceeds the %s receive window: %w", c.offset, c.window, ErrUnsynced)
+	}
+	return now.Add(c.offset), nil
+}
+
+// Offset returns the measured server time minus the local time, and whether
+// it is known: measured and not stale
+func (c *Clock) Offset() (time.Duration, bool) {
+	if c == nil {
+		return 0, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.offset, !c.syncedAt.IsZero() && c.now().Sub(c.syncedAt) <= c.maxAge
+}
+
+// RTT returns the round-trip latency of the last measurement
+func (c *Clock) RTT() time.Duration {
+	if c == nil {
+		return 0
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.rtt
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,52 @@
+package clock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestClock_Sync(t *testing.T) {
+	local := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	origin := local
+	c := New(func(context.Context) (time.Time, error) {
+		// The server is 2s ahead and answers half way through a 200ms round trip
+		server := local.Add(2*time.Second + 100*time.Millisecond)
+		local = local.Add(200 * time.Millisecond)
+		return server, nil
+	}, time.Second)
+	c.now = func() time.Time { return local }
+
+	if _, known := c.Offset(); known {
+		t.Fatal("Offset() known before the first Sync")
+	}
+	if _, err := c.SignTime(); err != nil {
+		t.Fatalf("SignTime() before the first Sync error = %v", err)
+	}
+
+	if err := c.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	offset, known := c.Offset()
+	if !known || offset != 2*time.Second {
+		t.Errorf("Offset() = %s, %v, expected 2s, true", offset, known)
+	}
+	if rtt := c.RTT(); rtt != 200*time.Millisecond {
+		t.Errorf("RTT() = %s, expected 200ms", rtt)
+	}
+	if got, err := c.SignTime(); err != nil || !got.Equal(local.Add(2*time.Second)) {
+		t.Errorf("SignTime() = %s, %v, expected %s", got, err, local.Add(2*time.Second))
+	}
+
+	// A stale offset larger than the receive window refuses to sign
+	local = origin.Add(time.Hour)
+	if _, err := c.SignTime(); !errors.Is(err, ErrUnsynced) {
+		t.Errorf("SignTime() with stale offset error = %v, expected ErrUnsynced", err)
+	}
+
+	var nilClock *Clock
+	if _, err := nilClock.SignTime(); err != nil {
+		t.Errorf("nil Clock SignTime() error = %v", err)
+	}
+}
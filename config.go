@@ -1,5 +1,7 @@
 package exc
 
+import "github.com/djpken/go-exc/options"
+
 // Config contains configuration for connecting to an exchange
 type Config struct {
 	// APIKey is the API key for authentication
@@ -28,3 +30,29 @@ const (
 	BingX       ExchangeType = "BING-X"
 	BingXTest   ExchangeType = "BING-X-TEST"
 )
+
+// Option configures the HTTP and WebSocket transport of an exchange client.
+// Options apply uniformly to the REST and WebSocket clients of every exchange.
+//
+// Usage:
+//
+//	client, _ := exc.NewExchange(ctx, exc.OKX, cfg,
+//		exc.WithHTTPClient(&http.Client{Transport: proxyTransport}),
+//		exc.WithUserAgent("my-bot/1.0"),
+//	)
+type Option = options.Option
+
+// Transport options
+var (
+	WithHTTPClient  = options.WithHTTPClient
+	WithBaseURL     = options.WithBaseURL
+	WithWSURL       = options.WithWSURL
+	WithDialer      = options.WithDialer
+	WithUserAgent   = options.WithUserAgent
+	WithRateLimiter = options.WithRateLimiter
+	WithRetryPolicy = options.WithRetryPolicy
+	WithClockSync   = options.WithClockSync
+	WithLogger      = options.WithLogger
+	WithMiddleware  = options.WithMiddleware
+	WithMetrics     = options.WithMetrics
+)
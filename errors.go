@@ -20,19 +20,32 @@ var (
 	ErrAlreadyConnected = errors.New("already connected")
 
 	// ErrInvalidSymbol is returned when an invalid symbol is specified
-	ErrInvalidSymbol = errors.New("invalid symbol")
+	// This is an alias to types.ErrInvalidSymbol, which exchange errors wrap
+	ErrInvalidSymbol = types.ErrInvalidSymbol
 
 	// ErrInvalidOrder is returned when an invalid order is specified
-	ErrInvalidOrder = errors.New("invalid order")
+	// This is an alias to types.ErrInvalidOrder, which exchange errors wrap
+	ErrInvalidOrder = types.ErrInvalidOrder
 
 	// ErrOrderNotFound is returned when an order is not found
-	ErrOrderNotFound = errors.New("order not found")
+	// This is an alias to types.ErrOrderNotFound, which exchange errors wrap
+	ErrOrderNotFound = types.ErrOrderNotFound
 
 	// ErrInsufficientBalance is returned when there is insufficient balance
-	ErrInsufficientBalance = errors.New("insufficient balance")
+	// This is an alias to types.ErrInsufficientBalance, which exchange errors wrap
+	ErrInsufficientBalance = types.ErrInsufficientBalance
+
+	// ErrAuthentication is returned when the exchange rejects the API credentials
+	// This is an alias to types.ErrAuthentication, which exchange errors wrap
+	ErrAuthentication = types.ErrAuthentication
+
+	// ErrExchangeUnavailable is returned when the exchange is busy or under maintenance
+	// This is an alias to types.ErrExchangeUnavailable, which exchange errors wrap
+	ErrExchangeUnavailable = types.ErrExchangeUnavailable
 
 	// ErrRateLimitExceeded is returned when rate limit is exceeded
-	ErrRateLimitExceeded = errors.New("rate limit exceeded")
+	// This is an alias to types.ErrRateLimitExceeded, returned by the REST rate limiters
+	ErrRateLimitExceeded = types.ErrRateLimitExceeded
 
 	// ErrNotImplemented is returned when a feature is not implemented
 	ErrNotImplemented = errors.New("not implemented")
@@ -42,6 +55,13 @@ var (
 	ErrNotSupported = types.ErrNotSupported
 )
 
+// APIError is the structured error returned by the exchange adapters for
+// exchange error codes. It wraps the common error its code maps to.
+type APIError = types.APIError
+
+// IsRetryable reports whether err may succeed when the request is sent again
+var IsRetryable = types.IsRetryable
+
 // Error represents an exchange API error
 type Error struct {
 	// Code is the error code from the exchange
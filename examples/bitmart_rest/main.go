@@ -20,7 +20,7 @@ func main() {
 	ctx := context.Background()
 
 	// Create BitMart client
-	client, err := bitmart.NewClient(ctx, apiKey, secretKey, memo)
+	client, err := bitmart.NewClient(ctx, apiKey, secretKey, memo, false)
 	if err != nil {
 		log.Fatalf("Failed to create client: %v", err)
 	}
@@ -34,7 +34,7 @@ func main() {
 
 	// Example 1: Get Single Ticker
 	log.Println("\n--- Example 1: Get Ticker for BTC_USDT ---")
-	ticker, err := client.Rest.Market.GetTicker(marketreq.GetTickerRequest{
+	ticker, err := client.Rest.Market.GetTicker(ctx, marketreq.GetTickerRequest{
 		Symbol: "BTC_USDT",
 	})
 	if err != nil {
@@ -49,7 +49,7 @@ func main() {
 
 	// Example 2: Get All Tickers
 	log.Println("\n--- Example 2: Get All Tickers ---")
-	tickers, err := client.Rest.Market.GetTickers()
+	tickers, err := client.Rest.Market.GetTickers(ctx)
 	if err != nil {
 		log.Printf("Error getting tickers: %v", err)
 	} else {
@@ -68,7 +68,7 @@ func main() {
 
 	// Example 3: Get Order Book
 	log.Println("\n--- Example 3: Get Order Book for BTC_USDT ---")
-	orderBook, err := client.Rest.Market.GetOrderBook(marketreq.GetOrderBookRequest{
+	orderBook, err := client.Rest.Market.GetOrderBook(ctx, marketreq.GetOrderBookRequest{
 		Symbol: "BTC_USDT",
 		Depth:  20, // Top 20 levels
 	})
@@ -89,7 +89,7 @@ func main() {
 
 	// Example 4: Get Recent Trades
 	log.Println("\n--- Example 4: Get Recent Trades for BTC_USDT ---")
-	trades, err := client.Rest.Market.GetTrades(marketreq.GetTradesRequest{
+	trades, err := client.Rest.Market.GetTrades(ctx, marketreq.GetTradesRequest{
 		Symbol: "BTC_USDT",
 		Limit:  10,
 	})
@@ -110,7 +110,7 @@ func main() {
 
 	// Example 5: Get Kline/Candlestick Data
 	log.Println("\n--- Example 5: Get Kline Data for BTC_USDT ---")
-	klines, err := client.Rest.Market.GetKlines(marketreq.GetKlineRequest{
+	klines, err := client.Rest.Market.GetKlines(ctx, marketreq.GetKlineRequest{
 		Symbol: "BTC_USDT",
 		Step:   60, // 1 hour
 		Limit:  10,
@@ -133,7 +133,7 @@ func main() {
 
 	// Example 6: Get Trading Symbols
 	log.Println("\n--- Example 6: Get Available Trading Symbols ---")
-	symbols, err := client.Rest.Market.GetSymbols()
+	symbols, err := client.Rest.Market.GetSymbols(ctx)
 	if err != nil {
 		log.Printf("Error getting symbols: %v", err)
 	} else {
@@ -153,7 +153,7 @@ func main() {
 
 	// Example 7: Get Account Balance
 	log.Println("\n--- Example 7: Get Account Balance ---")
-	balance, err := client.Rest.Account.GetBalance(accountreq.GetBalanceRequest{})
+	balance, err := client.Rest.Account.GetWalletBalance(ctx, accountreq.GetWalletBalanceRequest{})
 	if err != nil {
 		log.Printf("Error getting balance: %v", err)
 	} else {
@@ -162,24 +162,21 @@ func main() {
 			if i >= 5 {
 				break
 			}
-			log.Printf("  %s: Available=%s, Frozen=%s, Total=%s",
-				bal.Currency, bal.Available, bal.Frozen, bal.Total)
+			log.Printf("  %s: Available=%s, Frozen=%s, Unavailable=%s",
+				bal.Currency, bal.Available, bal.Frozen, bal.UnAvailable)
 		}
 	}
 
-	// Example 8: Get Wallet Balance (by type)
-	log.Println("\n--- Example 8: Get Spot Wallet Balance ---")
-	walletBalance, err := client.Rest.Account.GetWalletBalance(accountreq.GetWalletBalanceRequest{
-		WalletType: "spot",
+	// Example 8: Get Wallet Balance (single currency)
+	log.Println("\n--- Example 8: Get USDT Wallet Balance ---")
+	walletBalance, err := client.Rest.Account.GetWalletBalance(ctx, accountreq.GetWalletBalanceRequest{
+		Currency: "USDT",
 	})
 	if err != nil {
 		log.Printf("Error getting wallet balance: %v", err)
 	} else {
-		log.Printf("Code: %d, Wallet Type: %s", walletBalance.Code, walletBalance.Data.WalletType)
-		for i, bal := range walletBalance.Data.Balances {
-			if i >= 3 {
-				break
-			}
+		log.Printf("Code: %d, Message: %s", walletBalance.Code, walletBalance.Message)
+		for _, bal := range walletBalance.Data.Wallet {
 			log.Printf("  %s: Available=%s", bal.Currency, bal.Available)
 		}
 	}
@@ -190,7 +187,7 @@ func main() {
 
 	// Example 9: Place Limit Order (Demo - will fail without valid credentials)
 	log.Println("\n--- Example 9: Place Limit Order (Demo) ---")
-	orderResp, err := client.Rest.Trade.PlaceOrder(tradereq.PlaceOrderRequest{
+	orderResp, err := client.Rest.Trade.PlaceOrder(ctx, tradereq.PlaceOrderRequest{
 		Symbol: "BTC_USDT",
 		Side:   "buy",
 		Type:   "limit",
@@ -205,7 +202,7 @@ func main() {
 
 	// Example 10: Get Order Details
 	log.Println("\n--- Example 10: Get Order Details (Demo) ---")
-	orderDetail, err := client.Rest.Trade.GetOrder(tradereq.GetOrderRequest{
+	orderDetail, err := client.Rest.Trade.GetOrder(ctx, tradereq.GetOrderRequest{
 		OrderID: "12345678", // Example order ID
 	})
 	if err != nil {
@@ -217,7 +214,7 @@ func main() {
 
 	// Example 11: Get Orders List
 	log.Println("\n--- Example 11: Get Orders List (Demo) ---")
-	orders, err := client.Rest.Trade.GetOrders(tradereq.GetOrdersRequest{
+	orders, err := client.Rest.Trade.GetOrders(ctx, tradereq.GetOrdersRequest{
 		Symbol: "BTC_USDT",
 		Status: "new",
 		Limit:  10,
@@ -230,7 +227,7 @@ func main() {
 
 	// Example 12: Cancel Order
 	log.Println("\n--- Example 12: Cancel Order (Demo) ---")
-	cancelResp, err := client.Rest.Trade.CancelOrder(tradereq.CancelOrderRequest{
+	cancelResp, err := client.Rest.Trade.CancelOrder(ctx, tradereq.CancelOrderRequest{
 		Symbol:  "BTC_USDT",
 		OrderID: "12345678",
 	})
@@ -246,7 +243,7 @@ func main() {
 
 	// Example 13: Get Deposit Address
 	log.Println("\n--- Example 13: Get Deposit Address (Demo) ---")
-	depositAddr, err := client.Rest.Funding.GetDepositAddress(fundingreq.GetDepositAddressRequest{
+	depositAddr, err := client.Rest.Funding.GetDepositAddress(ctx, fundingreq.GetDepositAddressRequest{
 		Currency: "USDT",
 		Chain:    "TRC20",
 	})
@@ -259,7 +256,7 @@ func main() {
 
 	// Example 14: Get Deposit History
 	log.Println("\n--- Example 14: Get Deposit History (Demo) ---")
-	depositHistory, err := client.Rest.Funding.GetDepositHistory(fundingreq.GetDepositHistoryRequest{
+	depositHistory, err := client.Rest.Funding.GetDepositHistory(ctx, fundingreq.GetDepositHistoryRequest{
 		Currency: "USDT",
 		Limit:    10,
 	})
@@ -271,7 +268,7 @@ func main() {
 
 	// Example 15: Get Withdrawal History
 	log.Println("\n--- Example 15: Get Withdrawal History (Demo) ---")
-	withdrawHistory, err := client.Rest.Funding.GetWithdrawHistory(fundingreq.GetWithdrawHistoryRequest{
+	withdrawHistory, err := client.Rest.Funding.GetWithdrawHistory(ctx, fundingreq.GetWithdrawHistoryRequest{
 		Currency: "USDT",
 		Limit:    10,
 	})
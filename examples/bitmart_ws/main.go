@@ -395,16 +395,18 @@ func example6_PrivateOrderUpdates() {
 
 	for {
 		select {
-		case order := <-orderCh:
-			fmt.Printf("\n[Order Update]\n")
-			fmt.Printf("  Order ID: %s\n", order.OrderID)
-			fmt.Printf("  Symbol: %s\n", order.Symbol)
-			fmt.Printf("  Side: %s\n", order.Side)
-			fmt.Printf("  Type: %s\n", order.Type)
-			fmt.Printf("  Status: %s\n", order.Status)
-			fmt.Printf("  Price: %s\n", order.Price)
-			fmt.Printf("  Size: %s\n", order.Size)
-			fmt.Printf("  Filled: %s\n", order.FilledSize)
+		case event := <-orderCh:
+			for _, order := range event.Data {
+				fmt.Printf("\n[Order Update]\n")
+				fmt.Printf("  Order ID: %s\n", order.OrderID)
+				fmt.Printf("  Symbol: %s\n", order.Symbol)
+				fmt.Printf("  Side: %s\n", order.Side)
+				fmt.Printf("  Type: %s\n", order.Type)
+				fmt.Printf("  Status: %s\n", order.OrderState)
+				fmt.Printf("  Price: %s\n", order.Price)
+				fmt.Printf("  Size: %s\n", order.Size)
+				fmt.Printf("  Filled: %s\n", order.FilledSize)
+			}
 
 		case <-sigCh:
 			fmt.Println("\nShutting down...")
@@ -613,9 +615,11 @@ func example9_AllPrivateChannels() {
 
 	for {
 		select {
-		case order := <-orderCh:
-			fmt.Printf("\n[Order] %s: %s %s @ %s (%s)\n",
-				order.OrderID, order.Side, order.Symbol, order.Price, order.Status)
+		case event := <-orderCh:
+			for _, order := range event.Data {
+				fmt.Printf("\n[Order] %s: %s %s @ %s (%s)\n",
+					order.OrderID, order.Side, order.Symbol, order.Price, order.OrderState)
+			}
 
 		case balance := <-balanceCh:
 			fmt.Printf("\n[Balance] %s: %s (Available: %s)\n",
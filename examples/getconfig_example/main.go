@@ -40,7 +40,7 @@ func main() {
 // testBitMartConfig 测试 BitMart GetConfig（不支持）
 func testBitMartConfig(ctx context.Context) {
 	// 创建 BitMart 客户端
-	client, err := exc.NewExchange(ctx, exc.BitMart, exc.Config{
+	client, err := exc.NewExchange(ctx, exc.Bitmart, exc.Config{
 		APIKey:    "f42ce865e6123a77b507659452384a2b48165991",
 		SecretKey: "72cca2bc92ee262f7e5398e3344b15638d43224a6fc5404567fc884f655a4b73",
 		Extra: map[string]interface{}{
@@ -137,7 +137,7 @@ func batchProcessExchanges(ctx context.Context) {
 	exchanges := []exc.Exchange{}
 
 	// 添加多个交易所
-	bitmart, _ := exc.NewExchange(ctx, exc.BitMart, exc.Config{
+	bitmart, _ := exc.NewExchange(ctx, exc.Bitmart, exc.Config{
 		APIKey:    "key",
 		SecretKey: "secret",
 		Extra:     map[string]interface{}{"memo": "memo"},
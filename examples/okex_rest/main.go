@@ -28,7 +28,7 @@ func main() {
 
 	// Example 1: Get Account Balance
 	log.Println("\n--- Example 1: Get Account Balance ---")
-	balanceResp, err := client.Rest.Account.GetBalance(accountreq.GetBalance{
+	balanceResp, err := client.Rest.Account.GetBalance(ctx, accountreq.GetBalance{
 		Ccy: []string{"BTC", "USDT"}, // Optional: specify currencies
 	})
 	if err != nil {
@@ -46,7 +46,7 @@ func main() {
 
 	// Example 2: Get Positions
 	log.Println("\n--- Example 2: Get Positions ---")
-	positionsResp, err := client.Rest.Account.GetPositions(accountreq.GetPositions{
+	positionsResp, err := client.Rest.Account.GetPositions(ctx, accountreq.GetPositions{
 		InstID: []string{"BTC-USDT-SWAP"}, // Optional: specify instruments
 	})
 	if err != nil {
@@ -61,14 +61,14 @@ func main() {
 
 	// Example 3: Place a Limit Order
 	log.Println("\n--- Example 3: Place Limit Order ---")
-	orderResp, err := client.Rest.Trade.PlaceOrder([]tradereq.PlaceOrder{
+	orderResp, err := client.Rest.Trade.PlaceOrder(ctx, []tradereq.PlaceOrder{
 		{
 			InstID:  "BTC-USDT",
 			TdMode:  "cash",    // Trading mode: cash, cross, isolated
 			Side:    "buy",     // buy or sell
 			OrdType: "limit",   // limit, market, post_only, fok, ioc
-			Sz:      0.001,     // Order size
-			Px:      30000.0,   // Price
+			Sz:      "0.001",   // Order size
+			Px:      "30000",   // Price
 			Tag:     "example", // Optional tag
 		},
 	})
@@ -84,7 +84,7 @@ func main() {
 
 	// Example 4: Get Order Details
 	log.Println("\n--- Example 4: Get Order Details ---")
-	orderDetailResp, err := client.Rest.Trade.GetOrderDetail(tradereq.OrderDetails{
+	orderDetailResp, err := client.Rest.Trade.GetOrderDetail(ctx, tradereq.OrderDetails{
 		InstID: "BTC-USDT",
 		OrdID:  "123456789", // Replace with actual order ID
 	})
@@ -100,7 +100,7 @@ func main() {
 
 	// Example 5: Cancel Order
 	log.Println("\n--- Example 5: Cancel Order ---")
-	cancelResp, err := client.Rest.Trade.CandleOrder([]tradereq.CancelOrder{
+	cancelResp, err := client.Rest.Trade.CandleOrder(ctx, []tradereq.CancelOrder{
 		{
 			InstID: "BTC-USDT",
 			OrdID:  "123456789", // Replace with actual order ID
@@ -114,7 +114,7 @@ func main() {
 
 	// Example 6: Get Order History
 	log.Println("\n--- Example 6: Get Order History ---")
-	historyResp, err := client.Rest.Trade.GetOrderHistory(tradereq.OrderList{
+	historyResp, err := client.Rest.Trade.GetOrderHistory(ctx, tradereq.OrderList{
 		InstType: "SPOT",
 		InstID:   "BTC-USDT",
 		Limit:    10, // Get last 10 orders
@@ -12,7 +12,7 @@ import (
 
 const (
 	// Exchange Type - 交易所类型
-	EXCHANGE_TYPE = exc.BitMart // 可以改为 exc.OKX, exc.OKXTest 等
+	EXCHANGE_TYPE = exc.Bitmart // 可以改为 exc.OKX, exc.OKXTest 等
 
 	// API Keys - API 密钥配置
 	API_KEY    = "f42ce865e6123a77b507659452384a2b48165991"
@@ -67,7 +67,7 @@ func createExchangeClient(ctx context.Context) (exc.Exchange, error) {
 	}
 
 	// BitMart 需要 memo
-	if EXCHANGE_TYPE == exc.BitMart || EXCHANGE_TYPE == exc.BitMartTEST {
+	if EXCHANGE_TYPE == exc.Bitmart || EXCHANGE_TYPE == exc.BitmartTest {
 		config.Extra = map[string]interface{}{
 			"memo": MEMO,
 		}
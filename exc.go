@@ -4,6 +4,8 @@ package exc
 import (
 	"context"
 
+	"github.com/djpken/go-exc/clock"
+
 	"github.com/djpken/go-exc/types"
 )
 
@@ -17,6 +19,11 @@ type (
 	AccountConfig = types.AccountConfig
 	Instrument    = types.Instrument
 	Candle        = types.Candle
+	Trade         = types.Trade
+	FundingRate   = types.FundingRate
+	MarkPrice     = types.MarkPrice
+	Symbol        = types.Symbol
+	SymbolMapper  = types.SymbolMapper
 
 	// Primitive types
 	Decimal   = types.Decimal
@@ -29,6 +36,7 @@ var (
 	NewDecimalFromFloat = types.NewDecimalFromFloat
 	NewDecimalFromInt   = types.NewDecimalFromInt
 	MustDecimal         = types.MustDecimal
+	ParseSymbol         = types.ParseSymbol
 )
 
 // Re-export type aliases for convenience
@@ -39,17 +47,36 @@ type (
 	InstrumentType = types.InstrumentType
 
 	// Request types
-	PlaceOrderRequest     = types.PlaceOrderRequest
-	CancelOrderRequest    = types.CancelOrderRequest
-	GetOrderRequest       = types.GetOrderRequest
-	WithdrawRequest       = types.WithdrawRequest
-	SetLeverageRequest    = types.SetLeverageRequest
-	GetLeverageRequest    = types.GetLeverageRequest
-	GetInstrumentsRequest = types.GetInstrumentsRequest
-	GetTickersRequest     = types.GetTickersRequest
-	GetCandlesRequest     = types.GetCandlesRequest
-	Leverage              = types.Leverage
-	PlaceOrderResult      = types.PlaceOrderResult
+	PlaceOrderRequest            = types.PlaceOrderRequest
+	TimeInForce                  = types.TimeInForce
+	AttachedOrder                = types.AttachedOrder
+	CancelOrderRequest           = types.CancelOrderRequest
+	AmendOrderRequest            = types.AmendOrderRequest
+	GetOrderRequest              = types.GetOrderRequest
+	GetOpenOrdersRequest         = types.GetOpenOrdersRequest
+	GetOrderHistoryRequest       = types.GetOrderHistoryRequest
+	GetFillsRequest              = types.GetFillsRequest
+	WithdrawRequest              = types.WithdrawRequest
+	SetLeverageRequest           = types.SetLeverageRequest
+	GetLeverageRequest           = types.GetLeverageRequest
+	ClosePositionRequest         = types.ClosePositionRequest
+	ClosePositionResult          = types.ClosePositionResult
+	GetInstrumentsRequest        = types.GetInstrumentsRequest
+	GetTickersRequest            = types.GetTickersRequest
+	GetCandlesRequest            = types.GetCandlesRequest
+	GetFundingRateHistoryRequest = types.GetFundingRateHistoryRequest
+	Leverage                     = types.Leverage
+	PlaceOrderResult             = types.PlaceOrderResult
+	AmendOrderResult             = types.AmendOrderResult
+	CancelOrderResult            = types.CancelOrderResult
+
+	// Conditional order types
+	ConditionalOrder              = types.ConditionalOrder
+	ConditionalOrderType          = types.ConditionalOrderType
+	TriggerPriceType              = types.TriggerPriceType
+	ConditionalOrderRequest       = types.ConditionalOrderRequest
+	CancelConditionalOrderRequest = types.CancelConditionalOrderRequest
+	GetConditionalOrdersRequest   = types.GetConditionalOrdersRequest
 
 	// WebSocket types
 	BalanceAndPositionUpdate  = types.BalanceAndPositionUpdate
@@ -58,6 +85,11 @@ type (
 	OrderUpdate               = types.OrderUpdate
 	TickerUpdate              = types.TickerUpdate
 	CandleUpdate              = types.CandleUpdate
+	OrderBookUpdate           = types.OrderBookUpdate
+	OrderBookDelta            = types.OrderBookDelta
+	TradeUpdate               = types.TradeUpdate
+	FundingRateUpdate         = types.FundingRateUpdate
+	MarkPriceUpdate           = types.MarkPriceUpdate
 	WebSocketSubscribeRequest = types.WebSocketSubscribeRequest
 	WebSocketError            = types.WebSocketError
 	WebSocketSubscribe        = types.WebSocketSubscribe
@@ -71,6 +103,9 @@ type (
 // ZeroDecimal represents a zero value for Decimal type
 var ZeroDecimal = types.ZeroDecimal
 
+// NativeSymbolKey is the Extra key holding the exchange-native symbol of returned objects
+const NativeSymbolKey = types.NativeSymbolKey
+
 // Common constants
 const (
 	// Position side constants
@@ -89,15 +124,36 @@ const (
 	InstrumentFutures = types.InstrumentFutures
 	InstrumentSwap    = types.InstrumentSwap
 	InstrumentOption  = types.InstrumentOption
+
+	// Conditional order type constants
+	ConditionalOrderTypeTrigger  = types.ConditionalOrderTypeTrigger
+	ConditionalOrderTypeTPSL     = types.ConditionalOrderTypeTPSL
+	ConditionalOrderTypeTrailing = types.ConditionalOrderTypeTrailing
+
+	// Time in force constants
+	TimeInForceGTC = types.TimeInForceGTC
+	TimeInForceIOC = types.TimeInForceIOC
+	TimeInForceFOK = types.TimeInForceFOK
+	TimeInForceGTX = types.TimeInForceGTX
+
+	// Trigger price type constants
+	TriggerPriceTypeLast  = types.TriggerPriceTypeLast
+	TriggerPriceTypeMark  = types.TriggerPriceTypeMark
+	TriggerPriceTypeIndex = types.TriggerPriceTypeIndex
 )
 
 // Exchange represents a cryptocurrency exchange with a unified API interface.
 // All exchanges implement the same methods, allowing you to write exchange-agnostic code.
 //
+// Symbols are canonical: "BTC/USDT" (spot), "BTC/USDT:USDT" (USDT-settled perpetual
+// swap) and "BTC/USD:BTC-250328" (dated futures). Returned objects carry the
+// exchange-native symbol in Extra[NativeSymbolKey]. Exchange-native symbols are
+// still accepted as input.
+//
 // Usage:
 //
 //	client, _ := exc.NewExchange(ctx, exc.Bitmart, config)
-//	ticker, _ := client.GetTicker(ctx, "BTC_USDT")  // Works for all exchanges
+//	ticker, _ := client.GetTicker(ctx, "BTC/USDT")  // Works for all exchanges
 type Exchange interface {
 	// ========== Basic Methods ==========
 
@@ -114,6 +170,10 @@ type Exchange interface {
 	// Use this for exchange-specific WebSocket features
 	WebSocket() interface{}
 
+	// Clock returns the clock synchronized with the exchange server time,
+	// reporting the measured offset and round-trip latency
+	Clock() *clock.Clock
+
 	// Close closes all connections and cleans up resources
 	// Should be called when done using the exchange client
 	Close() error
@@ -125,7 +185,7 @@ type Exchange interface {
 	// --- Market Data ---
 
 	// GetTicker gets the latest ticker/price information for a symbol
-	// symbol: Trading pair symbol (e.g., "BTC_USDT" for Bitmart, "BTC-USDT" for OKEx)
+	// symbol: Canonical symbol (e.g., "BTC/USDT", "BTC/USDT:USDT")
 	// Returns: Ticker with price, volume, and timestamp information
 	GetTicker(ctx context.Context, symbol string) (*Ticker, error)
 
@@ -140,7 +200,7 @@ type Exchange interface {
 	GetInstruments(ctx context.Context, req GetInstrumentsRequest) ([]*Instrument, error)
 
 	// GetOrderBook gets the current order book for a symbol
-	// symbol: Trading pair symbol (e.g., "BTC_USDT" for Bitmart, "BTC-USDT" for OKEx)
+	// symbol: Canonical symbol (e.g., "BTC/USDT", "BTC/USDT:USDT")
 	// depth: Number of order book levels to retrieve (0 = exchange default)
 	// Returns: OrderBook with bids and asks at each price level
 	GetOrderBook(ctx context.Context, symbol string, depth int) (*OrderBook, error)
@@ -150,6 +210,27 @@ type Exchange interface {
 	// Returns: List of Candle objects with OHLCV data
 	GetCandles(ctx context.Context, req GetCandlesRequest) ([]*Candle, error)
 
+	// GetRecentTrades gets the most recent public trades for a symbol
+	// symbol: Canonical symbol (e.g., "BTC/USDT", "BTC/USDT:USDT")
+	// limit: Maximum number of trades to retrieve (0 = exchange default)
+	// Returns: List of Trade objects sorted newest first, Side being the aggressor side
+	GetRecentTrades(ctx context.Context, symbol string, limit int) ([]*Trade, error)
+
+	// GetFundingRate gets the current funding rate of a perpetual contract
+	// symbol: Canonical symbol of a perpetual contract (e.g., "BTC/USDT:USDT")
+	// Returns: FundingRate of the current period and its settlement time
+	GetFundingRate(ctx context.Context, symbol string) (*FundingRate, error)
+
+	// GetFundingRateHistory gets settled funding rates of a perpetual contract
+	// req: GetFundingRateHistoryRequest with symbol, limit, and optional time range
+	// Returns: List of FundingRate objects sorted newest first
+	GetFundingRateHistory(ctx context.Context, req GetFundingRateHistoryRequest) ([]*FundingRate, error)
+
+	// GetMarkPrice gets the mark and index prices of a contract
+	// symbol: Canonical symbol of a contract (e.g., "BTC/USDT:USDT")
+	// Returns: MarkPrice with mark price, index price, and timestamp
+	GetMarkPrice(ctx context.Context, symbol string) (*MarkPrice, error)
+
 	// --- Account Information ---
 
 	// GetConfig gets account configuration settings
@@ -180,6 +261,17 @@ type Exchange interface {
 	// Note: Not all exchanges support this (Bitmart returns ErrNotSupported)
 	SetLeverage(ctx context.Context, req SetLeverageRequest) (*Leverage, error)
 
+	// ClosePosition closes all or part of an open position
+	// req: ClosePositionRequest with symbol, position side, margin mode and optional partial quantity
+	// Returns: ClosePositionResult, with the closing order when the exchange returns one
+	// Note: Exchanges without a native endpoint send a reduce-only market order
+	ClosePosition(ctx context.Context, req ClosePositionRequest) (*ClosePositionResult, error)
+
+	// CloseAllPositions closes every open position at market
+	// Returns: One ClosePositionResult per position. Per-position failures are reported in
+	// ClosePositionResult.Error; the outer error is only set for request-level failures.
+	CloseAllPositions(ctx context.Context) ([]*ClosePositionResult, error)
+
 	// --- Trading Operations ---
 
 	// PlaceOrder places a new order on the exchange
@@ -204,11 +296,59 @@ type Exchange interface {
 	// Returns: Error if cancellation failed
 	CancelOrder(ctx context.Context, req CancelOrderRequest) error
 
+	// CancelOrders cancels multiple orders, batching requests where the exchange supports it
+	// reqs: List of cancel requests (may span multiple symbols)
+	// Returns: One CancelOrderResult per request in the same order. Per-order failures are
+	// reported in CancelOrderResult.Error; the outer error is only set for request-level failures.
+	CancelOrders(ctx context.Context, reqs []CancelOrderRequest) ([]*CancelOrderResult, error)
+
+	// CancelAllOrders cancels all working orders
+	// symbol: Only cancel orders of this symbol (empty = all symbols where supported)
+	// instType: Only cancel orders of this instrument type (empty = exchange default)
+	// Returns: One CancelOrderResult per order the exchange attempted to cancel
+	CancelAllOrders(ctx context.Context, symbol string, instType InstrumentType) ([]*CancelOrderResult, error)
+
+	// AmendOrder changes the size and/or price of a resting order
+	// req: AmendOrderRequest with symbol, order ID or client order ID, new quantity and/or price
+	// Returns: AmendOrderResult with the resulting Order; Replaced reports whether the
+	// exchange amended in place or the order was canceled and re-placed (new order ID)
+	AmendOrder(ctx context.Context, req AmendOrderRequest) (*AmendOrderResult, error)
+
 	// GetOrderDetail gets details of a specific order
 	// req: GetOrderRequest with symbol and order ID
 	// Returns: Order object with current status, filled quantity, etc.
 	GetOrderDetail(ctx context.Context, req GetOrderRequest) (*Order, error)
 
+	// GetOpenOrders lists working (live or partially filled) orders
+	// req: GetOpenOrdersRequest with optional symbol/instrument type filters and After cursor
+	// Returns: List of orders sorted newest first, with normalized Status
+	GetOpenOrders(ctx context.Context, req GetOpenOrdersRequest) ([]*Order, error)
+
+	// GetOrderHistory lists completed (filled or canceled) orders
+	// req: GetOrderHistoryRequest with symbol/instrument type filters, time window and After cursor
+	// Returns: List of orders sorted newest first, with normalized Status
+	GetOrderHistory(ctx context.Context, req GetOrderHistoryRequest) ([]*Order, error)
+
+	// GetFills lists own trade executions, paging across the time window automatically
+	// req: GetFillsRequest with symbol/instrument type/order filters and time window
+	// Returns: List of fills sorted newest first, with fee and maker/taker flag
+	GetFills(ctx context.Context, req GetFillsRequest) ([]*Trade, error)
+
+	// PlaceConditionalOrder places a trigger, take-profit/stop-loss or trailing stop order
+	// req: ConditionalOrderRequest with type, trigger price(s), trigger price type and quantity
+	// Returns: ConditionalOrder with the conditional order ID
+	PlaceConditionalOrder(ctx context.Context, req ConditionalOrderRequest) (*ConditionalOrder, error)
+
+	// CancelConditionalOrder cancels an untriggered conditional order
+	// req: CancelConditionalOrderRequest with symbol and conditional order ID
+	// Returns: Error if cancellation failed
+	CancelConditionalOrder(ctx context.Context, req CancelConditionalOrderRequest) error
+
+	// GetConditionalOrders lists untriggered conditional orders
+	// req: GetConditionalOrdersRequest with optional symbol/instrument type/type filters
+	// Returns: List of ConditionalOrder objects
+	GetConditionalOrders(ctx context.Context, req GetConditionalOrdersRequest) ([]*ConditionalOrder, error)
+
 	// --- WebSocket Subscriptions ---
 
 	// SubscribeTickers subscribes to ticker updates for specified symbols via WebSocket
@@ -235,6 +375,61 @@ type Exchange interface {
 	// Returns: Error if unsubscription failed
 	UnsubscribeCandles(interval string, symbols ...string) error
 
+	// SubscribeOrderBook streams order books for specified symbols via WebSocket
+	// ch: Channel to receive OrderBookUpdate events, each with the top levels of a local
+	//     book kept from a snapshot and incremental updates, and the delta applied
+	// depth: Number of levels per side to deliver (0 = whole local book)
+	// symbols: List of trading symbols to subscribe to
+	// Returns: Error if subscription failed
+	// Note: Sequence gaps are detected and the book resynced from a new snapshot.
+	//       Not all exchanges support this: BingX pushes every update as a full
+	//       snapshot without sequence numbers, so gaps cannot be detected and
+	//       Sequence is 0; Bitmart returns ErrNotSupported for spot symbols
+	SubscribeOrderBook(ch chan *OrderBookUpdate, depth int, symbols ...string) error
+
+	// UnsubscribeOrderBook unsubscribes from order book updates for specified symbols
+	// symbols: List of trading symbols to unsubscribe from
+	// Returns: Error if unsubscription failed
+	UnsubscribeOrderBook(symbols ...string) error
+
+	// SubscribeTrades subscribes to public trades for specified symbols via WebSocket
+	// ch: Channel to receive TradeUpdate events, Side being the aggressor side
+	// symbols: List of trading symbols to subscribe to
+	// Returns: Error if subscription failed
+	// Note: Where the exchange numbers trades sequentially, missed trades are
+	//       reported in TradeUpdate.Missed. Bitmart returns ErrNotSupported for
+	//       spot symbols
+	SubscribeTrades(ch chan *TradeUpdate, symbols ...string) error
+
+	// UnsubscribeTrades unsubscribes from public trades for specified symbols
+	// symbols: List of trading symbols to unsubscribe from
+	// Returns: Error if unsubscription failed
+	UnsubscribeTrades(symbols ...string) error
+
+	// SubscribeFundingRate subscribes to funding rate updates of perpetual contracts via WebSocket
+	// ch: Channel to receive FundingRateUpdate events
+	// symbols: List of perpetual contract symbols to subscribe
+	// Returns: Error if subscription failed
+	// Note: Not all exchanges support this (BingX returns ErrNotSupported)
+	SubscribeFundingRate(ch chan *FundingRateUpdate, symbols ...string) error
+
+	// UnsubscribeFundingRate unsubscribes from funding rate updates for specified symbols
+	// symbols: List of perpetual contract symbols to unsubscribe from
+	// Returns: Error if unsubscription failed
+	UnsubscribeFundingRate(symbols ...string) error
+
+	// SubscribeMarkPrice subscribes to mark and index price updates via WebSocket
+	// ch: Channel to receive MarkPriceUpdate events
+	// symbols: List of contract symbols to subscribe
+	// Returns: Error if subscription failed
+	// Note: BingX does not push index prices, leaving IndexPrice zero
+	SubscribeMarkPrice(ch chan *MarkPriceUpdate, symbols ...string) error
+
+	// UnsubscribeMarkPrice unsubscribes from mark price updates for specified symbols
+	// symbols: List of contract symbols to unsubscribe from
+	// Returns: Error if unsubscription failed
+	UnsubscribeMarkPrice(symbols ...string) error
+
 	// SubscribeBalanceAndPosition subscribes to balance and position updates via WebSocket
 	// ch: Channel to receive BalanceAndPositionUpdate events
 	// Returns: Error if subscription failed
@@ -272,6 +467,20 @@ type Exchange interface {
 	// Note: Not all exchanges support this (Bitmart returns ErrNotSupported)
 	UnsubscribePosition(req WebSocketSubscribeRequest) error
 
+	// SubscribeOrders subscribes to order updates via WebSocket
+	// ch: Channel to receive OrderUpdate events, one per order status transition or fill
+	// req: WebSocketSubscribeRequest with subscription parameters (InstrumentType selects
+	//      spot or contract orders where the exchange streams them separately)
+	// Returns: Error if subscription failed
+	// Note: FilledQuantity and Fee are cumulative; LastFillPrice and LastFillQuantity
+	//       describe the fill that triggered the update, if any
+	SubscribeOrders(ch chan *OrderUpdate, req WebSocketSubscribeRequest) error
+
+	// UnsubscribeOrders unsubscribes from order updates
+	// req: WebSocketSubscribeRequest with subscription parameters
+	// Returns: Error if unsubscription failed
+	UnsubscribeOrders(req WebSocketSubscribeRequest) error
+
 	// SetChannels sets channels for receiving WebSocket events
 	// errCh: Channel to receive error events
 	// subCh: Channel to receive subscription events
@@ -3,9 +3,13 @@ package bingx
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/djpken/go-exc/clock"
 	"github.com/djpken/go-exc/exchanges/bingx/rest"
 	"github.com/djpken/go-exc/exchanges/bingx/ws"
+	"github.com/djpken/go-exc/options"
 	commontypes "github.com/djpken/go-exc/types"
 )
 
@@ -25,29 +29,79 @@ type BingXExchange struct {
 	restAPI    *RESTAdapter
 	wsAPI      *WebSocketAdapter
 	ctx        context.Context
+	stop       context.CancelFunc
 	testMode   bool
 }
 
+// receiveWindow is how far a request timestamp may be from the BingX server time
+const receiveWindow = 5 * time.Second
+
 // NewBingXExchange creates a new BingX exchange instance.
 // testMode=true uses the BingX simulation trading environment (demo accounts).
-func NewBingXExchange(ctx context.Context, apiKey, secretKey string, testMode bool) (*BingXExchange, error) {
+// opts configure the HTTP client, endpoints, WebSocket dialer, user agent, logger, REST middleware and metrics.
+// Unless disabled with WithClockSync, the offset to the BingX server clock is
+// measured in the background until ctx is done or Close is called.
+func NewBingXExchange(ctx context.Context, apiKey, secretKey string, testMode bool, opts ...options.Option) (*BingXExchange, error) {
 	restURL := defaultRESTURL
 	wsURL := defaultWSURL
 	if testMode {
 		restURL = testRESTURL
 		wsURL = testWSURL
 	}
+	o := options.NewClientOptions(opts...)
+	if o.BaseURL != "" {
+		restURL = o.BaseURL
+	}
+	if o.WSURL != "" {
+		wsURL = o.WSURL
+	}
 
-	restClient := rest.NewClientRest(ctx, apiKey, secretKey, restURL)
+	restClient := rest.NewClientRest(apiKey, secretKey, restURL)
 	wsClient := ws.NewClientWs(wsURL, "") // public WebSocket; no auth needed
 
-	// Private WebSocket uses listen key obtained via REST
+	// Private WebSocket uses listen key obtained via REST, bound to the exchange lifetime
 	privateWS := ws.NewPrivateClientWs(
-		restClient.CreateListenKey,
-		restClient.ExtendListenKey,
+		func() (string, error) { return restClient.CreateListenKey(ctx) },
+		func(key string) error { return restClient.ExtendListenKey(ctx, key) },
 		wsURL,
 	)
 
+	if o.HTTPClient != nil {
+		restClient.SetHTTPClient(o.HTTPClient)
+	}
+	if o.Dialer != nil {
+		wsClient.SetDialer(o.Dialer)
+		privateWS.SetDialer(o.Dialer)
+	}
+	if o.UserAgent != "" {
+		restClient.SetUserAgent(o.UserAgent)
+		wsClient.SetUserAgent(o.UserAgent)
+		privateWS.SetUserAgent(o.UserAgent)
+	}
+	if o.RateLimiter != nil {
+		restClient.SetRateLimiter(o.RateLimiter)
+	}
+	if o.RetryPolicy != nil {
+		restClient.SetRetryPolicy(*o.RetryPolicy)
+	}
+	restClient.SetMiddleware(o.RESTMiddleware()...)
+	restClient.SetMetrics(o.Metrics)
+	wsClient.SetMetrics(o.Metrics)
+	privateWS.SetMetrics(o.Metrics)
+	logger := o.LoggerFor("bingx")
+	restClient.SetLogger(logger)
+	wsClient.SetLogger(logger.With("private", false))
+	privateWS.SetLogger(logger.With("private", true))
+
+	stop := func() {}
+	if interval := o.ClockSync(); interval > 0 {
+		clk := clock.New(serverTime(restClient), receiveWindow)
+		restClient.SetClock(clk)
+		var clockCtx context.Context
+		clockCtx, stop = context.WithCancel(ctx)
+		go clk.Run(clockCtx, interval)
+	}
+
 	restAdapter := NewRESTAdapter(restClient)
 	wsAdapter := NewWebSocketAdapter(wsClient, privateWS)
 
@@ -58,10 +112,25 @@ func NewBingXExchange(ctx context.Context, apiKey, secretKey string, testMode bo
 		restAPI:    restAdapter,
 		wsAPI:      wsAdapter,
 		ctx:        ctx,
+		stop:       stop,
 		testMode:   testMode,
 	}, nil
 }
 
+// serverTime returns a clock.ServerTimeFunc reading the BingX server time
+func serverTime(r *rest.ClientRest) clock.ServerTimeFunc {
+	return func(ctx context.Context) (time.Time, error) {
+		resp, err := r.Market.GetServerTime(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if resp.Data.ServerTime == 0 {
+			return time.Time{}, fmt.Errorf("bingx: no server time returned")
+		}
+		return time.UnixMilli(resp.Data.ServerTime), nil
+	}
+}
+
 // ─── Basic Methods ────────────────────────────────────────────────────────────
 
 func (e *BingXExchange) Name() string {
@@ -72,8 +141,10 @@ func (e *BingXExchange) Name() string {
 }
 func (e *BingXExchange) REST() interface{}      { return e.restAPI }
 func (e *BingXExchange) WebSocket() interface{} { return e.wsAPI }
+func (e *BingXExchange) Clock() *clock.Clock    { return e.restClient.Clock() }
 
 func (e *BingXExchange) Close() error {
+	e.stop()
 	if e.wsClient != nil {
 		_ = e.wsClient.Close()
 	}
@@ -105,6 +176,22 @@ func (e *BingXExchange) GetCandles(ctx context.Context, req commontypes.GetCandl
 	return e.restAPI.Market().GetCandles(ctx, req)
 }
 
+func (e *BingXExchange) GetRecentTrades(ctx context.Context, symbol string, limit int) ([]*commontypes.Trade, error) {
+	return e.restAPI.Market().GetRecentTrades(ctx, symbol, limit)
+}
+
+func (e *BingXExchange) GetFundingRate(ctx context.Context, symbol string) (*commontypes.FundingRate, error) {
+	return e.restAPI.Market().GetFundingRate(ctx, symbol)
+}
+
+func (e *BingXExchange) GetFundingRateHistory(ctx context.Context, req commontypes.GetFundingRateHistoryRequest) ([]*commontypes.FundingRate, error) {
+	return e.restAPI.Market().GetFundingRateHistory(ctx, req)
+}
+
+func (e *BingXExchange) GetMarkPrice(ctx context.Context, symbol string) (*commontypes.MarkPrice, error) {
+	return e.restAPI.Market().GetMarkPrice(ctx, symbol)
+}
+
 // ─── Account ─────────────────────────────────────────────────────────────────
 
 // GetConfig is not supported by BingX
@@ -128,6 +215,14 @@ func (e *BingXExchange) SetLeverage(ctx context.Context, req commontypes.SetLeve
 	return e.restAPI.Account().SetLeverage(ctx, req)
 }
 
+func (e *BingXExchange) ClosePosition(ctx context.Context, req commontypes.ClosePositionRequest) (*commontypes.ClosePositionResult, error) {
+	return e.restAPI.Trade().ClosePosition(ctx, req)
+}
+
+func (e *BingXExchange) CloseAllPositions(ctx context.Context) ([]*commontypes.ClosePositionResult, error) {
+	return e.restAPI.Trade().CloseAllPositions(ctx)
+}
+
 // ─── Trading ─────────────────────────────────────────────────────────────────
 
 func (e *BingXExchange) PlaceOrder(ctx context.Context, req commontypes.PlaceOrderRequest) (*commontypes.Order, error) {
@@ -148,10 +243,49 @@ func (e *BingXExchange) CancelOrder(ctx context.Context, req commontypes.CancelO
 	return e.restAPI.Trade().CancelOrder(ctx, req.Symbol, req.OrderID, req.Extra)
 }
 
+// CancelOrders cancels multiple orders and returns per-order results.
+func (e *BingXExchange) CancelOrders(ctx context.Context, reqs []commontypes.CancelOrderRequest) ([]*commontypes.CancelOrderResult, error) {
+	return e.restAPI.Trade().CancelOrders(ctx, reqs)
+}
+
+// CancelAllOrders cancels all open orders, optionally for a single symbol.
+func (e *BingXExchange) CancelAllOrders(ctx context.Context, symbol string, instType commontypes.InstrumentType) ([]*commontypes.CancelOrderResult, error) {
+	return e.restAPI.Trade().CancelAllOrders(ctx, symbol, instType)
+}
+
+// AmendOrder cancels and replaces an open order with a new price and/or quantity.
+func (e *BingXExchange) AmendOrder(ctx context.Context, req commontypes.AmendOrderRequest) (*commontypes.AmendOrderResult, error) {
+	return e.restAPI.Trade().AmendOrder(ctx, req)
+}
+
 func (e *BingXExchange) GetOrderDetail(ctx context.Context, req commontypes.GetOrderRequest) (*commontypes.Order, error) {
 	return e.restAPI.Trade().GetOrderDetail(ctx, req)
 }
 
+func (e *BingXExchange) GetOpenOrders(ctx context.Context, req commontypes.GetOpenOrdersRequest) ([]*commontypes.Order, error) {
+	return e.restAPI.Trade().GetOpenOrders(ctx, req)
+}
+
+func (e *BingXExchange) GetOrderHistory(ctx context.Context, req commontypes.GetOrderHistoryRequest) ([]*commontypes.Order, error) {
+	return e.restAPI.Trade().GetOrderHistory(ctx, req)
+}
+
+func (e *BingXExchange) GetFills(ctx context.Context, req commontypes.GetFillsRequest) ([]*commontypes.Trade, error) {
+	return e.restAPI.Trade().GetFills(ctx, req)
+}
+
+func (e *BingXExchange) PlaceConditionalOrder(ctx context.Context, req commontypes.ConditionalOrderRequest) (*commontypes.ConditionalOrder, error) {
+	return e.restAPI.Trade().PlaceConditionalOrder(ctx, req)
+}
+
+func (e *BingXExchange) CancelConditionalOrder(ctx context.Context, req commontypes.CancelConditionalOrderRequest) error {
+	return e.restAPI.Trade().CancelConditionalOrder(ctx, req)
+}
+
+func (e *BingXExchange) GetConditionalOrders(ctx context.Context, req commontypes.GetConditionalOrdersRequest) ([]*commontypes.ConditionalOrder, error) {
+	return e.restAPI.Trade().GetConditionalOrders(ctx, req)
+}
+
 // ─── WebSocket ────────────────────────────────────────────────────────────────
 
 func (e *BingXExchange) SubscribeTickers(ch chan *commontypes.TickerUpdate, symbols ...string) error {
@@ -170,6 +304,40 @@ func (e *BingXExchange) UnsubscribeCandles(interval string, symbols ...string) e
 	return e.wsAPI.UnsubscribeCandles(interval, symbols...)
 }
 
+func (e *BingXExchange) SubscribeOrderBook(ch chan *commontypes.OrderBookUpdate, depth int, symbols ...string) error {
+	return e.wsAPI.SubscribeOrderBook(ch, depth, symbols...)
+}
+
+func (e *BingXExchange) UnsubscribeOrderBook(symbols ...string) error {
+	return e.wsAPI.UnsubscribeOrderBook(symbols...)
+}
+
+func (e *BingXExchange) SubscribeTrades(ch chan *commontypes.TradeUpdate, symbols ...string) error {
+	return e.wsAPI.SubscribeTrades(ch, symbols...)
+}
+
+func (e *BingXExchange) UnsubscribeTrades(symbols ...string) error {
+	return e.wsAPI.UnsubscribeTrades(symbols...)
+}
+
+// SubscribeFundingRate is not supported by BingX (poll GetFundingRate instead).
+func (e *BingXExchange) SubscribeFundingRate(_ chan *commontypes.FundingRateUpdate, _ ...string) error {
+	return commontypes.ErrNotSupported
+}
+
+// UnsubscribeFundingRate is not supported by BingX.
+func (e *BingXExchange) UnsubscribeFundingRate(_ ...string) error {
+	return commontypes.ErrNotSupported
+}
+
+func (e *BingXExchange) SubscribeMarkPrice(ch chan *commontypes.MarkPriceUpdate, symbols ...string) error {
+	return e.wsAPI.SubscribeMarkPrice(ch, symbols...)
+}
+
+func (e *BingXExchange) UnsubscribeMarkPrice(symbols ...string) error {
+	return e.wsAPI.UnsubscribeMarkPrice(symbols...)
+}
+
 // SubscribeBalanceAndPosition is not supported by BingX (use SubscribeAccount + SubscribeOrders).
 func (e *BingXExchange) SubscribeBalanceAndPosition(_ chan *commontypes.BalanceAndPositionUpdate) error {
 	return commontypes.ErrNotSupported
@@ -3,10 +3,12 @@ package bingx
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/djpken/go-exc/exchanges/bingx/rest"
 	commontypes "github.com/djpken/go-exc/types"
+	"github.com/shopspring/decimal"
 )
 
 // Converter converts between BingX-specific types and common types
@@ -54,32 +56,28 @@ func (c *Converter) ConvertOrderBook(ob *rest.OrderBookData, symbol string) *com
 	if ob == nil {
 		return nil
 	}
-	bids := make([]commontypes.OrderBookLevel, 0, len(ob.Bids))
-	for _, b := range ob.Bids {
-		if len(b) >= 2 {
-			bids = append(bids, commontypes.OrderBookLevel{
-				Price:    c.str(b[0]),
-				Quantity: c.str(b[1]),
-			})
-		}
-	}
-	asks := make([]commontypes.OrderBookLevel, 0, len(ob.Asks))
-	for _, a := range ob.Asks {
-		if len(a) >= 2 {
-			asks = append(asks, commontypes.OrderBookLevel{
-				Price:    c.str(a[0]),
-				Quantity: c.str(a[1]),
-			})
-		}
-	}
 	return &commontypes.OrderBook{
 		Symbol:    symbol,
-		Bids:      bids,
-		Asks:      asks,
+		Bids:      c.ConvertOrderBookLevels(ob.Bids),
+		Asks:      c.ConvertOrderBookLevels(ob.Asks),
 		Timestamp: commontypes.Timestamp(time.UnixMilli(ob.T)),
 	}
 }
 
+// ConvertOrderBookLevels converts BingX [price, quantity] entries to common order book levels
+func (c *Converter) ConvertOrderBookLevels(entries [][]string) []commontypes.OrderBookLevel {
+	levels := make([]commontypes.OrderBookLevel, 0, len(entries))
+	for _, e := range entries {
+		if len(e) >= 2 {
+			levels = append(levels, commontypes.OrderBookLevel{
+				Price:    c.str(e[0]),
+				Quantity: c.str(e[1]),
+			})
+		}
+	}
+	return levels
+}
+
 // ConvertKline converts a BingX KlineEntry to the common Candle type
 func (c *Converter) ConvertKline(k *rest.KlineEntry, symbol, interval string) *commontypes.Candle {
 	if k == nil {
@@ -99,6 +97,72 @@ func (c *Converter) ConvertKline(k *rest.KlineEntry, symbol, interval string) *c
 	}
 }
 
+// aggressorSide returns the aggressor side of a public trade from its buyer-maker flag
+func aggressorSide(buyerMaker bool) string {
+	if buyerMaker {
+		return "sell"
+	}
+	return "buy"
+}
+
+// ConvertTrade converts a BingX TradeEntry to the common Trade type, Side being
+// the aggressor side. BingX swap trades carry no trade ID.
+func (c *Converter) ConvertTrade(t *rest.TradeEntry, symbol string) *commontypes.Trade {
+	if t == nil {
+		return nil
+	}
+	return &commontypes.Trade{
+		Symbol:    symbol,
+		Side:      aggressorSide(t.IsBuyerMaker),
+		Price:     c.str(t.Price),
+		Quantity:  c.str(t.Qty),
+		Timestamp: commontypes.Timestamp(time.UnixMilli(t.Time)),
+		Extra:     map[string]interface{}{"quoteQty": t.QuoteQty},
+	}
+}
+
+// ConvertPremiumIndexFundingRate converts the funding rate of a BingX premium index
+// to the common FundingRate type. BingX publishes neither the predicted rate nor
+// the settlement after nextFundingTime.
+func (c *Converter) ConvertPremiumIndexFundingRate(p *rest.PremiumIndexData) *commontypes.FundingRate {
+	if p == nil {
+		return nil
+	}
+	return &commontypes.FundingRate{
+		Symbol:      p.Symbol,
+		FundingRate: c.str(p.LastFundingRate),
+		FundingTime: commontypes.Timestamp(time.UnixMilli(p.NextFundingTime)),
+		Extra:       map[string]interface{}{},
+	}
+}
+
+// ConvertPremiumIndexMarkPrice converts the prices of a BingX premium index to the common MarkPrice type
+func (c *Converter) ConvertPremiumIndexMarkPrice(p *rest.PremiumIndexData) *commontypes.MarkPrice {
+	if p == nil {
+		return nil
+	}
+	return &commontypes.MarkPrice{
+		Symbol:     p.Symbol,
+		MarkPrice:  c.str(p.MarkPrice),
+		IndexPrice: c.str(p.IndexPrice),
+		Extra:      map[string]interface{}{},
+	}
+}
+
+// ConvertFundingRateEntry converts a settled BingX funding rate to the common FundingRate type
+func (c *Converter) ConvertFundingRateEntry(f *rest.FundingRateEntry) *commontypes.FundingRate {
+	if f == nil {
+		return nil
+	}
+	return &commontypes.FundingRate{
+		Symbol:      f.Symbol,
+		FundingRate: c.str(f.FundingRate),
+		FundingTime: commontypes.Timestamp(time.UnixMilli(f.FundingTime)),
+		Timestamp:   commontypes.Timestamp(time.UnixMilli(f.FundingTime)),
+		Extra:       map[string]interface{}{},
+	}
+}
+
 // ConvertBalance converts BingX BalanceAsset to the common AccountBalance type
 func (c *Converter) ConvertBalance(b *rest.BalanceAsset) *commontypes.AccountBalance {
 	if b == nil {
@@ -196,10 +260,12 @@ func (c *Converter) ConvertLeverage(symbol string, d *rest.LeverageData) []*comm
 }
 
 // ConvertOrder converts BingX OrderData to the common Order type
+// BingX reports commission as a negative amount; the sign is flipped so Fee is the amount paid.
 func (c *Converter) ConvertOrder(o *rest.OrderData) *commontypes.Order {
 	if o == nil {
 		return nil
 	}
+	fee, _ := c.str(o.Commission).Neg()
 	return &commontypes.Order{
 		ID:             strconv.FormatInt(o.OrderID, 10),
 		Symbol:         o.Symbol,
@@ -208,6 +274,7 @@ func (c *Converter) ConvertOrder(o *rest.OrderData) *commontypes.Order {
 		Price:          c.str(o.Price),
 		Quantity:       c.str(o.OrigQty),
 		FilledQuantity: c.str(o.ExecutedQty),
+		Fee:            fee,
 		Status:         c.ConvertOrderStatus(o.Status),
 		ClientOrderID:  o.ClientOrderID,
 		CreatedAt:      commontypes.Timestamp(time.UnixMilli(o.Time)),
@@ -224,6 +291,156 @@ func (c *Converter) ConvertOrder(o *rest.OrderData) *commontypes.Order {
 	}
 }
 
+// ConvertConditionalOrder converts a BingX conditional order to the common
+// ConditionalOrder type. Returns nil if the order is not a conditional order.
+func (c *Converter) ConvertConditionalOrder(o *rest.OrderData) *commontypes.ConditionalOrder {
+	if o == nil {
+		return nil
+	}
+	orderType, ok := c.ConvertConditionalOrderType(o.Type)
+	if !ok {
+		return nil
+	}
+
+	status := c.ConvertOrderStatus(o.Status)
+	if status == commontypes.OrderStatusOpen {
+		status = commontypes.OrderStatusLive
+	}
+
+	order := &commontypes.ConditionalOrder{
+		ID:               strconv.FormatInt(o.OrderID, 10),
+		ClientOrderID:    o.ClientOrderID,
+		Symbol:           o.Symbol,
+		Type:             orderType,
+		Side:             c.ConvertOrderSide(o.Side),
+		Status:           status,
+		Quantity:         c.str(o.OrigQty),
+		TriggerPriceType: c.ConvertWorkingType(o.WorkingType),
+		CreatedAt:        commontypes.Timestamp(time.UnixMilli(o.Time)),
+		Extra: map[string]interface{}{
+			"type":         o.Type,
+			"positionSide": o.PositionSide,
+		},
+	}
+
+	switch o.Type {
+	case "TAKE_PROFIT_MARKET", "TAKE_PROFIT":
+		order.TakeProfitTriggerPrice = c.str(o.StopPrice)
+		order.TakeProfitPrice = c.str(o.Price)
+	case "STOP_MARKET", "STOP":
+		order.StopLossTriggerPrice = c.str(o.StopPrice)
+		order.StopLossPrice = c.str(o.Price)
+	case "TRAILING_STOP_MARKET":
+		order.CallbackRate = c.str(o.PriceRate)
+		order.ActivationPrice = c.str(o.StopPrice)
+	default:
+		order.TriggerPrice = c.str(o.StopPrice)
+		order.OrderPrice = c.str(o.Price)
+	}
+	return order
+}
+
+// ConvertConditionalOrderType converts a BingX order type to the common
+// conditional order type. Reports false for regular orders.
+func (c *Converter) ConvertConditionalOrderType(s string) (commontypes.ConditionalOrderType, bool) {
+	switch s {
+	case "TRIGGER_MARKET", "TRIGGER_LIMIT":
+		return commontypes.ConditionalOrderTypeTrigger, true
+	case "STOP_MARKET", "STOP", "TAKE_PROFIT_MARKET", "TAKE_PROFIT":
+		return commontypes.ConditionalOrderTypeTPSL, true
+	case "TRAILING_STOP_MARKET":
+		return commontypes.ConditionalOrderTypeTrailing, true
+	default:
+		return "", false
+	}
+}
+
+// ConvertWorkingType converts a BingX workingType to the common trigger price type
+func (c *Converter) ConvertWorkingType(s string) commontypes.TriggerPriceType {
+	switch s {
+	case "MARK_PRICE":
+		return commontypes.TriggerPriceTypeMark
+	case "INDEX_PRICE":
+		return commontypes.TriggerPriceTypeIndex
+	default:
+		return commontypes.TriggerPriceTypeLast
+	}
+}
+
+// ToWorkingType converts a common trigger price type to a BingX workingType
+func (c *Converter) ToWorkingType(t commontypes.TriggerPriceType) string {
+	switch t {
+	case commontypes.TriggerPriceTypeMark:
+		return "MARK_PRICE"
+	case commontypes.TriggerPriceTypeIndex:
+		return "INDEX_PRICE"
+	default:
+		return "CONTRACT_PRICE"
+	}
+}
+
+// ToTimeInForce converts a common time in force to a BingX timeInForce.
+// Market orders only accept GTC or IOC.
+func (c *Converter) ToTimeInForce(orderType string, tif commontypes.TimeInForce) (string, error) {
+	var v string
+	switch tif {
+	case commontypes.TimeInForceGTC:
+		v = "GTC"
+	case commontypes.TimeInForceIOC:
+		v = "IOC"
+	case commontypes.TimeInForceFOK:
+		v = "FOK"
+	case commontypes.TimeInForceGTX:
+		v = "PostOnly"
+	default:
+		return "", fmt.Errorf("bingx: time in force %s: %w", tif, commontypes.ErrNotSupported)
+	}
+	if strings.EqualFold(orderType, "market") && v != "GTC" && v != "IOC" {
+		return "", fmt.Errorf("bingx: market order with time in force %s: %w", tif, commontypes.ErrNotSupported)
+	}
+	return v, nil
+}
+
+// ConvertFill converts BingX FillData to the common Trade type.
+// BingX reports commission as a negative amount; the sign is flipped so Fee is the amount paid.
+func (c *Converter) ConvertFill(f *rest.FillData) *commontypes.Trade {
+	if f == nil {
+		return nil
+	}
+	fee, _ := c.str(f.Commission).Neg()
+	return &commontypes.Trade{
+		ID:          f.TradeID,
+		OrderID:     f.OrderID,
+		Symbol:      f.Symbol,
+		Side:        c.ConvertOrderSide(f.Side),
+		Price:       c.str(f.Price),
+		Quantity:    c.str(f.Qty),
+		Fee:         fee,
+		FeeCurrency: f.CommissionAsset,
+		IsMaker:     f.Role == "maker",
+		Timestamp:   commontypes.Timestamp(c.parseFillTime(f.FilledTm)),
+		Extra: map[string]interface{}{
+			"positionSide": f.PositionSide,
+			"quoteQty":     f.QuoteQty,
+			"realisedPNL":  f.RealisedPNL,
+		},
+	}
+}
+
+// parseFillTime parses a BingX fill time, which is either an ISO-8601 string
+// or a millisecond timestamp. Returns the zero time if the format is unknown.
+func (c *Converter) parseFillTime(s string) time.Time {
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.UnixMilli(ms)
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.000-0700", "2006-01-02T15:04:05-0700"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
 // ConvertOrderSide converts a BingX order side string to common form
 func (c *Converter) ConvertOrderSide(s string) string {
 	switch s {
@@ -276,11 +493,18 @@ func (c *Converter) ConvertInstrument(ci *rest.ContractInfo) *commontypes.Instru
 		InstrumentType:    commontypes.InstrumentSwap,
 		MaxLever:          0,
 		CtVal:             c.str(ci.Size),
-		PricePrecision:    commontypes.NewDecimalFromInt(int64(ci.PricePrecision)),
-		QuantityPrecision: commontypes.NewDecimalFromInt(int64(ci.QuantityPrecision)),
+		MinOrderSize:      commontypes.NewDecimalFromFloat(ci.TradeMinQuantity),
+		MinNotional:       commontypes.NewDecimalFromFloat(ci.TradeMinUSDT),
+		PricePrecision:    precisionStep(ci.PricePrecision),
+		QuantityPrecision: precisionStep(ci.QuantityPrecision),
 	}
 }
 
+// precisionStep converts a number of decimal places to a step size, e.g. 2 -> 0.01
+func precisionStep(places int) commontypes.Decimal {
+	return commontypes.Decimal{Decimal: decimal.New(1, -int32(places))}
+}
+
 // ConvertIntervalToWS maps common interval strings to BingX WebSocket kline interval format
 func (c *Converter) ConvertIntervalToWS(interval string) (string, error) {
 	m := map[string]string{
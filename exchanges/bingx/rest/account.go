@@ -1,6 +1,8 @@
 package rest
 
-import "fmt"
+import (
+	"context"
+)
 
 // Account provides BingX account endpoints
 type Account struct {
@@ -29,13 +31,13 @@ type BalanceResponse struct {
 
 // GetBalance retrieves the perpetual swap account balance
 // GET /openApi/swap/v2/user/balance
-func (a *Account) GetBalance(currency string) (*BalanceResponse, error) {
+func (a *Account) GetBalance(ctx context.Context, currency string) (*BalanceResponse, error) {
 	var result BalanceResponse
 	params := map[string]string{}
 	if currency != "" {
 		params["currency"] = currency
 	}
-	if err := a.client.GET("/openApi/swap/v2/user/balance", params, &result); err != nil {
+	if err := a.client.GET(ctx, "/openApi/swap/v2/user/balance", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -64,13 +66,13 @@ type PositionsResponse struct {
 
 // GetPositions retrieves all open positions (or a specific symbol if provided)
 // GET /openApi/swap/v2/user/positions
-func (a *Account) GetPositions(symbol string) (*PositionsResponse, error) {
+func (a *Account) GetPositions(ctx context.Context, symbol string) (*PositionsResponse, error) {
 	var result PositionsResponse
 	params := map[string]string{}
 	if symbol != "" {
 		params["symbol"] = symbol
 	}
-	if err := a.client.GET("/openApi/swap/v2/user/positions", params, &result); err != nil {
+	if err := a.client.GET(ctx, "/openApi/swap/v2/user/positions", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -92,10 +94,10 @@ type LeverageResponse struct {
 
 // GetLeverage queries the current leverage for a symbol
 // GET /openApi/swap/v2/trade/leverage
-func (a *Account) GetLeverage(symbol string) (*LeverageResponse, error) {
+func (a *Account) GetLeverage(ctx context.Context, symbol string) (*LeverageResponse, error) {
 	var result LeverageResponse
 	params := map[string]string{"symbol": symbol}
-	if err := a.client.GET("/openApi/swap/v2/trade/leverage", params, &result); err != nil {
+	if err := a.client.GET(ctx, "/openApi/swap/v2/trade/leverage", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -117,14 +119,14 @@ type SetLeverageResponse struct {
 
 // SetLeverage sets the leverage for a symbol and side
 // POST /openApi/swap/v2/trade/leverage
-func (a *Account) SetLeverage(symbol, side string, leverage int) (*SetLeverageResponse, error) {
+func (a *Account) SetLeverage(ctx context.Context, symbol, side, leverage string) (*SetLeverageResponse, error) {
 	var result SetLeverageResponse
 	params := map[string]string{
 		"symbol":   symbol,
 		"side":     side,
-		"leverage": fmt.Sprintf("%d", leverage),
+		"leverage": leverage,
 	}
-	if err := a.client.POST("/openApi/swap/v2/trade/leverage", params, &result); err != nil {
+	if err := a.client.POST(ctx, "/openApi/swap/v2/trade/leverage", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -7,25 +7,40 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"io"
+	"log/slog"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/djpken/go-exc/clock"
+	"github.com/djpken/go-exc/metrics"
+	"github.com/djpken/go-exc/middleware"
+	"github.com/djpken/go-exc/ratelimit"
+	"github.com/djpken/go-exc/retry"
 )
 
 const (
 	defaultBaseURL = "https://open-api.bingx.com"
 	testBaseURL    = "https://open-api.bingx.com" // BingX simulation trading uses the same URL with demo account credentials
+
+	// exchangeName is the exchange label of middleware requests and metrics
+	exchangeName = "bingx"
 )
 
 // ClientRest is the BingX REST API client
 type ClientRest struct {
-	ctx        context.Context
 	httpClient *http.Client
 	apiKey     string
 	secretKey  string
 	baseURL    string
+	userAgent  string
+	limiter    *ratelimit.Limiter
+	retry      retry.Policy
+	clock      *clock.Clock
+	logger     *slog.Logger
+	middleware []middleware.Middleware
+	metrics    metrics.Recorder
 
 	Market  *Market
 	Account *Account
@@ -41,18 +56,21 @@ type Response[T any] struct {
 }
 
 // NewClientRest creates a new BingX REST client
-func NewClientRest(ctx context.Context, apiKey, secretKey, baseURL string) *ClientRest {
+func NewClientRest(apiKey, secretKey, baseURL string) *ClientRest {
 	if baseURL == "" {
 		baseURL = defaultBaseURL
 	}
 	c := &ClientRest{
-		ctx:       ctx,
 		apiKey:    apiKey,
 		secretKey: secretKey,
 		baseURL:   baseURL,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		limiter: ratelimit.New(RateLimits),
+		retry:   retry.DefaultPolicy,
+		logger:  slog.New(slog.DiscardHandler),
+		metrics: metrics.Nop{},
 	}
 	c.Market = NewMarket(c)
 	c.Account = NewAccount(c)
@@ -60,6 +78,53 @@ func NewClientRest(ctx context.Context, apiKey, secretKey, baseURL string) *Clie
 	return c
 }
 
+// SetHTTPClient sets the HTTP client used for all requests
+func (c *ClientRest) SetHTTPClient(client *http.Client) {
+	c.httpClient = client
+}
+
+// SetUserAgent sets the User-Agent header sent with every request
+func (c *ClientRest) SetUserAgent(userAgent string) {
+	c.userAgent = userAgent
+}
+
+// SetClock sets the clock used to timestamp signed requests. A nil clock
+// signs with the local time.
+func (c *ClientRest) SetClock(clk *clock.Clock) {
+	c.clock = clk
+}
+
+// Clock returns the clock used to timestamp signed requests, e.g. to monitor
+// its offset and latency
+func (c *ClientRest) Clock() *clock.Clock {
+	return c.clock
+}
+
+// SetLogger sets the logger receiving retries and rate-limit rejections.
+// A nil logger discards them.
+func (c *ClientRest) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	c.logger = logger
+}
+
+// SetMiddleware sets the middleware run around every request, the first
+// being the outermost
+func (c *ClientRest) SetMiddleware(middlewares ...middleware.Middleware) {
+	c.middleware = middlewares
+}
+
+// SetMetrics sets the recorder of rate-limit waits. A nil recorder records nothing.
+func (c *ClientRest) SetMetrics(recorder metrics.Recorder) {
+	c.metrics = metrics.OrNop(recorder)
+}
+
+// SetRetryPolicy sets the policy used to retry transient failures
+func (c *ClientRest) SetRetryPolicy(policy retry.Policy) {
+	c.retry = policy
+}
+
 // sign computes the HMAC-SHA256 hex signature for the given query string
 func (c *ClientRest) sign(queryString string) string {
 	mac := hmac.New(sha256.New, []byte(c.secretKey))
@@ -67,67 +132,122 @@ func (c *ClientRest) sign(queryString string) string {
 	return hex.EncodeToString(mac.Sum(nil))
 }
 
-// timestamp returns current Unix millisecond timestamp as string
-func timestamp() string {
-	return strconv.FormatInt(time.Now().UnixMilli(), 10)
+// timestamp returns the current server time as a Unix millisecond timestamp string
+func (c *ClientRest) timestamp() (string, error) {
+	now, err := c.clock.SignTime()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(now.UnixMilli(), 10), nil
 }
 
 // GET performs an authenticated GET request.
 // params should be passed as key=value pairs without timestamp/signature.
-func (c *ClientRest) GET(path string, params map[string]string, result interface{}) error {
-	return c.do(http.MethodGet, path, params, result)
+func (c *ClientRest) GET(ctx context.Context, path string, params map[string]string, result interface{}) error {
+	return c.do(ctx, http.MethodGet, path, params, result)
 }
 
 // POST performs an authenticated POST request.
-func (c *ClientRest) POST(path string, params map[string]string, result interface{}) error {
-	return c.do(http.MethodPost, path, params, result)
+func (c *ClientRest) POST(ctx context.Context, path string, params map[string]string, result interface{}) error {
+	return c.do(ctx, http.MethodPost, path, params, result)
 }
 
 // PUT performs an authenticated PUT request (params sent as URL query string).
-func (c *ClientRest) PUT(path string, params map[string]string, result interface{}) error {
-	return c.do(http.MethodPut, path, params, result)
+func (c *ClientRest) PUT(ctx context.Context, path string, params map[string]string, result interface{}) error {
+	return c.do(ctx, http.MethodPut, path, params, result)
 }
 
 // DELETE performs an authenticated DELETE request.
-func (c *ClientRest) DELETE(path string, params map[string]string, result interface{}) error {
-	return c.do(http.MethodDelete, path, params, result)
+func (c *ClientRest) DELETE(ctx context.Context, path string, params map[string]string, result interface{}) error {
+	return c.do(ctx, http.MethodDelete, path, params, result)
 }
 
 // GETPublic performs an unauthenticated GET request (for public endpoints)
-func (c *ClientRest) GETPublic(path string, params map[string]string, result interface{}) error {
+func (c *ClientRest) GETPublic(ctx context.Context, path string, params map[string]string, result interface{}) error {
 	qs := buildQueryString(params)
 	url := c.baseURL + path
 	if qs != "" {
 		url += "?" + qs
 	}
 
-	req, err := http.NewRequestWithContext(c.ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return fmt.Errorf("bingx: create request: %w", err)
+	return c.withRetry(ctx, http.MethodGet, path, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return fmt.Errorf("bingx: create request: %w", err)
+		}
+		return c.executeAndDecode(req, false, params, nil, result)
+	})
+}
+
+func (c *ClientRest) do(ctx context.Context, method, path string, params map[string]string, result interface{}) error {
+	return c.withRetry(ctx, method, path, func() error {
+		return c.send(ctx, method, path, params, result)
+	})
+}
+
+// withRetry calls send, retrying GET requests on transient failures
+// according to the retry policy
+func (c *ClientRest) withRetry(ctx context.Context, method, path string, send func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := send()
+		if err == nil || method != http.MethodGet || !c.retry.Retry(attempt) || !retry.IsTransient(err) {
+			return err
+		}
+		c.logger.Warn("retrying request", "path", path, "attempt", attempt, "error", err)
+		if sleepErr := c.retry.Sleep(ctx, attempt); sleepErr != nil {
+			return err
+		}
 	}
+}
 
-	return c.executeAndDecode(req, result)
+// submitOrder calls submit and, for orders with a client order ID, retries it
+// on transient failures once lookup confirms the failed attempt did not place
+// the order. When lookup finds the order, it is returned with a nil error.
+func (c *ClientRest) submitOrder(ctx context.Context, clientOrderID string, submit func() error, lookup func(context.Context) (*OrderData, bool, error)) (*OrderData, error) {
+	for attempt := 1; ; attempt++ {
+		err := submit()
+		if err == nil || clientOrderID == "" || !c.retry.Retry(attempt) || !retry.IsTransient(err) {
+			return nil, err
+		}
+		c.logger.Warn("order failed, confirming before retry", "clientOrderId", clientOrderID, "attempt", attempt, "error", err)
+		if sleepErr := c.retry.Sleep(ctx, attempt); sleepErr != nil {
+			return nil, err
+		}
+		order, found, lookupErr := lookup(ctx)
+		if lookupErr != nil {
+			return nil, err
+		}
+		if found {
+			return order, nil
+		}
+	}
 }
 
-func (c *ClientRest) do(method, path string, params map[string]string, result interface{}) error {
-	if params == nil {
-		params = make(map[string]string)
+// send signs and executes a single request
+func (c *ClientRest) send(ctx context.Context, method, path string, reqParams map[string]string, result interface{}) error {
+	params := make(map[string]string, len(reqParams)+2)
+	for k, v := range reqParams {
+		params[k] = v
 	}
-	params["timestamp"] = timestamp()
+	ts, err := c.timestamp()
+	if err != nil {
+		return err
+	}
+	params["timestamp"] = ts
 
 	qs := buildQueryString(params)
 	params["signature"] = c.sign(qs)
 	qs = buildQueryString(params)
 
 	var req *http.Request
-	var err error
-
+	var body []byte
 	url := c.baseURL + path
 	if method == http.MethodGet || method == http.MethodDelete || method == http.MethodPut {
-		req, err = http.NewRequestWithContext(c.ctx, method, url+"?"+qs, nil)
+		req, err = http.NewRequestWithContext(ctx, method, url+"?"+qs, nil)
 	} else {
 		// POST: send params as URL-encoded body
-		req, err = http.NewRequestWithContext(c.ctx, method, url, strings.NewReader(qs))
+		body = []byte(qs)
+		req, err = http.NewRequestWithContext(ctx, method, url, strings.NewReader(qs))
 		if err == nil {
 			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 		}
@@ -138,35 +258,66 @@ func (c *ClientRest) do(method, path string, params map[string]string, result in
 
 	req.Header.Set("X-BX-APIKEY", c.apiKey)
 
-	return c.executeAndDecode(req, result)
+	return c.executeAndDecode(req, true, params, body, result)
 }
 
-func (c *ClientRest) executeAndDecode(req *http.Request, result interface{}) error {
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("bingx: http request: %w", err)
+// executeAndDecode runs req through the middleware chain and decodes the
+// response into result
+func (c *ClientRest) executeAndDecode(req *http.Request, private bool, params map[string]string, reqBody []byte, result interface{}) error {
+	group := rateLimitGroup(req.URL.Path)
+	if err := c.wait(req.Context(), group); err != nil {
+		return fmt.Errorf("bingx: %w", err)
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("bingx: read response: %w", err)
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
 	}
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("bingx: http %d: %s", resp.StatusCode, body)
+	mwReq := &middleware.Request{
+		Exchange: exchangeName,
+		Method:   req.Method,
+		Endpoint: req.URL.Path,
+		Private:  private,
+		Params:   params,
+		Body:     reqBody,
+		Header:   req.Header,
+	}
+	mwResp, err := middleware.Chain(middleware.Send(c.httpClient, req), c.middleware...)(req.Context(), mwReq)
+	if err != nil {
+		err = fmt.Errorf("bingx: http request: %w", err)
+		if retry.Transient(nil, err) {
+			return retry.MarkTransient(err)
+		}
+		return err
 	}
+	resp := mwResp.HTTPResponse(req)
+	c.observeRateLimit(group, resp)
+	body := mwResp.Body
 
-	// Check business error code
+	// Check status and business error code
 	var envelope struct {
 		Code int    `json:"code"`
 		Msg  string `json:"msg"`
 	}
-	if err := json.Unmarshal(body, &envelope); err != nil {
-		return fmt.Errorf("bingx: decode envelope: %w", err)
+	decodeErr := json.Unmarshal(body, &envelope)
+	if resp.StatusCode != http.StatusOK {
+		if decodeErr != nil || envelope.Code == 0 {
+			envelope.Msg = string(body)
+		}
+		apiErr := newAPIError(resp.StatusCode, envelope.Code, envelope.Msg)
+		if apiErr.Retryable || retry.Transient(resp, nil) {
+			return retry.MarkTransient(apiErr)
+		}
+		return apiErr
+	}
+	if decodeErr != nil {
+		return fmt.Errorf("bingx: decode envelope: %w", decodeErr)
 	}
 	if envelope.Code != 0 {
-		return fmt.Errorf("bingx: api error %d: %s", envelope.Code, envelope.Msg)
+		apiErr := newAPIError(resp.StatusCode, envelope.Code, envelope.Msg)
+		if apiErr.Retryable {
+			return retry.MarkTransient(apiErr)
+		}
+		return apiErr
 	}
 
 	if result != nil {
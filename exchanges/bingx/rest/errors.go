@@ -0,0 +1,33 @@
+package rest
+
+import (
+	commontypes "github.com/djpken/go-exc/types"
+)
+
+// errorCodes maps BingX error codes onto the common errors
+//
+// https://bingx-api.github.io/docs/#/en-us/swapV2/base-info.html
+var errorCodes = map[int]commontypes.ErrorCode{
+	// Authentication
+	100001: {Err: commontypes.ErrAuthentication}, // Signature verification failed
+	100413: {Err: commontypes.ErrAuthentication}, // Incorrect API key
+	100419: {Err: commontypes.ErrAuthentication}, // IP is not on the API key whitelist
+
+	// Rate limits and availability
+	100410: {Err: commontypes.ErrRateLimitExceeded, Retryable: true},   // Rate limit reached
+	100500: {Err: commontypes.ErrExchangeUnavailable, Retryable: true}, // Internal system error
+	100503: {Err: commontypes.ErrExchangeUnavailable, Retryable: true}, // Server busy
+	80012:  {Err: commontypes.ErrExchangeUnavailable, Retryable: true}, // Service unavailable
+
+	// Orders
+	80014:  {Err: commontypes.ErrInvalidOrder},        // Invalid order parameter
+	80016:  {Err: commontypes.ErrOrderNotFound},       // Order does not exist
+	109400: {Err: commontypes.ErrInvalidSymbol},       // Symbol does not exist
+	101204: {Err: commontypes.ErrInsufficientBalance}, // Insufficient margin
+	110424: {Err: commontypes.ErrInvalidOrder},        // Order size below the minimum
+}
+
+// newAPIError returns the APIError of a failed request
+func newAPIError(httpStatus, code int, msg string) *commontypes.APIError {
+	return commontypes.NewAPIError("BingX", httpStatus, code, msg, errorCodes)
+}
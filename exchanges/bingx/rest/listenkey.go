@@ -1,5 +1,7 @@
 package rest
 
+import "context"
+
 // ListenKeyData holds the listen key returned by the BingX API.
 type ListenKeyData struct {
 	ListenKey string `json:"listenKey"`
@@ -7,24 +9,24 @@ type ListenKeyData struct {
 
 // CreateListenKey creates a new listen key for private WebSocket connections.
 // The key is valid for 1 hour; call ExtendListenKey every 30 minutes to keep it alive.
-func (c *ClientRest) CreateListenKey() (string, error) {
+func (c *ClientRest) CreateListenKey(ctx context.Context) (string, error) {
 	var resp Response[ListenKeyData]
-	if err := c.POST("/openApi/user/auth/userDataStream", nil, &resp); err != nil {
+	if err := c.POST(ctx, "/openApi/user/auth/userDataStream", nil, &resp); err != nil {
 		return "", err
 	}
 	return resp.Data.ListenKey, nil
 }
 
 // ExtendListenKey resets the 60-minute expiry timer for an existing listen key.
-func (c *ClientRest) ExtendListenKey(listenKey string) error {
-	return c.PUT("/openApi/user/auth/userDataStream", map[string]string{
+func (c *ClientRest) ExtendListenKey(ctx context.Context, listenKey string) error {
+	return c.PUT(ctx, "/openApi/user/auth/userDataStream", map[string]string{
 		"listenKey": listenKey,
 	}, nil)
 }
 
 // DeleteListenKey invalidates a listen key and closes the associated private stream.
-func (c *ClientRest) DeleteListenKey(listenKey string) error {
-	return c.DELETE("/openApi/user/auth/userDataStream", map[string]string{
+func (c *ClientRest) DeleteListenKey(ctx context.Context, listenKey string) error {
+	return c.DELETE(ctx, "/openApi/user/auth/userDataStream", map[string]string{
 		"listenKey": listenKey,
 	}, nil)
 }
@@ -1,6 +1,9 @@
 package rest
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Market provides BingX market data endpoints
 type Market struct {
@@ -43,10 +46,10 @@ type TickersResponse struct {
 
 // GetTicker retrieves 24hr ticker statistics for a symbol
 // GET /openApi/swap/v2/quote/ticker
-func (m *Market) GetTicker(symbol string) (*TickerResponse, error) {
+func (m *Market) GetTicker(ctx context.Context, symbol string) (*TickerResponse, error) {
 	var result TickerResponse
 	params := map[string]string{"symbol": symbol}
-	if err := m.client.GETPublic("/openApi/swap/v2/quote/ticker", params, &result); err != nil {
+	if err := m.client.GETPublic(ctx, "/openApi/swap/v2/quote/ticker", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -54,9 +57,9 @@ func (m *Market) GetTicker(symbol string) (*TickerResponse, error) {
 
 // GetTickers retrieves 24hr ticker statistics for all symbols
 // GET /openApi/swap/v2/quote/ticker (no symbol param)
-func (m *Market) GetTickers() (*TickersResponse, error) {
+func (m *Market) GetTickers(ctx context.Context) (*TickersResponse, error) {
 	var result TickersResponse
-	if err := m.client.GETPublic("/openApi/swap/v2/quote/ticker", nil, &result); err != nil {
+	if err := m.client.GETPublic(ctx, "/openApi/swap/v2/quote/ticker", nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -77,13 +80,13 @@ type OrderBookResponse struct {
 
 // GetOrderBook retrieves order book depth
 // GET /openApi/swap/v2/quote/depth
-func (m *Market) GetOrderBook(symbol string, limit int) (*OrderBookResponse, error) {
+func (m *Market) GetOrderBook(ctx context.Context, symbol string, limit int) (*OrderBookResponse, error) {
 	var result OrderBookResponse
 	params := map[string]string{"symbol": symbol}
 	if limit > 0 {
 		params["limit"] = fmt.Sprintf("%d", limit)
 	}
-	if err := m.client.GETPublic("/openApi/swap/v2/quote/depth", params, &result); err != nil {
+	if err := m.client.GETPublic(ctx, "/openApi/swap/v2/quote/depth", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -108,7 +111,7 @@ type KlinesResponse struct {
 // GetKlines retrieves candlestick/kline data (v3)
 // GET /openApi/swap/v3/quote/klines
 // timeZone: 0 = UTC+0, 8 = UTC+8 (default 8)
-func (m *Market) GetKlines(symbol, interval string, startTime, endTime int64, limit int, timeZone int32) (*KlinesResponse, error) {
+func (m *Market) GetKlines(ctx context.Context, symbol, interval string, startTime, endTime int64, limit int, timeZone int32) (*KlinesResponse, error) {
 	var result KlinesResponse
 	params := map[string]string{
 		"symbol":   symbol,
@@ -124,7 +127,96 @@ func (m *Market) GetKlines(symbol, interval string, startTime, endTime int64, li
 	if limit > 0 {
 		params["limit"] = fmt.Sprintf("%d", limit)
 	}
-	if err := m.client.GETPublic("/openApi/swap/v3/quote/klines", params, &result); err != nil {
+	if err := m.client.GETPublic(ctx, "/openApi/swap/v3/quote/klines", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// TradeEntry represents a single public trade
+type TradeEntry struct {
+	Time         int64  `json:"time"`
+	IsBuyerMaker bool   `json:"isBuyerMaker"`
+	Price        string `json:"price"`
+	Qty          string `json:"qty"`
+	QuoteQty     string `json:"quoteQty"`
+}
+
+// TradesResponse is the full API response for recent trades
+type TradesResponse struct {
+	Code int          `json:"code"`
+	Data []TradeEntry `json:"data"`
+}
+
+// GetTrades retrieves the most recent public trades
+// GET /openApi/swap/v2/quote/trades
+func (m *Market) GetTrades(ctx context.Context, symbol string, limit int) (*TradesResponse, error) {
+	var result TradesResponse
+	params := map[string]string{"symbol": symbol}
+	if limit > 0 {
+		params["limit"] = fmt.Sprintf("%d", limit)
+	}
+	if err := m.client.GETPublic(ctx, "/openApi/swap/v2/quote/trades", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PremiumIndexData holds the mark price and funding rate of a contract
+type PremiumIndexData struct {
+	Symbol          string `json:"symbol"`
+	MarkPrice       string `json:"markPrice"`
+	IndexPrice      string `json:"indexPrice"`
+	LastFundingRate string `json:"lastFundingRate"`
+	NextFundingTime int64  `json:"nextFundingTime"` // Milliseconds
+}
+
+// PremiumIndexResponse is the full API response for the premium index of a symbol
+type PremiumIndexResponse struct {
+	Code int              `json:"code"`
+	Data PremiumIndexData `json:"data"`
+}
+
+// GetPremiumIndex retrieves the mark price, index price and funding rate of a symbol
+// GET /openApi/swap/v2/quote/premiumIndex
+func (m *Market) GetPremiumIndex(ctx context.Context, symbol string) (*PremiumIndexResponse, error) {
+	var result PremiumIndexResponse
+	params := map[string]string{"symbol": symbol}
+	if err := m.client.GETPublic(ctx, "/openApi/swap/v2/quote/premiumIndex", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// FundingRateEntry represents a settled funding rate
+type FundingRateEntry struct {
+	Symbol      string `json:"symbol"`
+	FundingRate string `json:"fundingRate"`
+	FundingTime int64  `json:"fundingTime"` // Milliseconds
+}
+
+// FundingRatesResponse is the full API response for the funding rate history
+type FundingRatesResponse struct {
+	Code int                `json:"code"`
+	Data []FundingRateEntry `json:"data"`
+}
+
+// GetFundingRates retrieves settled funding rates. startTime and endTime are in
+// milliseconds, zero to omit.
+// GET /openApi/swap/v2/quote/fundingRate
+func (m *Market) GetFundingRates(ctx context.Context, symbol string, startTime, endTime int64, limit int) (*FundingRatesResponse, error) {
+	var result FundingRatesResponse
+	params := map[string]string{"symbol": symbol}
+	if startTime > 0 {
+		params["startTime"] = fmt.Sprintf("%d", startTime)
+	}
+	if endTime > 0 {
+		params["endTime"] = fmt.Sprintf("%d", endTime)
+	}
+	if limit > 0 {
+		params["limit"] = fmt.Sprintf("%d", limit)
+	}
+	if err := m.client.GETPublic(ctx, "/openApi/swap/v2/quote/fundingRate", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -165,9 +257,30 @@ type ContractsResponse struct {
 
 // GetContracts retrieves all perpetual swap contract specifications
 // GET /openApi/swap/v2/quote/contracts
-func (m *Market) GetContracts() (*ContractsResponse, error) {
+func (m *Market) GetContracts(ctx context.Context) (*ContractsResponse, error) {
 	var result ContractsResponse
-	if err := m.client.GETPublic("/openApi/swap/v2/quote/contracts", nil, &result); err != nil {
+	if err := m.client.GETPublic(ctx, "/openApi/swap/v2/quote/contracts", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ServerTimeData is the data field for a server time response
+type ServerTimeData struct {
+	ServerTime int64 `json:"serverTime"` // Milliseconds
+}
+
+// ServerTimeResponse is the full API response for the server time
+type ServerTimeResponse struct {
+	Code int            `json:"code"`
+	Data ServerTimeData `json:"data"`
+}
+
+// GetServerTime retrieves the server time
+// GET /openApi/swap/v2/server/time
+func (m *Market) GetServerTime(ctx context.Context) (*ServerTimeResponse, error) {
+	var result ServerTimeResponse
+	if err := m.client.GETPublic(ctx, "/openApi/swap/v2/server/time", nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
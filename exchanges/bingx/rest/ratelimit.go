@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/djpken/go-exc/ratelimit"
+)
+
+// Rate limit groups of the BingX REST API. Market data is limited per IP,
+// trade and account endpoints per UID.
+const (
+	RateLimitGroupMarket  = "market"
+	RateLimitGroupTrade   = "trade"
+	RateLimitGroupAccount = "account"
+)
+
+// RateLimits are the BingX per-group rate limits
+//
+// https://bingx-api.github.io/docs/#/en-us/swapV2/base-info.html
+var RateLimits = map[string]ratelimit.Rule{
+	RateLimitGroupMarket:  {Limit: 100, Window: 10 * time.Second},
+	RateLimitGroupTrade:   {Limit: 200, Window: 10 * time.Second},
+	RateLimitGroupAccount: {Limit: 100, Window: 10 * time.Second},
+}
+
+// SetRateLimiter replaces the rate limiter. A limiter created with
+// ratelimit.New(nil) disables limiting.
+func (c *ClientRest) SetRateLimiter(limiter *ratelimit.Limiter) {
+	c.limiter = limiter
+}
+
+// RateLimiter returns the rate limiter, e.g. to monitor its headroom
+func (c *ClientRest) RateLimiter() *ratelimit.Limiter {
+	return c.limiter
+}
+
+// wait blocks until a request can be sent on group, recording any wait
+func (c *ClientRest) wait(ctx context.Context, group string) error {
+	d, err := c.limiter.WaitTimed(ctx, group, 1)
+	if d > 0 {
+		c.metrics.RateLimitWait(exchangeName, group, d)
+	}
+	return err
+}
+
+// rateLimitGroup returns the RateLimits group of an endpoint path
+func rateLimitGroup(path string) string {
+	switch {
+	case strings.Contains(path, "/quote/"), strings.Contains(path, "/server/"):
+		return RateLimitGroupMarket
+	case strings.Contains(path, "/trade/"):
+		return RateLimitGroupTrade
+	default:
+		return RateLimitGroupAccount
+	}
+}
+
+// observeRateLimit syncs the limiter with the X-RateLimit-Requests-Remain
+// response header and blocks the group after an HTTP 429
+func (c *ClientRest) observeRateLimit(group string, resp *http.Response) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		c.logger.Warn("rate limit exceeded", "endpoint", group)
+		c.limiter.Sync(group, 0, 0)
+		return
+	}
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Requests-Remain")); err == nil {
+		c.limiter.Sync(group, remaining, 0)
+	}
+}
@@ -1,6 +1,13 @@
 package rest
 
-import "fmt"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	commontypes "github.com/djpken/go-exc/types"
+)
 
 // Trade provides BingX trading endpoints
 type Trade struct {
@@ -29,6 +36,7 @@ type OrderData struct {
 	Time          int64  `json:"time"`
 	UpdateTime    int64  `json:"updateTime"`
 	WorkingType   string `json:"workingType"`
+	PriceRate     string `json:"priceRate"`
 }
 
 // PlaceOrderResponseData is the data returned when placing an order
@@ -42,11 +50,14 @@ type PlaceOrderResponse struct {
 	Data PlaceOrderResponseData `json:"data"`
 }
 
-// PlaceOrder places a new perpetual swap order
+// PlaceOrder places a new perpetual swap order. price and quantity are sent
+// verbatim and omitted when empty. Orders with a clientOrderID are retried on
+// transient failures once GetOrder confirms the failed attempt did not place them.
 // POST /openApi/swap/v2/trade/order
 func (t *Trade) PlaceOrder(
+	ctx context.Context,
 	symbol, side, positionSide, orderType string,
-	price, quantity float64,
+	price, quantity string,
 	clientOrderID string,
 	extra map[string]string,
 ) (*PlaceOrderResponse, error) {
@@ -58,11 +69,11 @@ func (t *Trade) PlaceOrder(
 	if positionSide != "" {
 		params["positionSide"] = positionSide
 	}
-	if price > 0 {
-		params["price"] = fmt.Sprintf("%f", price)
+	if price != "" {
+		params["price"] = price
 	}
-	if quantity > 0 {
-		params["quantity"] = fmt.Sprintf("%f", quantity)
+	if quantity != "" {
+		params["quantity"] = quantity
 	}
 	if clientOrderID != "" {
 		params["clientOrderID"] = clientOrderID
@@ -72,12 +83,36 @@ func (t *Trade) PlaceOrder(
 	}
 
 	var result PlaceOrderResponse
-	if err := t.client.POST("/openApi/swap/v2/trade/order", params, &result); err != nil {
+	order, err := t.client.submitOrder(ctx, clientOrderID, func() error {
+		return t.client.POST(ctx, "/openApi/swap/v2/trade/order", params, &result)
+	}, func(ctx context.Context) (*OrderData, bool, error) {
+		return t.findOrder(ctx, symbol, clientOrderID)
+	})
+	if err != nil {
 		return nil, err
 	}
+	if order != nil {
+		result.Data.Order = *order
+	}
 	return &result, nil
 }
 
+// findOrder looks up an order by its client order ID. It reports found=false
+// only when BingX confirms the order does not exist.
+func (t *Trade) findOrder(ctx context.Context, symbol, clientOrderID string) (*OrderData, bool, error) {
+	resp, err := t.GetOrder(ctx, symbol, 0, clientOrderID)
+	if errors.Is(err, commontypes.ErrOrderNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if resp.Data.OrderID == 0 {
+		return nil, false, fmt.Errorf("bingx: order %s: empty lookup result", clientOrderID)
+	}
+	return &resp.Data, true, nil
+}
+
 // QueryOrderResponse is the full API response for querying an order
 type QueryOrderResponse struct {
 	Code int       `json:"code"`
@@ -86,7 +121,7 @@ type QueryOrderResponse struct {
 
 // GetOrder queries a single order by orderID or clientOrderID
 // GET /openApi/swap/v2/trade/order
-func (t *Trade) GetOrder(symbol string, orderID int64, clientOrderID string) (*QueryOrderResponse, error) {
+func (t *Trade) GetOrder(ctx context.Context, symbol string, orderID int64, clientOrderID string) (*QueryOrderResponse, error) {
 	params := map[string]string{"symbol": symbol}
 	if orderID > 0 {
 		params["orderId"] = fmt.Sprintf("%d", orderID)
@@ -96,7 +131,7 @@ func (t *Trade) GetOrder(symbol string, orderID int64, clientOrderID string) (*Q
 	}
 
 	var result QueryOrderResponse
-	if err := t.client.GET("/openApi/swap/v2/trade/order", params, &result); err != nil {
+	if err := t.client.GET(ctx, "/openApi/swap/v2/trade/order", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -110,7 +145,7 @@ type CancelOrderResponse struct {
 
 // CancelOrder cancels an open order
 // DELETE /openApi/swap/v2/trade/order
-func (t *Trade) CancelOrder(symbol string, orderID int64, clientOrderID string) (*CancelOrderResponse, error) {
+func (t *Trade) CancelOrder(ctx context.Context, symbol string, orderID int64, clientOrderID string) (*CancelOrderResponse, error) {
 	params := map[string]string{"symbol": symbol}
 	if orderID > 0 {
 		params["orderId"] = fmt.Sprintf("%d", orderID)
@@ -120,7 +155,241 @@ func (t *Trade) CancelOrder(symbol string, orderID int64, clientOrderID string)
 	}
 
 	var result CancelOrderResponse
-	if err := t.client.DELETE("/openApi/swap/v2/trade/order", params, &result); err != nil {
+	if err := t.client.DELETE(ctx, "/openApi/swap/v2/trade/order", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CancelReplaceResponseData is the data returned by a cancel-and-replace request
+type CancelReplaceResponseData struct {
+	CancelResult     string    `json:"cancelResult"`
+	CancelMsg        string    `json:"cancelMsg"`
+	CancelResponse   OrderData `json:"cancelResponse"`
+	ReplaceResult    string    `json:"replaceResult"`
+	ReplaceMsg       string    `json:"replaceMsg"`
+	NewOrderResponse OrderData `json:"newOrderResponse"`
+}
+
+// CancelReplaceResponse is the full API response for a cancel-and-replace request
+type CancelReplaceResponse struct {
+	Code int                       `json:"code"`
+	Data CancelReplaceResponseData `json:"data"`
+}
+
+// CancelReplace cancels an open order and places a new one in a single request.
+// The new order is only placed if the cancel succeeds (STOP_ON_FAILURE).
+// POST /openApi/swap/v1/trade/cancelReplace
+func (t *Trade) CancelReplace(
+	ctx context.Context,
+	symbol string,
+	cancelOrderID int64, cancelClientOrderID string,
+	side, positionSide, orderType string,
+	price, quantity string,
+	clientOrderID string,
+) (*CancelReplaceResponse, error) {
+	params := map[string]string{
+		"cancelReplaceMode": "STOP_ON_FAILURE",
+		"symbol":            symbol,
+		"side":              side,
+		"type":              orderType,
+	}
+	if cancelOrderID > 0 {
+		params["cancelOrderId"] = fmt.Sprintf("%d", cancelOrderID)
+	}
+	if cancelClientOrderID != "" {
+		params["cancelClientOrderID"] = cancelClientOrderID
+	}
+	if positionSide != "" {
+		params["positionSide"] = positionSide
+	}
+	if price != "" {
+		params["price"] = price
+	}
+	if quantity != "" {
+		params["quantity"] = quantity
+	}
+	if clientOrderID != "" {
+		params["clientOrderID"] = clientOrderID
+	}
+
+	var result CancelReplaceResponse
+	if err := t.client.POST(ctx, "/openApi/swap/v1/trade/cancelReplace", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// OrdersResponseData wraps a list of orders
+type OrdersResponseData struct {
+	Orders []OrderData `json:"orders"`
+}
+
+// OrdersResponse is the full API response for order list queries
+type OrdersResponse struct {
+	Code int                `json:"code"`
+	Data OrdersResponseData `json:"data"`
+}
+
+// GetOpenOrders lists all open orders, optionally filtered by symbol
+// GET /openApi/swap/v2/trade/openOrders
+func (t *Trade) GetOpenOrders(ctx context.Context, symbol string) (*OrdersResponse, error) {
+	params := map[string]string{}
+	if symbol != "" {
+		params["symbol"] = symbol
+	}
+
+	var result OrdersResponse
+	if err := t.client.GET(ctx, "/openApi/swap/v2/trade/openOrders", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAllOrders lists orders of any status within a time window (max 7 days).
+// startTime and endTime are in milliseconds; zero values are omitted.
+// GET /openApi/swap/v2/trade/allOrders
+func (t *Trade) GetAllOrders(ctx context.Context, symbol string, startTime, endTime int64, limit int) (*OrdersResponse, error) {
+	params := map[string]string{}
+	if symbol != "" {
+		params["symbol"] = symbol
+	}
+	if startTime > 0 {
+		params["startTime"] = fmt.Sprintf("%d", startTime)
+	}
+	if endTime > 0 {
+		params["endTime"] = fmt.Sprintf("%d", endTime)
+	}
+	if limit > 0 {
+		params["limit"] = fmt.Sprintf("%d", limit)
+	}
+
+	var result OrdersResponse
+	if err := t.client.GET(ctx, "/openApi/swap/v2/trade/allOrders", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// FillData holds a single own trade execution
+type FillData struct {
+	Symbol          string `json:"symbol"`
+	OrderID         string `json:"orderId"`
+	TradeID         string `json:"tradeId"`
+	Side            string `json:"side"`
+	PositionSide    string `json:"positionSide"`
+	Role            string `json:"role"` // maker or taker
+	Price           string `json:"price"`
+	Qty             string `json:"qty"`
+	QuoteQty        string `json:"quoteQty"`
+	Commission      string `json:"commission"`
+	CommissionAsset string `json:"commissionAsset"`
+	RealisedPNL     string `json:"realisedPNL"`
+	FilledTm        string `json:"filledTm"`
+}
+
+// FillHistoryResponseData wraps a list of fills
+type FillHistoryResponseData struct {
+	FillHistoryOrders []FillData `json:"fill_history_orders"`
+}
+
+// FillHistoryResponse is the full API response for the fill history query
+type FillHistoryResponse struct {
+	Code int                     `json:"code"`
+	Data FillHistoryResponseData `json:"data"`
+}
+
+// GetFillHistory lists own trade executions within a time window of at most 7 days.
+// startTs and endTs are in milliseconds and required.
+// GET /openApi/swap/v1/trade/fillHistory
+func (t *Trade) GetFillHistory(ctx context.Context, symbol, orderID string, startTs, endTs int64, pageSize int) (*FillHistoryResponse, error) {
+	params := map[string]string{
+		"startTs": fmt.Sprintf("%d", startTs),
+		"endTs":   fmt.Sprintf("%d", endTs),
+	}
+	if symbol != "" {
+		params["symbol"] = symbol
+	}
+	if orderID != "" {
+		params["orderId"] = orderID
+	}
+	if pageSize > 0 {
+		params["pageSize"] = fmt.Sprintf("%d", pageSize)
+	}
+
+	var result FillHistoryResponse
+	if err := t.client.GET(ctx, "/openApi/swap/v1/trade/fillHistory", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CancelFailure describes an order that could not be cancelled in a batch request
+type CancelFailure struct {
+	OrderID      int64  `json:"orderId"`
+	ErrorCode    int    `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// BatchCancelResponseData is the data returned by batch and cancel-all requests
+type BatchCancelResponseData struct {
+	Success []OrderData     `json:"success"`
+	Failed  []CancelFailure `json:"failed"`
+}
+
+// BatchCancelResponse is the full API response for batch and cancel-all requests
+type BatchCancelResponse struct {
+	Code int                     `json:"code"`
+	Data BatchCancelResponseData `json:"data"`
+}
+
+// CancelOrders cancels up to 10 open orders of one symbol
+// DELETE /openApi/swap/v2/trade/batchOrders
+func (t *Trade) CancelOrders(ctx context.Context, symbol string, orderIDs []int64) (*BatchCancelResponse, error) {
+	ids, err := json.Marshal(orderIDs)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{
+		"symbol":      symbol,
+		"orderIdList": string(ids),
+	}
+
+	var result BatchCancelResponse
+	if err := t.client.DELETE(ctx, "/openApi/swap/v2/trade/batchOrders", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CancelAllOrders cancels all open orders, optionally filtered by symbol
+// DELETE /openApi/swap/v2/trade/allOpenOrders
+func (t *Trade) CancelAllOrders(ctx context.Context, symbol string) (*BatchCancelResponse, error) {
+	params := map[string]string{}
+	if symbol != "" {
+		params["symbol"] = symbol
+	}
+
+	var result BatchCancelResponse
+	if err := t.client.DELETE(ctx, "/openApi/swap/v2/trade/allOpenOrders", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ClosePositionResponse is the full API response for closing a position
+type ClosePositionResponse struct {
+	Code int       `json:"code"`
+	Data OrderData `json:"data"`
+}
+
+// ClosePosition closes a whole position at market by its position ID
+// POST /openApi/swap/v1/trade/closePosition
+func (t *Trade) ClosePosition(ctx context.Context, positionID string) (*ClosePositionResponse, error) {
+	params := map[string]string{"positionId": positionID}
+
+	var result ClosePositionResponse
+	if err := t.client.POST(ctx, "/openApi/swap/v1/trade/closePosition", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
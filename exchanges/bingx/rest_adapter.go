@@ -2,8 +2,12 @@ package bingx
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/djpken/go-exc/exchanges/bingx/rest"
 	commontypes "github.com/djpken/go-exc/types"
@@ -38,16 +42,16 @@ type MarketAPIAdapter struct {
 	converter *Converter
 }
 
-func (a *MarketAPIAdapter) GetTicker(_ context.Context, symbol string) (*commontypes.Ticker, error) {
-	resp, err := a.client.Market.GetTicker(symbol)
+func (a *MarketAPIAdapter) GetTicker(ctx context.Context, symbol string) (*commontypes.Ticker, error) {
+	resp, err := a.client.Market.GetTicker(ctx, symbol)
 	if err != nil {
 		return nil, err
 	}
 	return a.converter.ConvertTicker(&resp.Data), nil
 }
 
-func (a *MarketAPIAdapter) GetTickers(_ context.Context) ([]*commontypes.Ticker, error) {
-	resp, err := a.client.Market.GetTickers()
+func (a *MarketAPIAdapter) GetTickers(ctx context.Context) ([]*commontypes.Ticker, error) {
+	resp, err := a.client.Market.GetTickers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -58,15 +62,15 @@ func (a *MarketAPIAdapter) GetTickers(_ context.Context) ([]*commontypes.Ticker,
 	return tickers, nil
 }
 
-func (a *MarketAPIAdapter) GetOrderBook(_ context.Context, symbol string, depth int) (*commontypes.OrderBook, error) {
-	resp, err := a.client.Market.GetOrderBook(symbol, depth)
+func (a *MarketAPIAdapter) GetOrderBook(ctx context.Context, symbol string, depth int) (*commontypes.OrderBook, error) {
+	resp, err := a.client.Market.GetOrderBook(ctx, symbol, depth)
 	if err != nil {
 		return nil, err
 	}
 	return a.converter.ConvertOrderBook(&resp.Data, symbol), nil
 }
 
-func (a *MarketAPIAdapter) GetCandles(_ context.Context, req commontypes.GetCandlesRequest) ([]*commontypes.Candle, error) {
+func (a *MarketAPIAdapter) GetCandles(ctx context.Context, req commontypes.GetCandlesRequest) ([]*commontypes.Candle, error) {
 	interval, err := a.converter.ConvertIntervalToREST(req.Interval)
 	if err != nil {
 		return nil, err
@@ -80,7 +84,7 @@ func (a *MarketAPIAdapter) GetCandles(_ context.Context, req commontypes.GetCand
 		endMs = req.EndTime.UnixMilli()
 	}
 
-	resp, err := a.client.Market.GetKlines(req.Symbol, interval, startMs, endMs, req.Limit, 0)
+	resp, err := a.client.Market.GetKlines(ctx, req.Symbol, interval, startMs, endMs, req.Limit, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -92,8 +96,65 @@ func (a *MarketAPIAdapter) GetCandles(_ context.Context, req commontypes.GetCand
 	return candles, nil
 }
 
-func (a *MarketAPIAdapter) GetInstruments(_ context.Context) ([]*commontypes.Instrument, error) {
-	resp, err := a.client.Market.GetContracts()
+// GetRecentTrades gets the most recent public trades, newest first
+func (a *MarketAPIAdapter) GetRecentTrades(ctx context.Context, symbol string, limit int) ([]*commontypes.Trade, error) {
+	resp, err := a.client.Market.GetTrades(ctx, symbol, limit)
+	if err != nil {
+		return nil, err
+	}
+	trades := make([]*commontypes.Trade, 0, len(resp.Data))
+	for i := range resp.Data {
+		trades = append(trades, a.converter.ConvertTrade(&resp.Data[i], symbol))
+	}
+	sort.SliceStable(trades, func(i, j int) bool {
+		return trades[i].Timestamp.Time().After(trades[j].Timestamp.Time())
+	})
+	return trades, nil
+}
+
+// GetFundingRate gets the current funding rate of a perpetual contract
+func (a *MarketAPIAdapter) GetFundingRate(ctx context.Context, symbol string) (*commontypes.FundingRate, error) {
+	resp, err := a.client.Market.GetPremiumIndex(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+	return a.converter.ConvertPremiumIndexFundingRate(&resp.Data), nil
+}
+
+// GetFundingRateHistory gets settled funding rates of a perpetual contract, newest first
+func (a *MarketAPIAdapter) GetFundingRateHistory(ctx context.Context, req commontypes.GetFundingRateHistoryRequest) ([]*commontypes.FundingRate, error) {
+	var startTime, endTime int64
+	if req.StartTime != nil {
+		startTime = req.StartTime.UnixMilli()
+	}
+	if req.EndTime != nil {
+		endTime = req.EndTime.UnixMilli()
+	}
+	resp, err := a.client.Market.GetFundingRates(ctx, req.Symbol, startTime, endTime, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]*commontypes.FundingRate, 0, len(resp.Data))
+	for i := range resp.Data {
+		rates = append(rates, a.converter.ConvertFundingRateEntry(&resp.Data[i]))
+	}
+	sort.SliceStable(rates, func(i, j int) bool {
+		return rates[i].FundingTime.Time().After(rates[j].FundingTime.Time())
+	})
+	return rates, nil
+}
+
+// GetMarkPrice gets the mark and index prices of a contract
+func (a *MarketAPIAdapter) GetMarkPrice(ctx context.Context, symbol string) (*commontypes.MarkPrice, error) {
+	resp, err := a.client.Market.GetPremiumIndex(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+	return a.converter.ConvertPremiumIndexMarkPrice(&resp.Data), nil
+}
+
+func (a *MarketAPIAdapter) GetInstruments(ctx context.Context) ([]*commontypes.Instrument, error) {
+	resp, err := a.client.Market.GetContracts(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -111,24 +172,24 @@ type AccountAPIAdapter struct {
 	converter *Converter
 }
 
-func (a *AccountAPIAdapter) GetBalance(_ context.Context, currencies ...string) (*commontypes.AccountBalance, error) {
+func (a *AccountAPIAdapter) GetBalance(ctx context.Context, currencies ...string) (*commontypes.AccountBalance, error) {
 	currency := ""
 	if len(currencies) > 0 {
 		currency = currencies[0]
 	}
-	resp, err := a.client.Account.GetBalance(currency)
+	resp, err := a.client.Account.GetBalance(ctx, currency)
 	if err != nil {
 		return nil, err
 	}
 	return a.converter.ConvertBalance(&resp.Data), nil
 }
 
-func (a *AccountAPIAdapter) GetPositions(_ context.Context, symbols ...string) ([]*commontypes.Position, error) {
+func (a *AccountAPIAdapter) GetPositions(ctx context.Context, symbols ...string) ([]*commontypes.Position, error) {
 	symbol := ""
 	if len(symbols) == 1 {
 		symbol = symbols[0]
 	}
-	resp, err := a.client.Account.GetPositions(symbol)
+	resp, err := a.client.Account.GetPositions(ctx, symbol)
 	if err != nil {
 		return nil, err
 	}
@@ -139,10 +200,10 @@ func (a *AccountAPIAdapter) GetPositions(_ context.Context, symbols ...string) (
 	return positions, nil
 }
 
-func (a *AccountAPIAdapter) GetLeverage(_ context.Context, symbols []string) ([]*commontypes.Leverage, error) {
+func (a *AccountAPIAdapter) GetLeverage(ctx context.Context, symbols []string) ([]*commontypes.Leverage, error) {
 	var all []*commontypes.Leverage
 	for _, sym := range symbols {
-		resp, err := a.client.Account.GetLeverage(sym)
+		resp, err := a.client.Account.GetLeverage(ctx, sym)
 		if err != nil {
 			return nil, fmt.Errorf("bingx: get leverage for %s: %w", sym, err)
 		}
@@ -151,7 +212,11 @@ func (a *AccountAPIAdapter) GetLeverage(_ context.Context, symbols []string) ([]
 	return all, nil
 }
 
-func (a *AccountAPIAdapter) SetLeverage(_ context.Context, req commontypes.SetLeverageRequest) (*commontypes.Leverage, error) {
+func (a *AccountAPIAdapter) SetLeverage(ctx context.Context, req commontypes.SetLeverageRequest) (*commontypes.Leverage, error) {
+	if !req.Leverage.IsInteger() {
+		return nil, fmt.Errorf("bingx: fractional leverage %s: %w", req.Leverage, commontypes.ErrNotSupported)
+	}
+
 	side := "LONG"
 	switch req.PosSide {
 	case commontypes.PositionSideShort:
@@ -161,7 +226,7 @@ func (a *AccountAPIAdapter) SetLeverage(_ context.Context, req commontypes.SetLe
 		side = "LONG"
 	}
 
-	resp, err := a.client.Account.SetLeverage(req.Symbol, side, req.Leverage)
+	resp, err := a.client.Account.SetLeverage(ctx, req.Symbol, side, req.Leverage.String())
 	if err != nil {
 		return nil, err
 	}
@@ -187,23 +252,50 @@ type TradeAPIAdapter struct {
 	converter *Converter
 }
 
-func (a *TradeAPIAdapter) PlaceOrder(_ context.Context, req commontypes.PlaceOrderRequest) (*commontypes.Order, error) {
+func (a *TradeAPIAdapter) PlaceOrder(ctx context.Context, req commontypes.PlaceOrderRequest) (*commontypes.Order, error) {
 	side := string(req.Side)
-	positionSide := ""
-	switch req.PosSide {
-	case commontypes.PositionSideLong:
-		positionSide = "LONG"
-	case commontypes.PositionSideShort:
-		positionSide = "SHORT"
-	case commontypes.PositionSideNet:
-		positionSide = "BOTH"
+
+	extra := map[string]string{}
+	tif, err := commontypes.OrderTimeInForce(req)
+	if err != nil {
+		return nil, fmt.Errorf("bingx: %w", err)
+	}
+	if tif != "" {
+		if extra["timeInForce"], err = a.converter.ToTimeInForce(req.Type, tif); err != nil {
+			return nil, err
+		}
+	}
+	if req.ReduceOnly {
+		switch toPositionSide(req.PosSide) {
+		case "LONG", "SHORT":
+			// Hedge mode has no reduceOnly flag; closing orders never open a position
+			if (req.PosSide == commontypes.PositionSideLong) == (req.Side == commontypes.OrderSideBuy) {
+				return nil, fmt.Errorf("bingx: reduce-only %s order would open a %s position", req.Side, req.PosSide)
+			}
+		default:
+			extra["reduceOnly"] = "true"
+		}
+	}
+	if req.TakeProfit != nil {
+		leg, err := a.attachedOrderParam(req.TakeProfit, "TAKE_PROFIT")
+		if err != nil {
+			return nil, err
+		}
+		extra["takeProfit"] = leg
+	}
+	if req.StopLoss != nil {
+		leg, err := a.attachedOrderParam(req.StopLoss, "STOP")
+		if err != nil {
+			return nil, err
+		}
+		extra["stopLoss"] = leg
 	}
 
 	resp, err := a.client.Trade.PlaceOrder(
-		req.Symbol, side, positionSide, req.Type,
-		req.Price, req.Quantity,
+		ctx, req.Symbol, side, toPositionSide(req.PosSide), req.Type,
+		decimalParam(req.Price), decimalParam(req.Quantity),
 		req.ClientOrderID,
-		nil,
+		extra,
 	)
 	if err != nil {
 		return nil, err
@@ -211,6 +303,51 @@ func (a *TradeAPIAdapter) PlaceOrder(_ context.Context, req commontypes.PlaceOrd
 	return a.converter.ConvertOrder(&resp.Data.Order), nil
 }
 
+// attachedOrderParam encodes an attached TP/SL leg as the JSON object BingX
+// expects in the takeProfit/stopLoss parameters. baseType is TAKE_PROFIT or
+// STOP; the _MARKET variant is used when the leg has no order price.
+func (a *TradeAPIAdapter) attachedOrderParam(leg *commontypes.AttachedOrder, baseType string) (string, error) {
+	if !leg.TriggerPrice.IsPositive() {
+		return "", fmt.Errorf("bingx: attached %s leg requires a trigger price", strings.ToLower(baseType))
+	}
+	param := map[string]interface{}{
+		"type":        baseType + "_MARKET",
+		"stopPrice":   leg.TriggerPrice.String(),
+		"workingType": a.converter.ToWorkingType(leg.TriggerPriceType),
+	}
+	if leg.OrderPrice.IsPositive() {
+		param["type"] = baseType
+		param["price"] = leg.OrderPrice.String()
+	}
+	b, err := json.Marshal(param)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// toPositionSide converts a common position side to a BingX positionSide
+func toPositionSide(posSide commontypes.PositionSide) string {
+	switch posSide {
+	case commontypes.PositionSideLong:
+		return "LONG"
+	case commontypes.PositionSideShort:
+		return "SHORT"
+	case commontypes.PositionSideNet:
+		return "BOTH"
+	default:
+		return ""
+	}
+}
+
+// decimalParam formats d as a request parameter, or returns "" when d is zero
+func decimalParam(d commontypes.Decimal) string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.String()
+}
+
 // PlaceSingleOrder places exactly one order and wraps the result in PlaceOrderResult.
 func (a *TradeAPIAdapter) PlaceSingleOrder(ctx context.Context, req commontypes.PlaceOrderRequest) (*commontypes.PlaceOrderResult, error) {
 	order, err := a.PlaceOrder(ctx, req)
@@ -231,7 +368,7 @@ func (a *TradeAPIAdapter) PlaceMultiOrder(ctx context.Context, reqs []commontype
 	return results, nil
 }
 
-func (a *TradeAPIAdapter) CancelOrder(_ context.Context, symbol, orderID string, _ map[string]interface{}) error {
+func (a *TradeAPIAdapter) CancelOrder(ctx context.Context, symbol, orderID string, _ map[string]interface{}) error {
 	var oid int64
 	if orderID != "" {
 		var err error
@@ -240,11 +377,491 @@ func (a *TradeAPIAdapter) CancelOrder(_ context.Context, symbol, orderID string,
 			return fmt.Errorf("bingx: invalid orderId %q: %w", orderID, err)
 		}
 	}
-	_, err := a.client.Trade.CancelOrder(symbol, oid, "")
+	_, err := a.client.Trade.CancelOrder(ctx, symbol, oid, "")
 	return err
 }
 
-func (a *TradeAPIAdapter) GetOrderDetail(_ context.Context, req commontypes.GetOrderRequest) (*commontypes.Order, error) {
+// CancelOrders cancels orders through the batch endpoint, grouped by symbol in
+// chunks of 10. A failed request marks every order of that chunk as failed.
+func (a *TradeAPIAdapter) CancelOrders(ctx context.Context, reqs []commontypes.CancelOrderRequest) ([]*commontypes.CancelOrderResult, error) {
+	const batchSize = 10
+
+	results := make([]*commontypes.CancelOrderResult, len(reqs))
+	bySymbol := make(map[string][]int)
+	var symbols []string
+	for i, req := range reqs {
+		results[i] = &commontypes.CancelOrderResult{Symbol: req.Symbol, OrderID: req.OrderID}
+		if _, err := strconv.ParseInt(req.OrderID, 10, 64); err != nil {
+			results[i].Error = fmt.Errorf("bingx: invalid orderId %q: %w", req.OrderID, err)
+			continue
+		}
+		if _, ok := bySymbol[req.Symbol]; !ok {
+			symbols = append(symbols, req.Symbol)
+		}
+		bySymbol[req.Symbol] = append(bySymbol[req.Symbol], i)
+	}
+
+	for _, symbol := range symbols {
+		idx := bySymbol[symbol]
+		for start := 0; start < len(idx); start += batchSize {
+			chunk := idx[start:min(start+batchSize, len(idx))]
+			ids := make([]int64, len(chunk))
+			for j, i := range chunk {
+				ids[j], _ = strconv.ParseInt(reqs[i].OrderID, 10, 64)
+			}
+
+			resp, err := a.client.Trade.CancelOrders(ctx, symbol, ids)
+			if err != nil {
+				for _, i := range chunk {
+					results[i].Error = err
+				}
+				continue
+			}
+
+			failed := make(map[string]error, len(resp.Data.Failed))
+			for _, f := range resp.Data.Failed {
+				failed[strconv.FormatInt(f.OrderID, 10)] = fmt.Errorf("bingx: cancel failed %d: %s", f.ErrorCode, f.ErrorMessage)
+			}
+			for _, i := range chunk {
+				results[i].Error = failed[reqs[i].OrderID]
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// CancelAllOrders cancels all open swap orders, optionally filtered by symbol.
+// instType is ignored since BingX only trades perpetual swaps through this client.
+func (a *TradeAPIAdapter) CancelAllOrders(ctx context.Context, symbol string, _ commontypes.InstrumentType) ([]*commontypes.CancelOrderResult, error) {
+	resp, err := a.client.Trade.CancelAllOrders(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*commontypes.CancelOrderResult, 0, len(resp.Data.Success)+len(resp.Data.Failed))
+	for _, o := range resp.Data.Success {
+		results = append(results, &commontypes.CancelOrderResult{
+			Symbol:        o.Symbol,
+			OrderID:       strconv.FormatInt(o.OrderID, 10),
+			ClientOrderID: o.ClientOrderID,
+		})
+	}
+	for _, f := range resp.Data.Failed {
+		results = append(results, &commontypes.CancelOrderResult{
+			Symbol:  symbol,
+			OrderID: strconv.FormatInt(f.OrderID, 10),
+			Error:   fmt.Errorf("bingx: cancel failed %d: %s", f.ErrorCode, f.ErrorMessage),
+		})
+	}
+	return results, nil
+}
+
+// AmendOrder changes the price and/or quantity of an open order.
+// BingX swap has no in-place amend, so the order is cancelled and replaced
+// atomically via cancelReplace; the result is flagged as Replaced.
+// NewQuantity is the new total quantity, so the replacement is placed for
+// NewQuantity minus the executed quantity. Unset fields fall back to the
+// original price and the remaining unfilled quantity. The replacement carries
+// NewClientOrderID, not the original client order ID.
+func (a *TradeAPIAdapter) AmendOrder(ctx context.Context, req commontypes.AmendOrderRequest) (*commontypes.AmendOrderResult, error) {
+	if !req.NewQuantity.IsPositive() && !req.NewPrice.IsPositive() {
+		return nil, fmt.Errorf("bingx: amend order requires a new quantity or price")
+	}
+	if req.OrderID == "" && req.ClientOrderID == "" {
+		return nil, fmt.Errorf("bingx: amend order requires an orderId or clientOrderID")
+	}
+
+	var oid int64
+	if req.OrderID != "" {
+		var err error
+		oid, err = strconv.ParseInt(req.OrderID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bingx: invalid orderId %q: %w", req.OrderID, err)
+		}
+	}
+
+	// cancelReplace needs the full order spec, so look up the original first
+	orig, err := a.client.Trade.GetOrder(ctx, req.Symbol, oid, req.ClientOrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	price := req.NewPrice
+	if !price.IsPositive() {
+		price = a.converter.str(orig.Data.Price)
+	}
+	total := req.NewQuantity
+	if !total.IsPositive() {
+		total = a.converter.str(orig.Data.OrigQty)
+	}
+	quantity, err := total.Sub(a.converter.str(orig.Data.ExecutedQty))
+	if err != nil {
+		return nil, err
+	}
+	if !quantity.IsPositive() {
+		return nil, fmt.Errorf("bingx: new quantity %s does not exceed executed quantity %s of order %d",
+			total, orig.Data.ExecutedQty, orig.Data.OrderID)
+	}
+
+	resp, err := a.client.Trade.CancelReplace(
+		ctx, req.Symbol, oid, req.ClientOrderID,
+		orig.Data.Side, orig.Data.PositionSide, orig.Data.Type,
+		decimalParam(price), decimalParam(quantity),
+		req.NewClientOrderID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Data.ReplaceResult != "SUCCESS" {
+		return nil, fmt.Errorf("bingx: cancel-replace failed: cancel=%s (%s), replace=%s (%s)",
+			resp.Data.CancelResult, resp.Data.CancelMsg, resp.Data.ReplaceResult, resp.Data.ReplaceMsg)
+	}
+
+	return &commontypes.AmendOrderResult{
+		Order:           a.converter.ConvertOrder(&resp.Data.NewOrderResponse),
+		Replaced:        true,
+		OriginalOrderID: strconv.FormatInt(orig.Data.OrderID, 10),
+	}, nil
+}
+
+// GetOpenOrders lists working orders. BingX has no order ID cursor, so After
+// and Limit are applied client-side.
+func (a *TradeAPIAdapter) GetOpenOrders(ctx context.Context, req commontypes.GetOpenOrdersRequest) ([]*commontypes.Order, error) {
+	resp, err := a.client.Trade.GetOpenOrders(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]*commontypes.Order, 0, len(resp.Data.Orders))
+	for i := range resp.Data.Orders {
+		orders = append(orders, a.converter.ConvertOrder(&resp.Data.Orders[i]))
+	}
+	sortOrdersNewestFirst(orders)
+	return commontypes.PaginateOrders(orders, req.After, req.Limit), nil
+}
+
+// GetOrderHistory lists filled and canceled orders. allOrders also returns
+// working orders, which are filtered out. After is resolved to the cursor
+// order's creation time and used as the end of the time window.
+func (a *TradeAPIAdapter) GetOrderHistory(ctx context.Context, req commontypes.GetOrderHistoryRequest) ([]*commontypes.Order, error) {
+	var startTime, endTime int64
+	if !req.StartTime.IsZero() {
+		startTime = req.StartTime.UnixMilli()
+	}
+	if !req.EndTime.IsZero() {
+		endTime = req.EndTime.UnixMilli()
+	}
+	if req.After != "" {
+		oid, err := strconv.ParseInt(req.After, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bingx: invalid orderId %q: %w", req.After, err)
+		}
+		cursor, err := a.client.Trade.GetOrder(ctx, req.Symbol, oid, "")
+		if err != nil {
+			return nil, err
+		}
+		endTime = cursor.Data.Time
+	}
+
+	resp, err := a.client.Trade.GetAllOrders(ctx, req.Symbol, startTime, endTime, 1000)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]*commontypes.Order, 0, len(resp.Data.Orders))
+	for i := range resp.Data.Orders {
+		o := a.converter.ConvertOrder(&resp.Data.Orders[i])
+		switch o.Status {
+		case commontypes.OrderStatusOpen, commontypes.OrderStatusPartiallyFilled:
+			continue
+		}
+		orders = append(orders, o)
+	}
+	sortOrdersNewestFirst(orders)
+	return commontypes.PaginateOrders(orders, req.After, req.Limit), nil
+}
+
+// GetFills lists own trade executions. The fill history endpoint accepts at
+// most 7 days per request, so the window is walked backwards in 7-day steps;
+// full pages are followed by moving the window end to the oldest fill.
+// Defaults to the last 7 days when StartTime is zero.
+func (a *TradeAPIAdapter) GetFills(ctx context.Context, req commontypes.GetFillsRequest) ([]*commontypes.Trade, error) {
+	const (
+		pageSize  = 1000
+		maxWindow = 7 * 24 * time.Hour
+	)
+
+	end := req.EndTime
+	if end.IsZero() {
+		end = time.Now()
+	}
+	start := req.StartTime
+	if start.IsZero() {
+		start = end.Add(-maxWindow)
+	}
+
+	var fills []*commontypes.Trade
+	seen := make(map[string]bool)
+	for windowEnd := end; windowEnd.After(start); {
+		windowStart := windowEnd.Add(-maxWindow)
+		if windowStart.Before(start) {
+			windowStart = start
+		}
+
+		pageEnd := windowEnd.UnixMilli()
+		for {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			resp, err := a.client.Trade.GetFillHistory(ctx, req.Symbol, req.OrderID, windowStart.UnixMilli(), pageEnd, pageSize)
+			if err != nil {
+				return nil, err
+			}
+
+			page := make([]*commontypes.Trade, 0, len(resp.Data.FillHistoryOrders))
+			for i := range resp.Data.FillHistoryOrders {
+				page = append(page, a.converter.ConvertFill(&resp.Data.FillHistoryOrders[i]))
+			}
+			sort.SliceStable(page, func(i, j int) bool {
+				return page[i].Timestamp.Time().After(page[j].Timestamp.Time())
+			})
+
+			added := 0
+			for _, f := range page {
+				key := f.ID + "/" + f.OrderID
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				added++
+				fills = append(fills, f)
+				if req.Limit > 0 && len(fills) >= req.Limit {
+					return fills, nil
+				}
+			}
+
+			if len(page) < pageSize || added == 0 {
+				break
+			}
+			pageEnd = page[len(page)-1].Timestamp.UnixMilli()
+		}
+
+		windowEnd = windowStart
+	}
+
+	return fills, nil
+}
+
+// sortOrdersNewestFirst sorts orders by creation time, newest first
+func sortOrdersNewestFirst(orders []*commontypes.Order) {
+	sort.SliceStable(orders, func(i, j int) bool {
+		return orders[i].CreatedAt.Time().After(orders[j].CreatedAt.Time())
+	})
+}
+
+// PlaceConditionalOrder places a conditional order as a regular swap order of
+// a conditional type: TRIGGER_MARKET/TRIGGER_LIMIT for trigger orders,
+// TAKE_PROFIT(_MARKET)/STOP(_MARKET) for TP/SL and TRAILING_STOP_MARKET for
+// trailing stops. Each order carries a single TP/SL leg, so take-profit and
+// stop-loss must be placed as separate requests. A TP/SL order without a
+// quantity closes the whole position.
+func (a *TradeAPIAdapter) PlaceConditionalOrder(ctx context.Context, req commontypes.ConditionalOrderRequest) (*commontypes.ConditionalOrder, error) {
+	var orderType string
+	var price commontypes.Decimal
+	extra := map[string]string{
+		"workingType": a.converter.ToWorkingType(req.TriggerPriceType),
+	}
+
+	switch req.Type {
+	case commontypes.ConditionalOrderTypeTrigger:
+		if !req.TriggerPrice.IsPositive() {
+			return nil, fmt.Errorf("bingx: trigger order requires a trigger price")
+		}
+		orderType = "TRIGGER_MARKET"
+		if req.OrderPrice.IsPositive() {
+			orderType = "TRIGGER_LIMIT"
+			price = req.OrderPrice
+		}
+		extra["stopPrice"] = req.TriggerPrice.String()
+	case commontypes.ConditionalOrderTypeTPSL:
+		hasTP := req.TakeProfitTriggerPrice.IsPositive()
+		hasSL := req.StopLossTriggerPrice.IsPositive()
+		if hasTP == hasSL {
+			return nil, fmt.Errorf("bingx: tpsl order requires exactly one of take-profit or stop-loss")
+		}
+		trigger := req.TakeProfitTriggerPrice
+		orderType, price = "TAKE_PROFIT_MARKET", req.TakeProfitPrice
+		if hasSL {
+			trigger = req.StopLossTriggerPrice
+			orderType, price = "STOP_MARKET", req.StopLossPrice
+		}
+		if price.IsPositive() {
+			orderType = strings.TrimSuffix(orderType, "_MARKET")
+		}
+		extra["stopPrice"] = trigger.String()
+		if req.Quantity.IsZero() {
+			extra["closePosition"] = "true"
+		}
+	case commontypes.ConditionalOrderTypeTrailing:
+		if !req.CallbackRate.IsPositive() {
+			return nil, fmt.Errorf("bingx: trailing order requires a callback rate")
+		}
+		orderType = "TRAILING_STOP_MARKET"
+		extra["priceRate"] = req.CallbackRate.String()
+		if req.ActivationPrice.IsPositive() {
+			extra["activationPrice"] = req.ActivationPrice.String()
+		}
+	default:
+		return nil, fmt.Errorf("bingx: unsupported conditional order type %q", req.Type)
+	}
+	if req.ReduceOnly {
+		extra["reduceOnly"] = "true"
+	}
+
+	resp, err := a.client.Trade.PlaceOrder(
+		ctx, req.Symbol, strings.ToUpper(string(req.Side)), toPositionSide(req.PosSide), orderType,
+		decimalParam(price), decimalParam(req.Quantity),
+		req.ClientOrderID,
+		extra,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	order := commontypes.ConditionalOrderFromRequest(req, strconv.FormatInt(resp.Data.Order.OrderID, 10))
+	order.Extra["type"] = orderType
+	return order, nil
+}
+
+// CancelConditionalOrder cancels an untriggered conditional order. BingX
+// conditional orders are regular orders, so this is the same as CancelOrder.
+func (a *TradeAPIAdapter) CancelConditionalOrder(ctx context.Context, req commontypes.CancelConditionalOrderRequest) error {
+	return a.CancelOrder(ctx, req.Symbol, req.OrderID, req.Extra)
+}
+
+// GetConditionalOrders lists untriggered conditional orders by filtering the
+// open orders on their type.
+func (a *TradeAPIAdapter) GetConditionalOrders(ctx context.Context, req commontypes.GetConditionalOrdersRequest) ([]*commontypes.ConditionalOrder, error) {
+	resp, err := a.client.Trade.GetOpenOrders(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	var orders []*commontypes.ConditionalOrder
+	for i := range resp.Data.Orders {
+		o := a.converter.ConvertConditionalOrder(&resp.Data.Orders[i])
+		if o == nil || (req.Type != "" && o.Type != req.Type) {
+			continue
+		}
+		orders = append(orders, o)
+	}
+	return orders, nil
+}
+
+// ClosePosition closes a position. A full close uses the native closePosition
+// endpoint; a partial close sends a market order on the opposite side, marked
+// reduce-only in one-way mode.
+func (a *TradeAPIAdapter) ClosePosition(ctx context.Context, req commontypes.ClosePositionRequest) (*commontypes.ClosePositionResult, error) {
+	resp, err := a.client.Account.GetPositions(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	var pos *rest.PositionData
+	for i := range resp.Data {
+		p := &resp.Data[i]
+		if amt, _ := strconv.ParseFloat(p.PositionAmt, 64); amt == 0 {
+			continue
+		}
+		if req.PosSide != "" && p.PositionSide != toPositionSide(req.PosSide) {
+			continue
+		}
+		if req.MarginMode != "" && p.Isolated != (req.MarginMode == commontypes.MarginModeIsolated) {
+			continue
+		}
+		pos = p
+		break
+	}
+	if pos == nil {
+		return nil, fmt.Errorf("bingx: no open position for %s", req.Symbol)
+	}
+
+	result := &commontypes.ClosePositionResult{
+		Symbol:  pos.Symbol,
+		PosSide: a.converter.ConvertPosition(pos).PosSide,
+	}
+
+	if !req.Quantity.IsPositive() {
+		closeResp, err := a.client.Trade.ClosePosition(ctx, pos.PositionID)
+		if err != nil {
+			return nil, err
+		}
+		result.Order = a.converter.ConvertOrder(&closeResp.Data)
+		return result, nil
+	}
+
+	var side string
+	extra := map[string]string{}
+	switch pos.PositionSide {
+	case "LONG":
+		side = "SELL"
+	case "SHORT":
+		side = "BUY"
+	default:
+		// One-way mode: the sign of positionAmt is the direction of the position
+		side = "SELL"
+		if strings.HasPrefix(pos.PositionAmt, "-") {
+			side = "BUY"
+		}
+		extra["reduceOnly"] = "true"
+	}
+
+	orderResp, err := a.client.Trade.PlaceOrder(
+		ctx, pos.Symbol, side, pos.PositionSide, "MARKET",
+		"", req.Quantity.String(),
+		"",
+		extra,
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.Order = a.converter.ConvertOrder(&orderResp.Data.Order)
+	return result, nil
+}
+
+// CloseAllPositions closes every open position through the native
+// closePosition endpoint, one position at a time.
+func (a *TradeAPIAdapter) CloseAllPositions(ctx context.Context) ([]*commontypes.ClosePositionResult, error) {
+	resp, err := a.client.Account.GetPositions(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*commontypes.ClosePositionResult
+	for i := range resp.Data {
+		p := &resp.Data[i]
+		if amt, _ := strconv.ParseFloat(p.PositionAmt, 64); amt == 0 {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		result := &commontypes.ClosePositionResult{
+			Symbol:  p.Symbol,
+			PosSide: a.converter.ConvertPosition(p).PosSide,
+		}
+		closeResp, err := a.client.Trade.ClosePosition(ctx, p.PositionID)
+		if err != nil {
+			result.Error = err
+		} else {
+			result.Order = a.converter.ConvertOrder(&closeResp.Data)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (a *TradeAPIAdapter) GetOrderDetail(ctx context.Context, req commontypes.GetOrderRequest) (*commontypes.Order, error) {
 	var oid int64
 	if req.OrderID != "" {
 		var err error
@@ -253,7 +870,7 @@ func (a *TradeAPIAdapter) GetOrderDetail(_ context.Context, req commontypes.GetO
 			return nil, fmt.Errorf("bingx: invalid orderId %q: %w", req.OrderID, err)
 		}
 	}
-	resp, err := a.client.Trade.GetOrder(req.Symbol, oid, req.ClientOrderID)
+	resp, err := a.client.Trade.GetOrder(ctx, req.Symbol, oid, req.ClientOrderID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,34 @@
+package bingx
+
+import (
+	"fmt"
+	"strings"
+
+	commontypes "github.com/djpken/go-exc/types"
+)
+
+// SymbolMapper translates canonical symbols to BingX perpetual swap symbols
+// such as BTC-USDT. BingX is only supported for USDT-M perpetual swaps.
+type SymbolMapper struct{}
+
+// NewSymbolMapper creates a new BingX symbol mapper
+func NewSymbolMapper() *SymbolMapper {
+	return &SymbolMapper{}
+}
+
+// ToNative converts a canonical symbol to a BingX symbol
+func (m *SymbolMapper) ToNative(sym commontypes.Symbol) (string, error) {
+	if sym.InstType != commontypes.InstrumentSwap || sym.Settle != sym.Quote {
+		return "", fmt.Errorf("bingx: %s: %w", sym, commontypes.ErrNotSupported)
+	}
+	return sym.Base + "-" + sym.Quote, nil
+}
+
+// FromNative converts a BingX symbol to a canonical symbol
+func (m *SymbolMapper) FromNative(native string, _ commontypes.InstrumentType) (commontypes.Symbol, error) {
+	base, quote, ok := strings.Cut(native, "-")
+	if !ok || base == "" || quote == "" || strings.Contains(quote, "-") {
+		return commontypes.Symbol{}, fmt.Errorf("bingx: unrecognised symbol %q", native)
+	}
+	return commontypes.Symbol{Base: base, Quote: quote, Settle: quote, InstType: commontypes.InstrumentSwap}, nil
+}
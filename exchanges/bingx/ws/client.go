@@ -6,9 +6,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
+	"net/http"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/djpken/go-exc/metrics"
 	"github.com/gorilla/websocket"
 )
 
@@ -17,6 +21,9 @@ const (
 	swapPublicTestURL = "wss://open-api-swap.bingx.com/swap-market" // BingX simulation trading uses the same WS URL with demo account credentials
 	pingInterval      = 20 * time.Second
 	reconnectDelay    = 3 * time.Second
+
+	// exchangeName is the exchange label of metrics
+	exchangeName = "bingx"
 )
 
 // subRequest is the JSON message sent to subscribe/unsubscribe
@@ -33,6 +40,10 @@ type Handler func(data []byte)
 type ClientWs struct {
 	url       string
 	listenKey string // non-empty for private connections
+	dialer    *websocket.Dialer
+	header    http.Header
+	logger    *slog.Logger
+	metrics   metrics.Recorder
 
 	mu       sync.RWMutex
 	conn     *websocket.Conn
@@ -56,14 +67,67 @@ func NewClientWs(baseURL, listenKey string) *ClientWs {
 	return &ClientWs{
 		url:       url,
 		listenKey: listenKey,
+		dialer:    websocket.DefaultDialer,
+		logger:    slog.New(slog.DiscardHandler),
+		metrics:   metrics.Nop{},
 		handlers:  make(map[string]Handler),
 		done:      make(chan struct{}),
 	}
 }
 
+// SetDialer sets a custom dialer for the WebSocket connection
+func (c *ClientWs) SetDialer(dialer *websocket.Dialer) {
+	c.dialer = dialer
+}
+
+// SetUserAgent sets the User-Agent header sent with the WebSocket handshake
+func (c *ClientWs) SetUserAgent(userAgent string) {
+	c.header = http.Header{"User-Agent": []string{userAgent}}
+}
+
+// SetLogger sets the logger receiving connection losses, reconnection failures
+// and undecodable messages. A nil logger discards them.
+func (c *ClientWs) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	c.logger = logger
+}
+
+// Logger returns the logger of the client
+func (c *ClientWs) Logger() *slog.Logger {
+	return c.logger
+}
+
+// SetMetrics sets the recorder of connections, reconnects, messages,
+// subscriptions and dropped updates. A nil recorder records nothing.
+func (c *ClientWs) SetMetrics(recorder metrics.Recorder) {
+	c.metrics = metrics.OrNop(recorder)
+}
+
+// Dropped reports an update of channel dropped because its consumer channel was full
+func (c *ClientWs) Dropped(channel, symbol string) {
+	c.logger.Warn("channel full, dropping update", "channel", channel, "symbol", symbol)
+	c.metrics.Dropped(exchangeName, channelLabel(channel))
+}
+
+// private reports whether the connection is authenticated by a listen key
+func (c *ClientWs) private() bool {
+	return c.listenKey != ""
+}
+
+// channelLabel returns channel without its symbol, e.g. "ticker" for
+// "BTC-USDT@ticker"
+func channelLabel(channel string) string {
+	if idx := strings.Index(channel, "@"); idx != -1 {
+		return channel[idx+1:]
+	}
+	return channel
+}
+
 // Connect establishes the WebSocket connection and starts the read loop
 func (c *ClientWs) Connect() error {
-	conn, _, err := websocket.DefaultDialer.Dial(c.url, nil)
+	conn, _, err := c.dialer.Dial(c.url, c.header)
 	if err != nil {
 		return fmt.Errorf("bingx ws: connect: %w", err)
 	}
@@ -72,6 +136,7 @@ func (c *ClientWs) Connect() error {
 	c.conn = conn
 	c.closed = false
 	c.mu.Unlock()
+	c.metrics.Connection(exchangeName, c.private(), true)
 
 	go c.readLoop()
 	go c.pingLoop()
@@ -94,6 +159,7 @@ func (c *ClientWs) Close() error {
 	}
 	c.closed = true
 	close(c.done)
+	c.metrics.Connection(exchangeName, c.private(), false)
 	if c.conn != nil {
 		return c.conn.Close()
 	}
@@ -116,12 +182,20 @@ func (c *ClientWs) UnregisterHandler(dataType string) {
 
 // Subscribe sends a subscription request for the given dataType
 func (c *ClientWs) Subscribe(dataType string) error {
-	return c.sendMsg("sub", dataType)
+	if err := c.sendMsg("sub", dataType); err != nil {
+		return err
+	}
+	c.metrics.Subscription(exchangeName, c.private(), 1)
+	return nil
 }
 
 // Unsubscribe sends an unsubscription request for the given dataType
 func (c *ClientWs) Unsubscribe(dataType string) error {
-	return c.sendMsg("unsub", dataType)
+	if err := c.sendMsg("unsub", dataType); err != nil {
+		return err
+	}
+	c.metrics.Subscription(exchangeName, c.private(), -1)
+	return nil
 }
 
 func (c *ClientWs) sendMsg(reqType, dataType string) error {
@@ -164,6 +238,8 @@ func (c *ClientWs) readLoop() {
 				return
 			default:
 				// connection error; attempt reconnect
+				c.logger.Warn("connection lost, reconnecting", "error", err)
+				c.metrics.Connection(exchangeName, c.private(), false)
 				c.reconnect()
 				return
 			}
@@ -197,6 +273,7 @@ func (c *ClientWs) dispatch(data []byte) {
 		E        string `json:"e"` // private event type field
 	}
 	if err := json.Unmarshal(data, &envelope); err != nil {
+		c.logger.Warn("failed to decode message", "error", err)
 		return
 	}
 
@@ -204,14 +281,19 @@ func (c *ClientWs) dispatch(data []byte) {
 	if key == "" {
 		key = envelope.E
 	}
+	if key != "" {
+		c.metrics.Message(exchangeName, channelLabel(key))
+	}
 
 	c.mu.RLock()
 	h, ok := c.handlers[key]
 	c.mu.RUnlock()
 
-	if ok {
-		h(data)
+	if !ok {
+		c.logger.Debug("no handler for channel", "channel", key)
+		return
 	}
+	h(data)
 }
 
 func (c *ClientWs) pingLoop() {
@@ -229,7 +311,9 @@ func (c *ClientWs) pingLoop() {
 			if conn == nil || closed {
 				return
 			}
-			_ = conn.WriteMessage(websocket.PingMessage, nil)
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.logger.Warn("failed to send ping", "error", err)
+			}
 		}
 	}
 }
@@ -242,16 +326,20 @@ func (c *ClientWs) reconnect() {
 	default:
 	}
 
-	conn, _, err := websocket.DefaultDialer.Dial(c.url, nil)
+	c.metrics.Reconnect(exchangeName, c.private())
+	conn, _, err := c.dialer.Dial(c.url, c.header)
 	if err != nil {
+		c.logger.Error("reconnect failed", "error", err)
 		return
 	}
 	c.mu.Lock()
 	c.conn = conn
 	c.closed = false
 	c.mu.Unlock()
+	c.metrics.Connection(exchangeName, c.private(), true)
 
-	// Re-subscribe to all registered channels
+	// Re-subscribe to all registered channels, which are still counted as
+	// subscriptions
 	c.mu.RLock()
 	dataTypes := make([]string, 0, len(c.handlers))
 	for dt := range c.handlers {
@@ -260,7 +348,9 @@ func (c *ClientWs) reconnect() {
 	c.mu.RUnlock()
 
 	for _, dt := range dataTypes {
-		_ = c.Subscribe(dt)
+		if err := c.sendMsg("sub", dt); err != nil {
+			c.logger.Error("failed to re-subscribe", "channel", dt, "error", err)
+		}
 	}
 
 	go c.readLoop()
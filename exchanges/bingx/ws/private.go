@@ -2,8 +2,12 @@ package ws
 
 import (
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
+
+	"github.com/djpken/go-exc/metrics"
+	"github.com/gorilla/websocket"
 )
 
 const listenKeyRenewInterval = 30 * time.Minute
@@ -15,6 +19,10 @@ type PrivateClientWs struct {
 	getListenKey    func() (string, error)
 	extendListenKey func(key string) error
 	baseURL         string // WebSocket base URL (empty = default)
+	dialer          *websocket.Dialer
+	userAgent       string
+	logger          *slog.Logger
+	metrics         metrics.Recorder
 
 	mu        sync.Mutex
 	client    *ClientWs
@@ -33,10 +41,67 @@ func NewPrivateClientWs(getKey func() (string, error), extendKey func(key string
 		getListenKey:    getKey,
 		extendListenKey: extendKey,
 		baseURL:         baseURL,
+		logger:          slog.New(slog.DiscardHandler),
+		metrics:         metrics.Nop{},
 		done:            make(chan struct{}),
 	}
 }
 
+// SetDialer sets a custom dialer for the private WebSocket connection
+func (p *PrivateClientWs) SetDialer(dialer *websocket.Dialer) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.dialer = dialer
+}
+
+// SetUserAgent sets the User-Agent header sent with the private WebSocket handshake
+func (p *PrivateClientWs) SetUserAgent(userAgent string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.userAgent = userAgent
+}
+
+// SetLogger sets the logger of the private WebSocket connection and listen key
+// renewal. A nil logger discards their records.
+func (p *PrivateClientWs) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.logger = logger
+	if p.client != nil {
+		p.client.SetLogger(logger)
+	}
+}
+
+// Logger returns the logger of the private WebSocket connection
+func (p *PrivateClientWs) Logger() *slog.Logger {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.logger
+}
+
+// SetMetrics sets the recorder of the private WebSocket connection. A nil
+// recorder records nothing.
+func (p *PrivateClientWs) SetMetrics(recorder metrics.Recorder) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.metrics = metrics.OrNop(recorder)
+	if p.client != nil {
+		p.client.SetMetrics(recorder)
+	}
+}
+
+// Dropped reports an update of channel dropped because its consumer channel was full
+func (p *PrivateClientWs) Dropped(channel, symbol string) {
+	p.mu.Lock()
+	logger, recorder := p.logger, p.metrics
+	p.mu.Unlock()
+	logger.Warn("channel full, dropping update", "channel", channel, "symbol", symbol)
+	recorder.Dropped(exchangeName, channelLabel(channel))
+}
+
 // EnsureConnected lazily connects to the private WebSocket on first call.
 // Subsequent calls are no-ops when the connection is already active.
 func (p *PrivateClientWs) EnsureConnected() error {
@@ -54,6 +119,14 @@ func (p *PrivateClientWs) EnsureConnected() error {
 
 	p.listenKey = key
 	p.client = NewClientWs(p.baseURL, key)
+	if p.dialer != nil {
+		p.client.SetDialer(p.dialer)
+	}
+	if p.userAgent != "" {
+		p.client.SetUserAgent(p.userAgent)
+	}
+	p.client.SetLogger(p.logger)
+	p.client.SetMetrics(p.metrics)
 
 	if err := p.client.Connect(); err != nil {
 		return fmt.Errorf("bingx private ws: connect: %w", err)
@@ -113,9 +186,13 @@ func (p *PrivateClientWs) renewLoop() {
 		case <-ticker.C:
 			p.mu.Lock()
 			key := p.listenKey
+			logger := p.logger
 			p.mu.Unlock()
-			if key != "" {
-				_ = p.extendListenKey(key)
+			if key == "" {
+				continue
+			}
+			if err := p.extendListenKey(key); err != nil {
+				logger.Warn("failed to extend listen key", "error", err)
 			}
 		}
 	}
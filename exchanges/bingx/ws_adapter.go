@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/djpken/go-exc/exchanges/bingx/ws"
+	"github.com/djpken/go-exc/orderbook"
 	commontypes "github.com/djpken/go-exc/types"
 )
 
@@ -17,6 +18,7 @@ type WebSocketAdapter struct {
 
 	tickerChannels map[string]chan *commontypes.TickerUpdate
 	candleChannels map[string]map[string]chan *commontypes.CandleUpdate // interval->symbol->ch
+	bookDataTypes  map[string]string                                   // symbol->depth dataType
 
 	// Private channel fan-out targets (ACCOUNT_UPDATE carries both balance and position data)
 	accountChannel  chan *commontypes.AccountUpdate
@@ -30,6 +32,7 @@ func NewWebSocketAdapter(client *ws.ClientWs, privateClient *ws.PrivateClientWs)
 		converter:      NewConverter(),
 		tickerChannels: make(map[string]chan *commontypes.TickerUpdate),
 		candleChannels: make(map[string]map[string]chan *commontypes.CandleUpdate),
+		bookDataTypes:  make(map[string]string),
 	}
 }
 
@@ -88,6 +91,7 @@ func (a *WebSocketAdapter) SubscribeTickers(userCh chan *commontypes.TickerUpdat
 		a.client.RegisterHandler(dataType, func(data []byte) {
 			var msg tickerMsg
 			if err := json.Unmarshal(data, &msg); err != nil {
+				a.client.Logger().Warn("failed to decode event", "channel", dataType, "symbol", sym, "error", err)
 				return
 			}
 			d := msg.Data
@@ -109,6 +113,7 @@ func (a *WebSocketAdapter) SubscribeTickers(userCh chan *commontypes.TickerUpdat
 			select {
 			case userCh <- update:
 			default:
+				a.client.Dropped(dataType, sym)
 			}
 		})
 
@@ -179,6 +184,7 @@ func (a *WebSocketAdapter) SubscribeCandles(userCh chan *commontypes.CandleUpdat
 		a.client.RegisterHandler(dataType, func(data []byte) {
 			var msg klineMsg
 			if err := json.Unmarshal(data, &msg); err != nil {
+				a.client.Logger().Warn("failed to decode event", "channel", dataType, "symbol", sym, "error", err)
 				return
 			}
 			k := msg.Data.K
@@ -196,6 +202,7 @@ func (a *WebSocketAdapter) SubscribeCandles(userCh chan *commontypes.CandleUpdat
 			select {
 			case userCh <- update:
 			default:
+				a.client.Dropped(dataType, sym)
 			}
 		})
 
@@ -224,6 +231,232 @@ func (a *WebSocketAdapter) UnsubscribeCandles(interval string, symbols ...string
 	return nil
 }
 
+// ─── Order book ──────────────────────────────────────────────────────────────
+
+// depthMsg is the expected structure of a BingX depth WebSocket push: the full
+// top levels of the book, without a sequence number
+type depthMsg struct {
+	DataType string `json:"dataType"`
+	Ts       int64  `json:"ts"`
+	Data     struct {
+		Bids [][]string `json:"bids"`
+		Asks [][]string `json:"asks"`
+	} `json:"data"`
+}
+
+// depthLevel returns the depth stream level covering depth
+func depthLevel(depth int) int {
+	for _, level := range []int{5, 10, 20, 50} {
+		if depth > 0 && depth <= level {
+			return level
+		}
+	}
+	return 100
+}
+
+// SubscribeOrderBook streams the top depth levels of a local order book per symbol.
+// BingX pushes the full top levels every 500ms, the smallest of 5, 10, 20, 50 and 100
+// covering depth; the delta of each update is its difference to the previous push.
+// Pushes carry no sequence number, so Sequence is 0 and missed pushes go undetected.
+func (a *WebSocketAdapter) SubscribeOrderBook(userCh chan *commontypes.OrderBookUpdate, depth int, symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("bingx: no symbols specified")
+	}
+	if !a.client.IsConnected() {
+		if err := a.Connect(); err != nil {
+			return fmt.Errorf("bingx: ws connect: %w", err)
+		}
+	}
+
+	level := depthLevel(depth)
+	for _, symbol := range symbols {
+		dataType := fmt.Sprintf("%s@depth%d@500ms", symbol, level)
+		a.bookDataTypes[symbol] = dataType
+
+		sym := symbol
+		conv := a.converter
+		book := orderbook.New() // handlers run on the read loop goroutine only
+		a.client.RegisterHandler(dataType, func(data []byte) {
+			var msg depthMsg
+			if err := json.Unmarshal(data, &msg); err != nil {
+				a.client.Logger().Warn("failed to decode event", "channel", dataType, "symbol", sym, "error", err)
+				return
+			}
+			snapshot := !book.Synced()
+			delta := book.Snapshot(conv.ConvertOrderBookLevels(msg.Data.Bids), conv.ConvertOrderBookLevels(msg.Data.Asks), 0)
+			bids, asks := book.Top(depth)
+			update := &commontypes.OrderBookUpdate{
+				Symbol:    sym,
+				Bids:      bids,
+				Asks:      asks,
+				Delta:     delta,
+				Snapshot:  snapshot,
+				Timestamp: commontypes.Timestamp(time.UnixMilli(msg.Ts)),
+			}
+			select {
+			case userCh <- update:
+			default:
+				a.client.Dropped(dataType, sym)
+			}
+		})
+
+		if err := a.client.Subscribe(dataType); err != nil {
+			return fmt.Errorf("bingx: subscribe order book %s: %w", sym, err)
+		}
+	}
+	return nil
+}
+
+func (a *WebSocketAdapter) UnsubscribeOrderBook(symbols ...string) error {
+	for _, symbol := range symbols {
+		dataType, ok := a.bookDataTypes[symbol]
+		if !ok {
+			continue
+		}
+		a.client.UnregisterHandler(dataType)
+		if err := a.client.Unsubscribe(dataType); err != nil {
+			return err
+		}
+		delete(a.bookDataTypes, symbol)
+	}
+	return nil
+}
+
+// ─── Trades ──────────────────────────────────────────────────────────────────
+
+// tradeMsg is the expected structure of a BingX trade WebSocket push, a batch
+// of trades without trade IDs
+type tradeMsg struct {
+	DataType string `json:"dataType"`
+	Data     []struct {
+		Q string `json:"q"` // quantity
+		P string `json:"p"` // price
+		T int64  `json:"T"` // trade time ms
+		M bool   `json:"m"` // buyer is maker, i.e. the seller the aggressor
+		S string `json:"s"` // symbol
+	} `json:"data"`
+}
+
+// SubscribeTrades subscribes to public trades. BingX swap trades carry no trade
+// IDs, so Missed is always 0.
+func (a *WebSocketAdapter) SubscribeTrades(userCh chan *commontypes.TradeUpdate, symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("bingx: no symbols specified")
+	}
+	if !a.client.IsConnected() {
+		if err := a.Connect(); err != nil {
+			return fmt.Errorf("bingx: ws connect: %w", err)
+		}
+	}
+
+	for _, symbol := range symbols {
+		dataType := symbol + "@trade"
+
+		sym := symbol // capture
+		a.client.RegisterHandler(dataType, func(data []byte) {
+			var msg tradeMsg
+			if err := json.Unmarshal(data, &msg); err != nil {
+				a.client.Logger().Warn("failed to decode event", "channel", dataType, "symbol", sym, "error", err)
+				return
+			}
+			for _, d := range msg.Data {
+				conv := a.converter
+				update := &commontypes.TradeUpdate{Trade: commontypes.Trade{
+					Symbol:    d.S,
+					Side:      aggressorSide(d.M),
+					Price:     conv.str(d.P),
+					Quantity:  conv.str(d.Q),
+					Timestamp: commontypes.Timestamp(time.UnixMilli(d.T)),
+					Extra:     map[string]interface{}{},
+				}}
+				select {
+				case userCh <- update:
+				default:
+					a.client.Dropped(dataType, sym)
+				}
+			}
+		})
+
+		if err := a.client.Subscribe(dataType); err != nil {
+			return fmt.Errorf("bingx: subscribe trades %s: %w", sym, err)
+		}
+	}
+	return nil
+}
+
+func (a *WebSocketAdapter) UnsubscribeTrades(symbols ...string) error {
+	for _, symbol := range symbols {
+		dataType := symbol + "@trade"
+		a.client.UnregisterHandler(dataType)
+		if err := a.client.Unsubscribe(dataType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// markPriceMsg is the structure of a BingX mark price push
+type markPriceMsg struct {
+	Data struct {
+		E int64  `json:"E"` // event time (ms)
+		S string `json:"s"` // symbol
+		P string `json:"p"` // mark price
+	} `json:"data"`
+}
+
+// SubscribeMarkPrice subscribes to mark prices. BingX pushes no index price, so
+// IndexPrice is always zero.
+func (a *WebSocketAdapter) SubscribeMarkPrice(userCh chan *commontypes.MarkPriceUpdate, symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("bingx: no symbols specified")
+	}
+	if !a.client.IsConnected() {
+		if err := a.Connect(); err != nil {
+			return fmt.Errorf("bingx: ws connect: %w", err)
+		}
+	}
+
+	for _, symbol := range symbols {
+		dataType := symbol + "@markPrice"
+
+		sym := symbol // capture
+		a.client.RegisterHandler(dataType, func(data []byte) {
+			var msg markPriceMsg
+			if err := json.Unmarshal(data, &msg); err != nil {
+				a.client.Logger().Warn("failed to decode event", "channel", dataType, "symbol", sym, "error", err)
+				return
+			}
+			update := &commontypes.MarkPriceUpdate{MarkPrice: commontypes.MarkPrice{
+				Symbol:    msg.Data.S,
+				MarkPrice: a.converter.str(msg.Data.P),
+				Timestamp: commontypes.Timestamp(time.UnixMilli(msg.Data.E)),
+				Extra:     map[string]interface{}{},
+			}}
+			select {
+			case userCh <- update:
+			default:
+				a.client.Dropped(dataType, sym)
+			}
+		})
+
+		if err := a.client.Subscribe(dataType); err != nil {
+			return fmt.Errorf("bingx: subscribe mark price %s: %w", sym, err)
+		}
+	}
+	return nil
+}
+
+func (a *WebSocketAdapter) UnsubscribeMarkPrice(symbols ...string) error {
+	for _, symbol := range symbols {
+		dataType := symbol + "@markPrice"
+		a.client.UnregisterHandler(dataType)
+		if err := a.client.Unsubscribe(dataType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ─── Private channels ────────────────────────────────────────────────────────
 
 // accountUpdateMsg is the structure of a BingX ACCOUNT_UPDATE private push.
@@ -259,6 +492,7 @@ func (a *WebSocketAdapter) registerAccountUpdateHandler() error {
 	return a.privateClient.RegisterHandler("ACCOUNT_UPDATE", func(data []byte) {
 		var msg accountUpdateMsg
 		if err := json.Unmarshal(data, &msg); err != nil {
+			a.privateClient.Logger().Warn("failed to decode event", "channel", "ACCOUNT_UPDATE", "error", err)
 			return
 		}
 
@@ -286,6 +520,7 @@ func (a *WebSocketAdapter) registerAccountUpdateHandler() error {
 			select {
 			case ch <- update:
 			default:
+				a.privateClient.Dropped("account", "")
 			}
 		}
 
@@ -330,6 +565,7 @@ func (a *WebSocketAdapter) registerAccountUpdateHandler() error {
 			select {
 			case ch <- update:
 			default:
+				a.privateClient.Dropped("positions", "")
 			}
 		}
 	})
@@ -350,13 +586,15 @@ type orderTradeUpdateMsg struct {
 		AvgPrice      string `json:"ap"`
 		ExecType      string `json:"x"` // execution type (NEW, TRADE, CANCELED, ...)
 		Status        string `json:"X"` // order status
+		LastPrice     string `json:"L"` // last filled price
 		FilledQty     string `json:"l"` // last filled qty
 		TotalFilled   string `json:"z"` // cumulative filled qty
-		Fee           string `json:"n"`
+		Fee           string `json:"n"` // commission of the last fill, negative when paid
 		FeeAsset      string `json:"N"`
 		TradeTime     int64  `json:"T"`
 		PosSide       string `json:"ps"` // LONG / SHORT / BOTH
 		RealizedPnL   string `json:"rp"`
+		ReduceOnly    bool   `json:"ro"`
 	} `json:"o"`
 }
 
@@ -409,30 +647,37 @@ func (a *WebSocketAdapter) UnsubscribePosition(_ commontypes.WebSocketSubscribeR
 }
 
 // SubscribeOrders registers a handler for ORDER_TRADE_UPDATE events.
+// BingX reports the commission of each fill; it is accumulated per order so
+// that Fee covers all fills, like FilledQuantity.
 func (a *WebSocketAdapter) SubscribeOrders(userCh chan *commontypes.OrderUpdate, _ commontypes.WebSocketSubscribeRequest) error {
 	if a.privateClient == nil {
 		return commontypes.ErrNotSupported
 	}
 	conv := a.converter
+	fees := make(map[int64]commontypes.Decimal) // order ID -> fee paid so far, dropped once the order is done
 	return a.privateClient.RegisterHandler("ORDER_TRADE_UPDATE", func(data []byte) {
 		var msg orderTradeUpdateMsg
 		if err := json.Unmarshal(data, &msg); err != nil {
+			a.privateClient.Logger().Warn("failed to decode event", "channel", "ORDER_TRADE_UPDATE", "error", err)
 			return
 		}
 		o := msg.Order
 		order := &commontypes.Order{
-			ID:             fmt.Sprintf("%d", o.OrderID),
-			Symbol:         o.Symbol,
-			ClientOrderID:  o.ClientOrderID,
-			Side:           conv.ConvertOrderSide(o.Side),
-			Type:           conv.ConvertOrderType(o.Type),
-			Status:         conv.ConvertOrderStatus(o.Status),
-			Price:          conv.str(o.Price),
-			Quantity:       conv.str(o.Quantity),
-			FilledQuantity: conv.str(o.TotalFilled),
-			Fee:            conv.str(o.Fee),
-			FeeCurrency:    o.FeeAsset,
-			UpdatedAt:      commontypes.Timestamp(time.UnixMilli(o.TradeTime)),
+			ID:               fmt.Sprintf("%d", o.OrderID),
+			Symbol:           o.Symbol,
+			ClientOrderID:    o.ClientOrderID,
+			Side:             conv.ConvertOrderSide(o.Side),
+			Type:             conv.ConvertOrderType(o.Type),
+			Status:           conv.ConvertOrderStatus(o.Status),
+			Price:            conv.str(o.Price),
+			Quantity:         conv.str(o.Quantity),
+			FilledQuantity:   conv.str(o.TotalFilled),
+			LastFillQuantity: conv.str(o.FilledQty),
+			LastFillPrice:    conv.str(o.LastPrice),
+			// Closing a hedge-mode position is reduce-only without the flag set
+			ReduceOnly:  o.ReduceOnly || (o.PosSide == "LONG" && o.Side == "SELL") || (o.PosSide == "SHORT" && o.Side == "BUY"),
+			FeeCurrency: o.FeeAsset,
+			UpdatedAt:   commontypes.Timestamp(time.UnixMilli(o.TradeTime)),
 			Extra: map[string]interface{}{
 				"avgPrice":    o.AvgPrice,
 				"execType":    o.ExecType,
@@ -440,6 +685,20 @@ func (a *WebSocketAdapter) SubscribeOrders(userCh chan *commontypes.OrderUpdate,
 				"realizedPnL": o.RealizedPnL,
 			},
 		}
+		if o.LastPrice == "" && o.FilledQty != "" && o.FilledQty == o.TotalFilled {
+			order.LastFillPrice = conv.str(o.AvgPrice) // the only fill so far
+		}
+		if order.RemainingQuantity, _ = order.Quantity.Sub(order.FilledQuantity); order.RemainingQuantity.IsNegative() {
+			order.RemainingQuantity = commontypes.ZeroDecimal
+		}
+		fee, _ := conv.str(o.Fee).Neg()
+		order.Fee, _ = fees[o.OrderID].Add(fee)
+		switch order.Status {
+		case commontypes.OrderStatusFilled, commontypes.OrderStatusCanceled:
+			delete(fees, o.OrderID)
+		default:
+			fees[o.OrderID] = order.Fee
+		}
 		update := &commontypes.OrderUpdate{
 			Orders:    []*commontypes.Order{order},
 			UpdatedAt: commontypes.Timestamp(time.UnixMilli(msg.EventTime)),
@@ -447,6 +706,7 @@ func (a *WebSocketAdapter) SubscribeOrders(userCh chan *commontypes.OrderUpdate,
 		select {
 		case userCh <- update:
 		default:
+			a.privateClient.Dropped("orders", o.Symbol)
 		}
 	})
 }
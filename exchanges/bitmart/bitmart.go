@@ -3,8 +3,10 @@ package bitmart
 import (
 	"context"
 
+	"github.com/djpken/go-exc/clock"
 	"github.com/djpken/go-exc/exchanges/bitmart/rest"
 	"github.com/djpken/go-exc/exchanges/bitmart/ws"
+	"github.com/djpken/go-exc/options"
 	commontypes "github.com/djpken/go-exc/types"
 )
 
@@ -18,10 +20,11 @@ type BitMartExchange struct {
 
 // NewBitMartExchange creates a new BitMart exchange instance
 // Note: testMode parameter is ignored as BitMart doesn't have a separate test server
-func NewBitMartExchange(ctx context.Context, apiKey, secretKey, memo string, testMode bool) (*BitMartExchange, error) {
+// opts configure the HTTP client, endpoints, WebSocket dialer and user agent.
+func NewBitMartExchange(ctx context.Context, apiKey, secretKey, memo string, testMode bool, opts ...options.Option) (*BitMartExchange, error) {
 
 	// Create native BitMart client
-	client, err := NewClient(ctx, apiKey, secretKey, memo, testMode)
+	client, err := NewClient(ctx, apiKey, secretKey, memo, testMode, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,11 +56,16 @@ func (e *BitMartExchange) WebSocket() interface{} {
 	return e.wsAPI
 }
 
+// Clock returns the clock synchronized with the BitMart server time
+func (e *BitMartExchange) Clock() *clock.Clock {
+	return e.client.Rest.Clock()
+}
+
 // Close closes all connections
 func (e *BitMartExchange) Close() error {
-	// Close WebSocket connection if it exists
-	if e.client != nil && e.client.Ws != nil {
-		return e.client.Ws.Close()
+	// Stop the clock synchronization and close the WebSocket connection
+	if e.client != nil {
+		return e.client.Close()
 	}
 	return nil
 }
@@ -108,6 +116,26 @@ func (e *BitMartExchange) GetOrderBook(ctx context.Context, symbol string, depth
 	return e.restAPI.Market().GetOrderBook(ctx, symbol, depth)
 }
 
+// GetRecentTrades gets the most recent public trades
+func (e *BitMartExchange) GetRecentTrades(ctx context.Context, symbol string, limit int) ([]*commontypes.Trade, error) {
+	return e.restAPI.Market().GetRecentTrades(ctx, symbol, limit)
+}
+
+// GetFundingRate gets the current funding rate of a perpetual contract
+func (e *BitMartExchange) GetFundingRate(ctx context.Context, symbol string) (*commontypes.FundingRate, error) {
+	return e.restAPI.Market().GetFundingRate(ctx, symbol)
+}
+
+// GetFundingRateHistory gets settled funding rates of a perpetual contract, newest first
+func (e *BitMartExchange) GetFundingRateHistory(ctx context.Context, req commontypes.GetFundingRateHistoryRequest) ([]*commontypes.FundingRate, error) {
+	return e.restAPI.Market().GetFundingRateHistory(ctx, req)
+}
+
+// GetMarkPrice gets the mark and index prices of a contract
+func (e *BitMartExchange) GetMarkPrice(ctx context.Context, symbol string) (*commontypes.MarkPrice, error) {
+	return e.restAPI.Market().GetMarkPrice(ctx, symbol)
+}
+
 // GetCandles gets historical candlestick/kline data
 func (e *BitMartExchange) GetCandles(ctx context.Context, req commontypes.GetCandlesRequest) ([]*commontypes.Candle, error) {
 	return e.restAPI.Market().GetCandles(ctx, req)
@@ -138,6 +166,16 @@ func (e *BitMartExchange) SetLeverage(ctx context.Context, req commontypes.SetLe
 	return e.restAPI.Account().SetLeverage(ctx, req)
 }
 
+// ClosePosition closes all or part of a position
+func (e *BitMartExchange) ClosePosition(ctx context.Context, req commontypes.ClosePositionRequest) (*commontypes.ClosePositionResult, error) {
+	return e.restAPI.Trade().ClosePosition(ctx, req)
+}
+
+// CloseAllPositions closes every open position
+func (e *BitMartExchange) CloseAllPositions(ctx context.Context) ([]*commontypes.ClosePositionResult, error) {
+	return e.restAPI.Trade().CloseAllPositions(ctx)
+}
+
 // PlaceOrder places a new order
 func (e *BitMartExchange) PlaceOrder(ctx context.Context, req commontypes.PlaceOrderRequest) (*commontypes.Order, error) {
 	if req.Extra == nil {
@@ -164,11 +202,62 @@ func (e *BitMartExchange) CancelOrder(ctx context.Context, req commontypes.Cance
 	return e.restAPI.Trade().CancelOrder(ctx, req.Symbol, req.OrderID, req.Extra)
 }
 
+// CancelOrders cancels multiple orders and returns per-order results
+// Each order is routed to the spot or contract API from its symbol
+func (e *BitMartExchange) CancelOrders(ctx context.Context, reqs []commontypes.CancelOrderRequest) ([]*commontypes.CancelOrderResult, error) {
+	return e.restAPI.Trade().CancelOrders(ctx, reqs)
+}
+
+// CancelAllOrders cancels all open orders
+// Use instType swap/futures or a contract symbol for contract orders; otherwise spot orders are cancelled
+func (e *BitMartExchange) CancelAllOrders(ctx context.Context, symbol string, instType commontypes.InstrumentType) ([]*commontypes.CancelOrderResult, error) {
+	return e.restAPI.Trade().CancelAllOrders(ctx, symbol, instType)
+}
+
+// AmendOrder amends an existing order
+// Spot orders are cancelled and replaced; contract limit orders are modified in place
+func (e *BitMartExchange) AmendOrder(ctx context.Context, req commontypes.AmendOrderRequest) (*commontypes.AmendOrderResult, error) {
+	return e.restAPI.Trade().AmendOrder(ctx, req)
+}
+
 // GetOrderDetail gets order details
 func (e *BitMartExchange) GetOrderDetail(ctx context.Context, req commontypes.GetOrderRequest) (*commontypes.Order, error) {
 	return e.restAPI.Trade().GetOrderDetail(ctx, req)
 }
 
+// GetOpenOrders lists incomplete orders
+// Contract orders are selected with a contract symbol or InstType swap/futures
+func (e *BitMartExchange) GetOpenOrders(ctx context.Context, req commontypes.GetOpenOrdersRequest) ([]*commontypes.Order, error) {
+	return e.restAPI.Trade().GetOpenOrders(ctx, req)
+}
+
+// GetOrderHistory lists completed orders
+// Contract orders are selected with a contract symbol or InstType swap/futures
+func (e *BitMartExchange) GetOrderHistory(ctx context.Context, req commontypes.GetOrderHistoryRequest) ([]*commontypes.Order, error) {
+	return e.restAPI.Trade().GetOrderHistory(ctx, req)
+}
+
+// GetFills lists own trade executions
+// Contract fills are selected with a contract symbol or InstType swap/futures
+func (e *BitMartExchange) GetFills(ctx context.Context, req commontypes.GetFillsRequest) ([]*commontypes.Trade, error) {
+	return e.restAPI.Trade().GetFills(ctx, req)
+}
+
+// PlaceConditionalOrder places a contract plan, TP/SL or trailing stop order
+func (e *BitMartExchange) PlaceConditionalOrder(ctx context.Context, req commontypes.ConditionalOrderRequest) (*commontypes.ConditionalOrder, error) {
+	return e.restAPI.Trade().PlaceConditionalOrder(ctx, req)
+}
+
+// CancelConditionalOrder cancels an untriggered contract conditional order
+func (e *BitMartExchange) CancelConditionalOrder(ctx context.Context, req commontypes.CancelConditionalOrderRequest) error {
+	return e.restAPI.Trade().CancelConditionalOrder(ctx, req)
+}
+
+// GetConditionalOrders lists untriggered contract conditional orders
+func (e *BitMartExchange) GetConditionalOrders(ctx context.Context, req commontypes.GetConditionalOrdersRequest) ([]*commontypes.ConditionalOrder, error) {
+	return e.restAPI.Trade().GetConditionalOrders(ctx, req)
+}
+
 // ========== WebSocket Subscription Methods ==========
 // BitMart WebSocket subscriptions are not supported through the unified interface
 // Use the native WebSocket client directly for BitMart-specific WebSocket features
@@ -193,6 +282,46 @@ func (e *BitMartExchange) UnsubscribeCandles(interval string, symbols ...string)
 	return e.wsAPI.UnsubscribeCandles(interval, symbols...)
 }
 
+// SubscribeOrderBook streams order books for specified symbols via WebSocket
+func (e *BitMartExchange) SubscribeOrderBook(ch chan *commontypes.OrderBookUpdate, depth int, symbols ...string) error {
+	return e.wsAPI.SubscribeOrderBook(ch, depth, symbols...)
+}
+
+// UnsubscribeOrderBook unsubscribes from order book updates for specified symbols
+func (e *BitMartExchange) UnsubscribeOrderBook(symbols ...string) error {
+	return e.wsAPI.UnsubscribeOrderBook(symbols...)
+}
+
+// SubscribeTrades subscribes to public trades via WebSocket
+func (e *BitMartExchange) SubscribeTrades(ch chan *commontypes.TradeUpdate, symbols ...string) error {
+	return e.wsAPI.SubscribeTrades(ch, symbols...)
+}
+
+// UnsubscribeTrades unsubscribes from public trades for specified symbols
+func (e *BitMartExchange) UnsubscribeTrades(symbols ...string) error {
+	return e.wsAPI.UnsubscribeTrades(symbols...)
+}
+
+// SubscribeFundingRate subscribes to funding rate updates for specified symbols
+func (e *BitMartExchange) SubscribeFundingRate(ch chan *commontypes.FundingRateUpdate, symbols ...string) error {
+	return e.wsAPI.SubscribeFundingRate(ch, symbols...)
+}
+
+// UnsubscribeFundingRate unsubscribes from funding rate updates for specified symbols
+func (e *BitMartExchange) UnsubscribeFundingRate(symbols ...string) error {
+	return e.wsAPI.UnsubscribeFundingRate(symbols...)
+}
+
+// SubscribeMarkPrice subscribes to mark price updates for specified symbols
+func (e *BitMartExchange) SubscribeMarkPrice(ch chan *commontypes.MarkPriceUpdate, symbols ...string) error {
+	return e.wsAPI.SubscribeMarkPrice(ch, symbols...)
+}
+
+// UnsubscribeMarkPrice unsubscribes from mark price updates for specified symbols
+func (e *BitMartExchange) UnsubscribeMarkPrice(symbols ...string) error {
+	return e.wsAPI.UnsubscribeMarkPrice(symbols...)
+}
+
 // SubscribeBalanceAndPosition subscribes to balance and position updates via WebSocket
 // BitMart does not support this feature through the unified interface
 func (e *BitMartExchange) SubscribeBalanceAndPosition(ch chan *commontypes.BalanceAndPositionUpdate) error {
@@ -228,15 +357,14 @@ func (e *BitMartExchange) UnsubscribePosition(req commontypes.WebSocketSubscribe
 }
 
 // SubscribeOrders subscribes to order updates via WebSocket
-// BitMart does not support this feature through the unified interface
+// Spot orders are streamed for req.InstrumentType InstrumentSpot, contract orders otherwise
 func (e *BitMartExchange) SubscribeOrders(ch chan *commontypes.OrderUpdate, req commontypes.WebSocketSubscribeRequest) error {
-	return commontypes.ErrNotSupported
+	return e.wsAPI.SubscribeOrders(ch, req)
 }
 
 // UnsubscribeOrders unsubscribes from order updates
-// BitMart does not support this feature through the unified interface
 func (e *BitMartExchange) UnsubscribeOrders(req commontypes.WebSocketSubscribeRequest) error {
-	return commontypes.ErrNotSupported
+	return e.wsAPI.UnsubscribeOrders(req)
 }
 
 // SetChannels sets channels for receiving WebSocket events
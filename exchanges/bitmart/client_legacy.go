@@ -3,9 +3,13 @@ package bitmart
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/djpken/go-exc/clock"
 	"github.com/djpken/go-exc/exchanges/bitmart/rest"
 	"github.com/djpken/go-exc/exchanges/bitmart/ws"
+	"github.com/djpken/go-exc/options"
 )
 
 // Client represents the BitMart native client
@@ -18,11 +22,25 @@ type Client struct {
 
 	ctx    context.Context
 	config *Config
+	stop   context.CancelFunc
 }
 
+// receiveWindow is how far a request timestamp may be from the BitMart server time
+const receiveWindow = 5 * time.Second
+
 // NewClient creates a new BitMart native client
-func NewClient(ctx context.Context, apiKey, secretKey, memo string, testMode bool) (*Client, error) {
+// opts override the HTTP client, endpoints, dialer, user agent, logger, REST middleware and metrics of the clients.
+// Unless disabled with WithClockSync, the offset to the BitMart server clock is measured
+// in the background until ctx is done or Close is called.
+func NewClient(ctx context.Context, apiKey, secretKey, memo string, testMode bool, opts ...options.Option) (*Client, error) {
 	config := NewDefaultConfig(apiKey, secretKey, memo, testMode)
+	o := options.NewClientOptions(opts...)
+	if o.BaseURL != "" {
+		config.BaseURL = o.BaseURL
+	}
+	if o.WSURL != "" {
+		config.WSBaseURL = o.WSURL
+	}
 
 	// Create REST client config
 	restConfig := &rest.BitMartConfig{
@@ -31,10 +49,26 @@ func NewClient(ctx context.Context, apiKey, secretKey, memo string, testMode boo
 		Memo:      memo,
 		BaseURL:   config.GetBaseURL(),
 	}
-	restClient, err := rest.NewClientRest(ctx, restConfig)
+	restClient, err := rest.NewClientRest(restConfig)
 	if err != nil {
 		return nil, err
 	}
+	if o.HTTPClient != nil {
+		restClient.SetHTTPClient(o.HTTPClient)
+	}
+	if o.UserAgent != "" {
+		restClient.SetUserAgent(o.UserAgent)
+	}
+	if o.RateLimiter != nil {
+		restClient.SetRateLimiter(o.RateLimiter)
+	}
+	if o.RetryPolicy != nil {
+		restClient.SetRetryPolicy(*o.RetryPolicy)
+	}
+	restClient.SetMiddleware(o.RESTMiddleware()...)
+	restClient.SetMetrics(o.Metrics)
+	logger := o.LoggerFor("bitmart")
+	restClient.SetLogger(logger)
 
 	// Create WebSocket client config
 	wsConfig := &ws.BitMartConfig{
@@ -47,19 +81,54 @@ func NewClient(ctx context.Context, apiKey, secretKey, memo string, testMode boo
 	if err != nil {
 		return nil, err
 	}
+	if o.Dialer != nil {
+		wsClient.SetDialer(o.Dialer)
+	}
+	if o.UserAgent != "" {
+		wsClient.SetUserAgent(o.UserAgent)
+	}
+	wsClient.SetLogger(logger)
+	wsClient.SetMetrics(o.Metrics)
+
+	stop := func() {}
+	if interval := o.ClockSync(); interval > 0 {
+		clk := clock.New(serverTime(restClient), receiveWindow)
+		restClient.SetClock(clk)
+		wsClient.SetClock(clk)
+		var clockCtx context.Context
+		clockCtx, stop = context.WithCancel(ctx)
+		go clk.Run(clockCtx, interval)
+	}
 
 	return &Client{
 		Rest:   restClient,
 		Ws:     wsClient,
 		ctx:    ctx,
 		config: config,
+		stop:   stop,
 	}, nil
 }
 
-// Close closes all connections
+// serverTime returns a clock.ServerTimeFunc reading the BitMart system time
+func serverTime(r *rest.ClientRest) clock.ServerTimeFunc {
+	return func(ctx context.Context) (time.Time, error) {
+		resp, err := r.Market.GetServerTime(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if resp.Data.ServerTime == 0 {
+			return time.Time{}, fmt.Errorf("bitmart: no server time returned")
+		}
+		return time.UnixMilli(resp.Data.ServerTime), nil
+	}
+}
+
+// Close stops the server clock synchronization and closes all connections
 func (c *Client) Close() error {
 	var errs []error
 
+	c.stop()
+
 	if c.Ws != nil {
 		if err := c.Ws.Close(); err != nil {
 			errs = append(errs, err)
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"time"
 
+	privateevents "github.com/djpken/go-exc/exchanges/bitmart/events/private"
+	publicevents "github.com/djpken/go-exc/exchanges/bitmart/events/public"
 	accountmodels "github.com/djpken/go-exc/exchanges/bitmart/models/account"
 	"github.com/djpken/go-exc/exchanges/bitmart/models/contract"
 	marketmodels "github.com/djpken/go-exc/exchanges/bitmart/models/market"
@@ -76,6 +78,497 @@ func (c *Converter) ConvertOrderDetail(detail *trademodels.OrderDetail) *commont
 	return order
 }
 
+// ConvertOrderV4 converts BitMart v4 order query result to common order type
+func (c *Converter) ConvertOrderV4(order *trademodels.OrderV4) *commontypes.Order {
+	if order == nil {
+		return nil
+	}
+
+	quantity := c.stringToDecimal(order.Size)
+	filled := c.stringToDecimal(order.FilledSize)
+	remaining, _ := quantity.Sub(filled)
+
+	return &commontypes.Order{
+		ID:                order.OrderID,
+		ClientOrderID:     order.ClientOrderID,
+		Symbol:            order.Symbol,
+		Side:              order.Side,
+		Type:              order.Type,
+		Price:             c.stringToDecimal(order.Price),
+		Quantity:          quantity,
+		FilledQuantity:    filled,
+		RemainingQuantity: remaining,
+		Status:            c.ConvertOrderStatus(order.State),
+		CreatedAt:         commontypes.Timestamp(time.UnixMilli(order.CreateTime)),
+		UpdatedAt:         commontypes.Timestamp(time.UnixMilli(order.UpdateTime)),
+		Extra: map[string]interface{}{
+			"avg_price":       order.PriceAvg,
+			"notional":        order.Notional,
+			"filled_notional": order.FilledNotional,
+			"order_mode":      order.OrderMode,
+		},
+	}
+}
+
+// ConvertContractOrderDetail converts BitMart contract order to common order type
+func (c *Converter) ConvertContractOrderDetail(order *contractresponses.ContractOrder) *commontypes.Order {
+	if order == nil {
+		return nil
+	}
+
+	quantity := c.stringToDecimal(order.Size)
+	filled := c.stringToDecimal(order.DealSize)
+	remaining, _ := quantity.Sub(filled)
+
+	// Convert side (contract side is different from spot)
+	side := "buy"
+	if order.Side == 3 || order.Side == 4 {
+		side = "sell"
+	}
+
+	return &commontypes.Order{
+		ID:                order.OrderID,
+		ClientOrderID:     order.ClientOrderID,
+		Symbol:            order.Symbol,
+		Side:              side,
+		Type:              order.Type,
+		Price:             c.stringToDecimal(order.Price),
+		Quantity:          quantity,
+		FilledQuantity:    filled,
+		RemainingQuantity: remaining,
+		Status:            c.ConvertContractOrderState(order.State, quantity, filled),
+		CreatedAt:         commontypes.Timestamp(time.UnixMilli(order.CreateTime)),
+		UpdatedAt:         commontypes.Timestamp(time.UnixMilli(order.UpdateTime)),
+		Extra: map[string]interface{}{
+			"account_type":  commontypes.AccountTypeFutures,
+			"contract_side": order.Side, // Original contract side value
+			"avg_price":     order.DealAvgPrice,
+			"leverage":      order.Leverage,
+			"open_type":     order.OpenType,
+		},
+	}
+}
+
+// ConvertContractOrderState converts BitMart contract order state to common status
+// Contract orders only report 1=approval, 2=check (working) and 4=finished, so
+// partial fills and cancellations are derived from the filled size.
+func (c *Converter) ConvertContractOrderState(state int, quantity, filled commontypes.Decimal) commontypes.OrderStatus {
+	switch state {
+	case 1:
+		return commontypes.OrderStatusPending
+	case 2:
+		if filled.IsPositive() {
+			return commontypes.OrderStatusPartiallyFilled
+		}
+		return commontypes.OrderStatusOpen
+	case 4:
+		if done, _ := filled.GreaterThanOrEqual(quantity); done && quantity.IsPositive() {
+			return commontypes.OrderStatusFilled
+		}
+		return commontypes.OrderStatusCanceled
+	default:
+		return commontypes.OrderStatus(strconv.Itoa(state))
+	}
+}
+
+// ConvertSpotOrderData converts a BitMart spot order push to common order type
+// Spot order pushes carry no fees, leaving Fee zero.
+func (c *Converter) ConvertSpotOrderData(data *privateevents.OrderData) *commontypes.Order {
+	if data == nil {
+		return nil
+	}
+
+	quantity := c.stringToDecimal(data.Size)
+	filled := c.stringToDecimal(data.FilledSize)
+	remaining, _ := quantity.Sub(filled)
+	createTime, _ := data.CreateTime.Int64()
+	updateTime, _ := data.UpdateTime.Int64()
+
+	return &commontypes.Order{
+		ID:                data.OrderID,
+		ClientOrderID:     data.ClientOrderID,
+		Symbol:            data.Symbol,
+		Side:              data.Side,
+		Type:              data.Type,
+		Status:            c.ConvertOrderStatus(data.OrderState),
+		Price:             c.stringToDecimal(data.Price),
+		Quantity:          quantity,
+		FilledQuantity:    filled,
+		RemainingQuantity: remaining,
+		LastFillPrice:     c.stringToDecimal(data.LastFillPrice),
+		LastFillQuantity:  c.stringToDecimal(data.LastFillCount),
+		CreatedAt:         commontypes.Timestamp(time.UnixMilli(createTime)),
+		UpdatedAt:         commontypes.Timestamp(time.UnixMilli(updateTime)),
+		Extra: map[string]interface{}{
+			"account_type":    commontypes.AccountTypeSpot,
+			"notional":        data.Notional,
+			"filled_notional": data.FilledNotional,
+			"exec_type":       data.ExecType,
+			"detail_id":       data.DetailID,
+			"order_mode":      data.OrderMode,
+			"entrust_type":    data.EntrustType,
+		},
+	}
+}
+
+// ConvertFuturesOrderData converts a BitMart futures order push to common order type
+// The last fill is only set for match actions (1, 8 and 9), on which Fee is the
+// fee paid for that fill; BitMart does not push the cumulative fee.
+func (c *Converter) ConvertFuturesOrderData(data *privateevents.FuturesOrderData) *commontypes.Order {
+	if data == nil {
+		return nil
+	}
+
+	o := &data.Order
+	quantity := c.stringToDecimal(o.Size)
+	filled := c.stringToDecimal(o.DealSize)
+	remaining, _ := quantity.Sub(filled)
+
+	order := &commontypes.Order{
+		ID:                o.OrderID,
+		ClientOrderID:     o.ClientOrderID,
+		Symbol:            o.Symbol,
+		Side:              c.contractSideToOrderSide(o.Side),
+		Type:              o.Type,
+		Status:            c.ConvertContractOrderState(o.State, quantity, filled),
+		Price:             c.stringToDecimal(o.Price),
+		Quantity:          quantity,
+		FilledQuantity:    filled,
+		RemainingQuantity: remaining,
+		ReduceOnly:        o.Side == 2 || o.Side == 3, // buy_close_short, sell_close_long
+		CreatedAt:         commontypes.Timestamp(time.UnixMilli(o.CreateTime)),
+		UpdatedAt:         commontypes.Timestamp(time.UnixMilli(o.UpdateTime)),
+		Extra: map[string]interface{}{
+			"account_type":  commontypes.AccountTypeFutures,
+			"action":        data.Action,
+			"contract_side": o.Side, // Original contract side value
+			"avg_price":     o.DealAvgPrice,
+			"leverage":      o.Leverage,
+			"open_type":     o.OpenType,
+			"position_mode": o.PositionMode,
+		},
+	}
+
+	switch data.Action {
+	case 1, 8, 9:
+		if t := o.LastTrade; t != nil {
+			order.LastFillPrice = c.stringToDecimal(t.FillPrice)
+			order.LastFillQuantity = c.stringToDecimal(t.FillQty)
+			order.Fee, _ = c.stringToDecimal(t.Fee).Neg()
+			order.FeeCurrency = t.FeeCcy
+			order.Extra["trade_id"] = t.LastTradeID
+		}
+	}
+	return order
+}
+
+// ConvertPlanOrder converts BitMart contract plan or TP/SL order to common conditional order type
+func (c *Converter) ConvertPlanOrder(order *contractresponses.PlanOrder) *commontypes.ConditionalOrder {
+	if order == nil {
+		return nil
+	}
+
+	result := &commontypes.ConditionalOrder{
+		ID:               order.OrderID,
+		ClientOrderID:    order.ClientOrderID,
+		Symbol:           order.Symbol,
+		Side:             c.contractSideToOrderSide(order.Side),
+		Status:           commontypes.OrderStatusLive,
+		Quantity:         c.stringToDecimal(order.Size),
+		TriggerPriceType: c.ConvertContractPriceType(order.PriceType),
+		CreatedAt:        commontypes.Timestamp(time.UnixMilli(order.CreateTime)),
+		Extra: map[string]interface{}{
+			"account_type":  commontypes.AccountTypeFutures,
+			"contract_side": order.Side,
+			"type":          order.Type,
+			"price_way":     order.PriceWay,
+			"plan_category": order.PlanCategory,
+			"leverage":      order.Leverage,
+			"open_type":     order.OpenType,
+		},
+	}
+
+	switch order.Type {
+	case "take_profit":
+		result.Type = commontypes.ConditionalOrderTypeTPSL
+		result.TakeProfitTriggerPrice = c.stringToDecimal(order.TriggerPrice)
+		result.TakeProfitPrice = c.stringToDecimal(order.ExecutivePrice)
+	case "stop_loss":
+		result.Type = commontypes.ConditionalOrderTypeTPSL
+		result.StopLossTriggerPrice = c.stringToDecimal(order.TriggerPrice)
+		result.StopLossPrice = c.stringToDecimal(order.ExecutivePrice)
+	default:
+		result.Type = commontypes.ConditionalOrderTypeTrigger
+		result.TriggerPrice = c.stringToDecimal(order.TriggerPrice)
+		result.OrderPrice = c.stringToDecimal(order.ExecutivePrice)
+	}
+	return result
+}
+
+// ConvertTrailOrder converts BitMart contract trailing order to common conditional order type
+// BitMart reports the callback rate in percent; it is converted to a fraction.
+func (c *Converter) ConvertTrailOrder(order *contractresponses.ContractOrder) *commontypes.ConditionalOrder {
+	if order == nil {
+		return nil
+	}
+
+	callbackRate, _ := c.stringToDecimal(order.CallbackRate).Div(commontypes.NewDecimalFromInt(100))
+
+	return &commontypes.ConditionalOrder{
+		ID:               order.OrderID,
+		ClientOrderID:    order.ClientOrderID,
+		Symbol:           order.Symbol,
+		Type:             commontypes.ConditionalOrderTypeTrailing,
+		Side:             c.contractSideToOrderSide(order.Side),
+		Status:           commontypes.OrderStatusLive,
+		Quantity:         c.stringToDecimal(order.Size),
+		TriggerPriceType: c.ConvertContractPriceType(order.ActivationPriceType),
+		CallbackRate:     callbackRate,
+		ActivationPrice:  c.stringToDecimal(order.ActivationPrice),
+		CreatedAt:        commontypes.Timestamp(time.UnixMilli(order.CreateTime)),
+		Extra: map[string]interface{}{
+			"account_type":  commontypes.AccountTypeFutures,
+			"contract_side": order.Side,
+			"leverage":      order.Leverage,
+			"open_type":     order.OpenType,
+		},
+	}
+}
+
+// ConvertContractPriceType converts BitMart contract trigger price type to common type
+func (c *Converter) ConvertContractPriceType(priceType int) commontypes.TriggerPriceType {
+	if priceType == 2 {
+		return commontypes.TriggerPriceTypeMark
+	}
+	return commontypes.TriggerPriceTypeLast
+}
+
+// ToContractPriceType converts common trigger price type to BitMart contract price type
+// BitMart contracts trigger on last (1) or fair (2) price only.
+func (c *Converter) ToContractPriceType(priceType commontypes.TriggerPriceType) (int, error) {
+	switch priceType {
+	case "", commontypes.TriggerPriceTypeLast:
+		return 1, nil
+	case commontypes.TriggerPriceTypeMark:
+		return 2, nil
+	default:
+		return 0, fmt.Errorf("bitmart: trigger price type %q: %w", priceType, commontypes.ErrNotSupported)
+	}
+}
+
+// contractSideToOrderSide converts BitMart contract side to buy/sell
+func (c *Converter) contractSideToOrderSide(side int) string {
+	if side == 3 || side == 4 {
+		return "sell"
+	}
+	return "buy"
+}
+
+// ConvertTradeV4 converts BitMart v4 spot trade execution to common trade type
+func (c *Converter) ConvertTradeV4(trade *trademodels.TradeV4) *commontypes.Trade {
+	if trade == nil {
+		return nil
+	}
+
+	return &commontypes.Trade{
+		ID:          trade.TradeID,
+		OrderID:     trade.OrderID,
+		Symbol:      trade.Symbol,
+		Side:        trade.Side,
+		Price:       c.stringToDecimal(trade.Price),
+		Quantity:    c.stringToDecimal(trade.Size),
+		Fee:         c.stringToDecimal(trade.Fee),
+		FeeCurrency: trade.FeeCoinName,
+		IsMaker:     trade.TradeRole == "maker",
+		Timestamp:   commontypes.Timestamp(time.UnixMilli(trade.CreateTime)),
+		Extra: map[string]interface{}{
+			"client_order_id": trade.ClientOrderID,
+			"notional":        trade.Notional,
+			"order_mode":      trade.OrderMode,
+		},
+	}
+}
+
+// ConvertContractFill converts a single BitMart contract trade execution to common trade type
+func (c *Converter) ConvertContractFill(trade *contractresponses.ContractTrade) *commontypes.Trade {
+	if trade == nil {
+		return nil
+	}
+
+	// Convert side (contract side is different from spot)
+	side := "buy"
+	if trade.Side == 3 || trade.Side == 4 {
+		side = "sell"
+	}
+
+	return &commontypes.Trade{
+		ID:          trade.TradeID,
+		OrderID:     trade.OrderID,
+		Symbol:      trade.Symbol,
+		Side:        side,
+		Price:       c.stringToDecimal(trade.Price),
+		Quantity:    c.stringToDecimal(trade.Vol),
+		Fee:         c.stringToDecimal(trade.PaidFees),
+		FeeCurrency: "USDT", // Default, actual currency not provided
+		IsMaker:     trade.ExecType == "Maker",
+		Timestamp:   commontypes.Timestamp(time.UnixMilli(trade.CreateTime)),
+		Extra: map[string]interface{}{
+			"account":         trade.Account,
+			"realised_profit": trade.RealisedProfit,
+			"contract_side":   trade.Side, // Original contract side value
+		},
+	}
+}
+
+// aggressorSide returns the aggressor side of a public trade from its buyer-maker flag
+func aggressorSide(buyerMaker bool) string {
+	if buyerMaker {
+		return "sell"
+	}
+	return "buy"
+}
+
+// ConvertFuturesTrade converts a BitMart futures WebSocket trade to common trade type,
+// Side being the aggressor side
+func (c *Converter) ConvertFuturesTrade(trade *publicevents.FuturesTradeData) *commontypes.Trade {
+	if trade == nil {
+		return nil
+	}
+
+	return &commontypes.Trade{
+		ID:        trade.TradeID.String(),
+		Symbol:    trade.Symbol,
+		Side:      aggressorSide(trade.M),
+		Price:     c.stringToDecimal(trade.DealPrice),
+		Quantity:  c.stringToDecimal(trade.DealVol),
+		Timestamp: commontypes.Timestamp(trade.CreatedAt),
+		Extra: map[string]interface{}{
+			"way": trade.Way,
+		},
+	}
+}
+
+// ConvertContractMarketTrade converts a BitMart contract market trade to common trade type,
+// Side being the aggressor side. BitMart does not return trade IDs here.
+func (c *Converter) ConvertContractMarketTrade(trade *contractresponses.ContractMarketTrade) *commontypes.Trade {
+	if trade == nil {
+		return nil
+	}
+
+	return &commontypes.Trade{
+		Symbol:    trade.Symbol,
+		Side:      aggressorSide(trade.IsBuyerMaker),
+		Price:     c.stringToDecimal(trade.Price),
+		Quantity:  c.stringToDecimal(trade.Qty),
+		Timestamp: commontypes.Timestamp(time.UnixMilli(trade.Time)),
+		Extra: map[string]interface{}{
+			"quote_qty": trade.QuoteQty,
+		},
+	}
+}
+
+// ConvertContractFundingRate converts the current BitMart contract funding rate to common type.
+// BitMart does not return the settlement time after FundingTime, NextFundingTime being zero.
+func (c *Converter) ConvertContractFundingRate(rate *contractresponses.ContractCurrentFundingRate) *commontypes.FundingRate {
+	if rate == nil {
+		return nil
+	}
+
+	return &commontypes.FundingRate{
+		Symbol:          rate.Symbol,
+		FundingRate:     c.stringToDecimal(rate.RateValue),
+		FundingTime:     commontypes.Timestamp(time.UnixMilli(rate.FundingTime)),
+		NextFundingRate: c.stringToDecimal(rate.ExpectedRate),
+		Timestamp:       commontypes.Timestamp(time.UnixMilli(rate.Timestamp)),
+		Extra: map[string]interface{}{
+			"funding_upper_limit": rate.FundingUpperLimit,
+			"funding_lower_limit": rate.FundingLowerLimit,
+		},
+	}
+}
+
+// ConvertContractFundingRateHistory converts a settled BitMart contract funding rate to common type
+func (c *Converter) ConvertContractFundingRateHistory(rate *contractresponses.ContractFundingRate) *commontypes.FundingRate {
+	if rate == nil {
+		return nil
+	}
+
+	fundingTime, _ := strconv.ParseInt(rate.FundingTime, 10, 64)
+	return &commontypes.FundingRate{
+		Symbol:      rate.Symbol,
+		FundingRate: c.stringToDecimal(rate.FundingRate),
+		FundingTime: commontypes.Timestamp(time.UnixMilli(fundingTime)),
+		Timestamp:   commontypes.Timestamp(time.UnixMilli(fundingTime)),
+		Extra:       map[string]interface{}{},
+	}
+}
+
+// ConvertFundingRateEvent converts BitMart funding rate WebSocket data to common type
+func (c *Converter) ConvertFundingRateEvent(data *publicevents.FundingRateData) *commontypes.FundingRate {
+	if data == nil {
+		return nil
+	}
+
+	rate := &commontypes.FundingRate{
+		Symbol:          data.Symbol,
+		FundingRate:     c.stringToDecimal(data.FundingRate),
+		FundingTime:     commontypes.Timestamp(time.UnixMilli(data.FundingTime)),
+		NextFundingRate: c.stringToDecimal(data.NextFundingRate),
+		Timestamp:       commontypes.Timestamp(time.UnixMilli(data.TS)),
+		Extra: map[string]interface{}{
+			"funding_upper_limit": data.FundingUpperLimit,
+			"funding_lower_limit": data.FundingLowerLimit,
+		},
+	}
+	if data.NextFundingTime > 0 {
+		rate.NextFundingTime = commontypes.Timestamp(time.UnixMilli(data.NextFundingTime))
+	}
+	return rate
+}
+
+// ConvertFuturesTickerMarkPrice converts the mark and index prices of a BitMart futures ticker to common type
+func (c *Converter) ConvertFuturesTickerMarkPrice(data *publicevents.FuturesTickerData) *commontypes.MarkPrice {
+	if data == nil {
+		return nil
+	}
+
+	return &commontypes.MarkPrice{
+		Symbol:     data.Symbol,
+		MarkPrice:  c.stringToDecimal(data.MarkPrice),
+		IndexPrice: c.stringToDecimal(data.IndexPrice),
+		Extra:      map[string]interface{}{},
+	}
+}
+
+// ConvertSpotTradeArray converts a BitMart spot trade array to common trade type
+// BitMart format: [symbol, timestamp, price, size, side], side being the taker side
+func (c *Converter) ConvertSpotTradeArray(tradeData []interface{}) *commontypes.Trade {
+	if len(tradeData) < 5 {
+		return nil
+	}
+
+	symbol, _ := tradeData[0].(string)
+	var ts int64
+	switch v := tradeData[1].(type) {
+	case string:
+		ts, _ = strconv.ParseInt(v, 10, 64)
+	case float64:
+		ts = int64(v)
+	}
+	price, _ := tradeData[2].(string)
+	size, _ := tradeData[3].(string)
+	side, _ := tradeData[4].(string)
+
+	return &commontypes.Trade{
+		Symbol:    symbol,
+		Side:      side,
+		Price:     c.stringToDecimal(price),
+		Quantity:  c.stringToDecimal(size),
+		Timestamp: commontypes.Timestamp(time.UnixMilli(ts)),
+		Extra:     make(map[string]interface{}),
+	}
+}
+
 // ConvertContractTrades converts BitMart contract trades to common order type
 // This aggregates trade executions into an Order object
 func (c *Converter) ConvertContractTrades(trades []contractresponses.ContractTrade) *commontypes.Order {
@@ -337,6 +830,18 @@ func (c *Converter) ConvertContractOrderBook(resp *contractresponses.GetContract
 	}
 }
 
+// ConvertDepthLevels converts BitMart futures depth levels to common order book levels
+func (c *Converter) ConvertDepthLevels(levels []publicevents.DepthLevel) []commontypes.OrderBookLevel {
+	out := make([]commontypes.OrderBookLevel, len(levels))
+	for i, l := range levels {
+		out[i] = commontypes.OrderBookLevel{
+			Price:    c.stringToDecimal(l.Price),
+			Quantity: c.stringToDecimal(l.Volume),
+		}
+	}
+	return out
+}
+
 // ConvertOrderStatus converts BitMart order status to common status
 func (c *Converter) ConvertOrderStatus(status string) commontypes.OrderStatus {
 	switch bitmarttypes.OrderStatus(status) {
@@ -346,7 +851,7 @@ func (c *Converter) ConvertOrderStatus(status string) commontypes.OrderStatus {
 		return commontypes.OrderStatusPartiallyFilled
 	case bitmarttypes.OrderStatusFilled:
 		return commontypes.OrderStatusFilled
-	case bitmarttypes.OrderStatusCanceled:
+	case bitmarttypes.OrderStatusCanceled, bitmarttypes.OrderStatusPartiallyCanceled:
 		return commontypes.OrderStatusCanceled
 	case bitmarttypes.OrderStatusPendingCancel:
 		return "canceling"
@@ -383,6 +888,62 @@ func (c *Converter) ConvertOrderType(orderType string) string {
 	}
 }
 
+// ToSpotOrderType folds a time in force into the BitMart spot order type
+// Spot orders express post-only as limit_maker and IOC as the ioc type; FOK
+// is not available.
+func (c *Converter) ToSpotOrderType(orderType string, tif commontypes.TimeInForce) (string, error) {
+	spotType := c.ConvertOrderType(orderType)
+	if tif == "" || tif == commontypes.TimeInForceGTC {
+		return spotType, nil
+	}
+
+	var native string
+	switch tif {
+	case commontypes.TimeInForceIOC:
+		native = string(bitmarttypes.OrderTypeIOC)
+	case commontypes.TimeInForceGTX:
+		native = string(bitmarttypes.OrderTypeLimitMaker)
+	}
+
+	switch {
+	case native == "":
+		return "", fmt.Errorf("bitmart: spot time in force %s: %w", tif, commontypes.ErrNotSupported)
+	case spotType == string(bitmarttypes.OrderTypeLimit), spotType == native:
+		return native, nil
+	case spotType == string(bitmarttypes.OrderTypeMarket) && tif == commontypes.TimeInForceIOC:
+		// Market orders are immediate-or-cancel already
+		return spotType, nil
+	default:
+		return "", fmt.Errorf("bitmart: spot %s order with time in force %s: %w", spotType, tif, commontypes.ErrNotSupported)
+	}
+}
+
+// ToContractMode converts a time in force to the BitMart contract order mode
+// (1=GTC, 2=FOK, 3=IOC, 4=Maker Only). It returns 0 when tif is empty so the
+// exchange default applies. Market orders only accept GTC or IOC.
+func (c *Converter) ToContractMode(orderType string, tif commontypes.TimeInForce) (int, error) {
+	var mode int
+	switch tif {
+	case "":
+		return 0, nil
+	case commontypes.TimeInForceGTC:
+		mode = 1
+	case commontypes.TimeInForceFOK:
+		mode = 2
+	case commontypes.TimeInForceIOC:
+		mode = 3
+	case commontypes.TimeInForceGTX:
+		mode = 4
+	default:
+		return 0, fmt.Errorf("bitmart: contract time in force %s: %w", tif, commontypes.ErrNotSupported)
+	}
+
+	if c.ConvertOrderType(orderType) == string(bitmarttypes.OrderTypeMarket) && mode != 1 && mode != 3 {
+		return 0, fmt.Errorf("bitmart: contract market order with time in force %s: %w", tif, commontypes.ErrNotSupported)
+	}
+	return mode, nil
+}
+
 // formatFloat converts float64 to string
 func (c *Converter) formatFloat(f float64) string {
 	return fmt.Sprintf("%f", f)
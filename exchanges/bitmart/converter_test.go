@@ -1,9 +1,13 @@
 package bitmart
 
 import (
+	"encoding/json"
 	"testing"
 
+	publicevents "github.com/djpken/go-exc/exchanges/bitmart/events/public"
 	accountmodels "github.com/djpken/go-exc/exchanges/bitmart/models/account"
+	contractresponses "github.com/djpken/go-exc/exchanges/bitmart/responses/contract"
+	commontypes "github.com/djpken/go-exc/types"
 )
 
 func TestConverter_ConvertAccountBalance(t *testing.T) {
@@ -220,3 +224,273 @@ func TestConverter_ConvertBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestConverter_ConvertPlanOrder(t *testing.T) {
+	converter := NewConverter()
+
+	tests := []struct {
+		name            string
+		order           *contractresponses.PlanOrder
+		expectedType    commontypes.ConditionalOrderType
+		expectedTrigger string
+		expectedSLPx    string
+		expectedPxType  commontypes.TriggerPriceType
+	}{
+		{
+			name:            "plan order on last price",
+			order:           &contractresponses.PlanOrder{OrderID: "1", Type: "limit", Side: 1, TriggerPrice: "50000", ExecutivePrice: "50100", PriceType: 1},
+			expectedType:    commontypes.ConditionalOrderTypeTrigger,
+			expectedTrigger: "50000",
+			expectedSLPx:    "0",
+			expectedPxType:  commontypes.TriggerPriceTypeLast,
+		},
+		{
+			name:            "stop loss on fair price",
+			order:           &contractresponses.PlanOrder{OrderID: "2", Type: "stop_loss", Side: 3, TriggerPrice: "40000", PriceType: 2},
+			expectedType:    commontypes.ConditionalOrderTypeTPSL,
+			expectedTrigger: "0",
+			expectedSLPx:    "40000",
+			expectedPxType:  commontypes.TriggerPriceTypeMark,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := converter.ConvertPlanOrder(tt.order)
+			if result.Type != tt.expectedType {
+				t.Errorf("Type = %v, expected %v", result.Type, tt.expectedType)
+			}
+			if result.TriggerPrice.String() != tt.expectedTrigger {
+				t.Errorf("TriggerPrice = %v, expected %v", result.TriggerPrice, tt.expectedTrigger)
+			}
+			if result.StopLossTriggerPrice.String() != tt.expectedSLPx {
+				t.Errorf("StopLossTriggerPrice = %v, expected %v", result.StopLossTriggerPrice, tt.expectedSLPx)
+			}
+			if result.TriggerPriceType != tt.expectedPxType {
+				t.Errorf("TriggerPriceType = %v, expected %v", result.TriggerPriceType, tt.expectedPxType)
+			}
+		})
+	}
+}
+
+func TestConverter_ConvertPublicTrades(t *testing.T) {
+	converter := NewConverter()
+
+	var event publicevents.FuturesTradeEvent
+	if err := json.Unmarshal([]byte(`{"group":"futures/trade:BTCUSDT","data":[
+		{"trade_id":3000000245258661,"symbol":"BTCUSDT","deal_price":"117387.58","deal_vol":"1445","way":1,"m":true,"created_at":"2023-02-24T07:54:11.124Z"}
+	]}`), &event); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		trade         *commontypes.Trade
+		expectedID    string
+		expectedSide  string
+		expectedPrice string
+		expectedMs    int64
+	}{
+		{
+			name:          "futures websocket trade, buyer maker",
+			trade:         converter.ConvertFuturesTrade(&event.Data[0]),
+			expectedID:    "3000000245258661",
+			expectedSide:  "sell",
+			expectedPrice: "117387.58",
+			expectedMs:    1677225251124,
+		},
+		{
+			name:          "contract market trade, seller maker",
+			trade:         converter.ConvertContractMarketTrade(&contractresponses.ContractMarketTrade{Symbol: "BTCUSDT", Price: "50000.5", Qty: "3", Time: 1700000000000}),
+			expectedSide:  "buy",
+			expectedPrice: "50000.5",
+			expectedMs:    1700000000000,
+		},
+		{
+			name:          "spot trade array",
+			trade:         converter.ConvertSpotTradeArray([]interface{}{"BTC_USDT", "1667403145679", "20000.1", "0.5", "sell"}),
+			expectedSide:  "sell",
+			expectedPrice: "20000.1",
+			expectedMs:    1667403145679,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.trade.ID != tt.expectedID {
+				t.Errorf("ID = %v, expected %v", tt.trade.ID, tt.expectedID)
+			}
+			if tt.trade.Side != tt.expectedSide {
+				t.Errorf("Side = %v, expected %v", tt.trade.Side, tt.expectedSide)
+			}
+			if tt.trade.Price.String() != tt.expectedPrice {
+				t.Errorf("Price = %v, expected %v", tt.trade.Price, tt.expectedPrice)
+			}
+			if tt.trade.Timestamp.UnixMilli() != tt.expectedMs {
+				t.Errorf("Timestamp = %v, expected %v", tt.trade.Timestamp.UnixMilli(), tt.expectedMs)
+			}
+		})
+	}
+}
+
+func TestConverter_ConvertFundingRates(t *testing.T) {
+	converter := NewConverter()
+
+	var event publicevents.FundingRateEvent
+	if err := json.Unmarshal([]byte(`{"group":"futures/fundingRate:BTCUSDT","data":{
+		"symbol":"BTCUSDT","fundingRate":"0.000098800809","fundingTime":1733270400000,"nextFundingRate":"0.000091","nextFundingTime":1733299200000,
+		"funding_upper_limit":"0.0375","funding_lower_limit":"-0.0375","ts":1733270382000}}`), &event); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                string
+		rate                *commontypes.FundingRate
+		expectedRate        string
+		expectedFundingMs   int64
+		expectedNextRate    string
+		expectedNextFunding bool
+	}{
+		{
+			name:                "websocket funding rate",
+			rate:                converter.ConvertFundingRateEvent(&event.Data),
+			expectedRate:        "0.000098800809",
+			expectedFundingMs:   1733270400000,
+			expectedNextRate:    "0.000091",
+			expectedNextFunding: true,
+		},
+		{
+			name: "current funding rate",
+			rate: converter.ConvertContractFundingRate(&contractresponses.ContractCurrentFundingRate{
+				Symbol: "BTCUSDT", RateValue: "0.0001", ExpectedRate: "0.00012", FundingTime: 1733270400000, Timestamp: 1733270382000,
+			}),
+			expectedRate:      "0.0001",
+			expectedFundingMs: 1733270400000,
+			expectedNextRate:  "0.00012",
+		},
+		{
+			name:              "settled funding rate",
+			rate:              converter.ConvertContractFundingRateHistory(&contractresponses.ContractFundingRate{Symbol: "BTCUSDT", FundingRate: "-0.00005", FundingTime: "1733241600000"}),
+			expectedRate:      "-0.00005",
+			expectedFundingMs: 1733241600000,
+			expectedNextRate:  "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.rate.Symbol != "BTCUSDT" {
+				t.Errorf("Symbol = %v, expected BTCUSDT", tt.rate.Symbol)
+			}
+			if tt.rate.FundingRate.String() != tt.expectedRate {
+				t.Errorf("FundingRate = %v, expected %v", tt.rate.FundingRate, tt.expectedRate)
+			}
+			if tt.rate.FundingTime.UnixMilli() != tt.expectedFundingMs {
+				t.Errorf("FundingTime = %v, expected %v", tt.rate.FundingTime.UnixMilli(), tt.expectedFundingMs)
+			}
+			if tt.rate.NextFundingRate.String() != tt.expectedNextRate {
+				t.Errorf("NextFundingRate = %v, expected %v", tt.rate.NextFundingRate, tt.expectedNextRate)
+			}
+			if tt.rate.NextFundingTime.Time().IsZero() == tt.expectedNextFunding {
+				t.Errorf("NextFundingTime = %v, expected set: %v", tt.rate.NextFundingTime, tt.expectedNextFunding)
+			}
+		})
+	}
+}
+
+func TestConverter_ToSpotOrderType(t *testing.T) {
+	converter := NewConverter()
+
+	tests := []struct {
+		name      string
+		orderType string
+		tif       commontypes.TimeInForce
+		expected  string
+		wantErr   bool
+	}{
+		{"limit unset", "limit", "", "limit", false},
+		{"limit ioc", "limit", commontypes.TimeInForceIOC, "ioc", false},
+		{"limit gtx", "limit", commontypes.TimeInForceGTX, "limit_maker", false},
+		{"limit fok", "limit", commontypes.TimeInForceFOK, "", true},
+		{"market ioc", "market", commontypes.TimeInForceIOC, "market", false},
+		{"ioc gtx", "ioc", commontypes.TimeInForceGTX, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := converter.ToSpotOrderType(tt.orderType, tt.tif)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToSpotOrderType(%q, %q) error = %v, wantErr %v", tt.orderType, tt.tif, err, tt.wantErr)
+			}
+			if result != tt.expected {
+				t.Errorf("ToSpotOrderType(%q, %q) = %q, expected %q", tt.orderType, tt.tif, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConverter_ToContractMode(t *testing.T) {
+	converter := NewConverter()
+
+	tests := []struct {
+		name      string
+		orderType string
+		tif       commontypes.TimeInForce
+		expected  int
+		wantErr   bool
+	}{
+		{"unset", "limit", "", 0, false},
+		{"gtc", "limit", commontypes.TimeInForceGTC, 1, false},
+		{"fok", "limit", commontypes.TimeInForceFOK, 2, false},
+		{"ioc", "limit", commontypes.TimeInForceIOC, 3, false},
+		{"maker only", "limit", commontypes.TimeInForceGTX, 4, false},
+		{"market ioc", "market", commontypes.TimeInForceIOC, 3, false},
+		{"market fok", "market", commontypes.TimeInForceFOK, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := converter.ToContractMode(tt.orderType, tt.tif)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToContractMode(%q, %q) error = %v, wantErr %v", tt.orderType, tt.tif, err, tt.wantErr)
+			}
+			if result != tt.expected {
+				t.Errorf("ToContractMode(%q, %q) = %d, expected %d", tt.orderType, tt.tif, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSymbolMapper(t *testing.T) {
+	mapper := NewSymbolMapper()
+
+	tests := []struct {
+		canonical string
+		native    string
+	}{
+		{"BTC/USDT", "BTC_USDT"},
+		{"BTC/USDT:USDT", "BTCUSDT"},
+		{"ETH/USDC:USDC", "ETHUSDC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.canonical, func(t *testing.T) {
+			sym, err := commontypes.ParseSymbol(tt.canonical)
+			if err != nil {
+				t.Fatalf("ParseSymbol(%q) error = %v", tt.canonical, err)
+			}
+			native, err := mapper.ToNative(sym)
+			if err != nil || native != tt.native {
+				t.Errorf("ToNative(%s) = %q, %v, expected %q", tt.canonical, native, err, tt.native)
+			}
+			back, err := mapper.FromNative(tt.native, "")
+			if err != nil || back != sym {
+				t.Errorf("FromNative(%q) = %+v, %v, expected %+v", tt.native, back, err, sym)
+			}
+		})
+	}
+
+	if _, err := mapper.ToNative(commontypes.Symbol{Base: "BTC", Quote: "USD", Settle: "BTC", InstType: commontypes.InstrumentFutures, Expiry: "250328"}); err == nil {
+		t.Error("ToNative(futures) expected error")
+	}
+}
@@ -1,20 +1,36 @@
 package private
 
-// OrderEvent represents order update WebSocket event
+import "encoding/json"
+
+// OrderEvent represents spot order update WebSocket event
+// Channel: spot/user/order:SYMBOL or spot/user/order:ALL_SYMBOLS
 type OrderEvent struct {
-	OrderID        string `json:"order_id"`
-	ClientOrderID  string `json:"client_order_id"`
-	Symbol         string `json:"symbol"`
-	Side           string `json:"side"` // buy or sell
-	Type           string `json:"type"` // limit, market, etc.
-	Price          string `json:"price"`
-	Size           string `json:"size"`
-	Notional       string `json:"notional"`
-	FilledSize     string `json:"filled_size"`
-	FilledNotional string `json:"filled_notional"`
-	Status         string `json:"status"` // new, partially_filled, filled, canceled
-	CreateTime     int64  `json:"create_time"`
-	UpdateTime     int64  `json:"update_time"`
+	Table string      `json:"table"` // "spot/user/order"
+	Data  []OrderData `json:"data"`
+}
+
+// OrderData represents a single order in spot order event
+type OrderData struct {
+	OrderID        string      `json:"order_id"`
+	ClientOrderID  string      `json:"client_order_id"`
+	Symbol         string      `json:"symbol"`
+	Side           string      `json:"side"` // buy or sell
+	Type           string      `json:"type"` // limit, market, etc.
+	Price          string      `json:"price"`
+	Size           string      `json:"size"`
+	Notional       string      `json:"notional"`
+	FilledSize     string      `json:"filled_size"`
+	FilledNotional string      `json:"filled_notional"`
+	OrderState     string      `json:"order_state"`     // new, partially_filled, filled, canceled, partially_canceled
+	LastFillPrice  string      `json:"last_fill_price"` // Price of the latest fill
+	LastFillCount  string      `json:"last_fill_count"` // Size of the latest fill
+	LastFillTime   json.Number `json:"last_fill_time"`  // Time of the latest fill (milliseconds)
+	ExecType       string      `json:"exec_type"`       // M=maker, T=taker
+	DetailID       string      `json:"detail_id"`       // Trade ID of the latest fill
+	OrderMode      string      `json:"order_mode"`      // spot or iso_margin
+	EntrustType    string      `json:"entrust_type"`    // normal or profit_loss
+	CreateTime     json.Number `json:"create_time"`     // Order creation time (milliseconds)
+	UpdateTime     json.Number `json:"update_time"`     // Order update time (milliseconds)
 }
 
 // BalanceEvent represents balance update WebSocket event
@@ -78,3 +94,48 @@ type FuturesPositionData struct {
 	UpdateTime     int64  `json:"update_time"`      // Position update time (milliseconds)
 	PositionMode   string `json:"position_mode"`    // Position mode: "hedge_mode" or "one_way_mode"
 }
+
+// FuturesOrderEvent represents futures order update WebSocket event
+// Channel: futures/order
+type FuturesOrderEvent struct {
+	Group string             `json:"group"` // "futures/order"
+	Data  []FuturesOrderData `json:"data"`
+}
+
+// FuturesOrderData represents a single order action in futures order event
+type FuturesOrderData struct {
+	// Action: 1=match deal, 2=submit order, 3=cancel order, 4=liquidate cancel order,
+	// 5=adl cancel order, 6=part liquidate, 7=bankruptcy order, 8=passive adl match deal,
+	// 9=active adl match deal
+	Action int                `json:"action"`
+	Order  FuturesOrderDetail `json:"order"`
+}
+
+// FuturesOrderDetail represents the order of a futures order action
+type FuturesOrderDetail struct {
+	OrderID       string            `json:"order_id"`
+	ClientOrderID string            `json:"client_order_id"`
+	Symbol        string            `json:"symbol"`         // Contract trading pair (e.g., BTCUSDT)
+	Side          int               `json:"side"`           // 1=buy_open_long, 2=buy_close_short, 3=sell_close_long, 4=sell_open_short
+	Type          string            `json:"type"`           // limit, market, liquidate, bankruptcy, adl
+	Price         string            `json:"price"`          // Order price
+	Size          string            `json:"size"`           // Order quantity
+	State         int               `json:"state"`          // 1=approval, 2=check, 4=finish
+	Leverage      string            `json:"leverage"`       // Leverage
+	OpenType      string            `json:"open_type"`      // isolated or cross
+	DealAvgPrice  string            `json:"deal_avg_price"` // Average fill price
+	DealSize      string            `json:"deal_size"`      // Cumulative filled quantity
+	PositionMode  string            `json:"position_mode"`  // hedge_mode or one_way_mode
+	LastTrade     *FuturesLastTrade `json:"last_trade"`     // Latest fill, absent before the first one
+	CreateTime    int64             `json:"create_time"`    // Order creation time (milliseconds)
+	UpdateTime    int64             `json:"update_time"`    // Order update time (milliseconds)
+}
+
+// FuturesLastTrade represents the latest fill of a futures order
+type FuturesLastTrade struct {
+	LastTradeID int64  `json:"lastTradeID"`
+	FillQty     string `json:"fillQty"`   // Quantity of the fill
+	FillPrice   string `json:"fillPrice"` // Price of the fill
+	Fee         string `json:"fee"`       // Fee of the fill, negative when paid
+	FeeCcy      string `json:"feeCcy"`    // Fee currency
+}
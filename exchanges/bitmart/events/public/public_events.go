@@ -1,5 +1,10 @@
 package public
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // TickerEvent represents ticker WebSocket event
 type TickerEvent struct {
 	Symbol        string `json:"symbol"`
@@ -27,6 +32,28 @@ type DepthEvent struct {
 	Asks      [][]string `json:"asks"` // [price, amount]
 }
 
+// DepthIncreaseEvent represents futures incremental depth WebSocket event
+type DepthIncreaseEvent struct {
+	Group string            `json:"group"` // e.g., "futures/depthIncrease20:BTCUSDT@100ms"
+	Data  DepthIncreaseData `json:"data"`
+}
+
+// DepthIncreaseData represents the data field in futures incremental depth event
+type DepthIncreaseData struct {
+	Symbol    string       `json:"symbol"`  // Contract symbol (e.g., "BTCUSDT")
+	Asks      []DepthLevel `json:"asks"`    // Changed ask levels, volume 0 removes the level
+	Bids      []DepthLevel `json:"bids"`    // Changed bid levels, volume 0 removes the level
+	Timestamp int64        `json:"ms_t"`    // Push time in milliseconds
+	Version   int64        `json:"version"` // Incremented by 1 on every push
+	Type      string       `json:"type"`    // "snapshot" or "update"
+}
+
+// DepthLevel represents a futures depth price level
+type DepthLevel struct {
+	Price  string `json:"price"`
+	Volume string `json:"vol"`
+}
+
 // TradeEvent represents trade WebSocket event
 type TradeEvent struct {
 	Symbol    string `json:"symbol"`
@@ -37,6 +64,24 @@ type TradeEvent struct {
 	TradeID   string `json:"trade_id"`
 }
 
+// FuturesTradeEvent represents futures trade WebSocket event
+// This matches the actual BitMart futures trade response format
+type FuturesTradeEvent struct {
+	Group string             `json:"group"` // e.g., "futures/trade:BTCUSDT"
+	Data  []FuturesTradeData `json:"data"`
+}
+
+// FuturesTradeData represents a single trade in a futures trade event
+type FuturesTradeData struct {
+	Symbol    string      `json:"symbol"`     // Contract symbol (e.g., "BTCUSDT")
+	TradeID   json.Number `json:"trade_id"`   // Trade ID
+	DealPrice string      `json:"deal_price"` // Deal price
+	DealVol   string      `json:"deal_vol"`   // Deal quantity (contracts)
+	Way       int         `json:"way"`        // Trade type, open/close and long/short of both sides
+	M         bool        `json:"m"`          // Whether the buyer was the maker, i.e. the seller the aggressor
+	CreatedAt time.Time   `json:"created_at"` // Deal time
+}
+
 // KlineEvent represents candlestick WebSocket event
 type KlineEvent struct {
 	Symbol      string `json:"symbol"`
@@ -69,3 +114,21 @@ type FuturesTickerData struct {
 	BidPrice   string `json:"bid_price"`   // Best bid price
 	BidVol     string `json:"bid_vol"`     // Best bid volume
 }
+
+// FundingRateEvent represents futures funding rate WebSocket event
+type FundingRateEvent struct {
+	Group string          `json:"group"` // e.g., "futures/fundingRate:BTCUSDT"
+	Data  FundingRateData `json:"data"`
+}
+
+// FundingRateData represents the data field in futures funding rate event
+type FundingRateData struct {
+	Symbol            string `json:"symbol"`              // Contract symbol (e.g., "BTCUSDT")
+	FundingRate       string `json:"fundingRate"`         // Funding rate settled at FundingTime
+	FundingTime       int64  `json:"fundingTime"`         // Next settlement time (ms)
+	NextFundingRate   string `json:"nextFundingRate"`     // Predicted funding rate of the following period
+	NextFundingTime   int64  `json:"nextFundingTime"`     // Settlement time after FundingTime (ms)
+	FundingUpperLimit string `json:"funding_upper_limit"` // Funding rate upper limit
+	FundingLowerLimit string `json:"funding_lower_limit"` // Funding rate lower limit
+	TS                int64  `json:"ts"`                  // Data time (ms)
+}
@@ -37,3 +37,41 @@ type Trade struct {
 	FeeCurrency string `json:"fee_currency"`
 	ExecTime   int64  `json:"exec_time"`
 }
+
+// OrderV4 represents order information returned by the v4 order query APIs
+type OrderV4 struct {
+	OrderID        string `json:"orderId"`
+	ClientOrderID  string `json:"clientOrderId"`
+	Symbol         string `json:"symbol"`
+	Side           string `json:"side"`      // buy or sell
+	OrderMode      string `json:"orderMode"` // spot or iso_margin
+	Type           string `json:"type"`      // limit, market, limit_maker, ioc
+	State          string `json:"state"`     // new, partially_filled, filled, canceled, partially_canceled
+	Price          string `json:"price"`
+	PriceAvg       string `json:"priceAvg"`
+	Size           string `json:"size"`
+	FilledSize     string `json:"filledSize"`
+	Notional       string `json:"notional"`
+	FilledNotional string `json:"filledNotional"`
+	CreateTime     int64  `json:"createTime"`
+	UpdateTime     int64  `json:"updateTime"`
+}
+
+// TradeV4 represents own trade execution returned by the v4 trade query APIs
+type TradeV4 struct {
+	TradeID       string `json:"tradeId"`
+	OrderID       string `json:"orderId"`
+	ClientOrderID string `json:"clientOrderId"`
+	Symbol        string `json:"symbol"`
+	Side          string `json:"side"`      // buy or sell
+	OrderMode     string `json:"orderMode"` // spot or iso_margin
+	Type          string `json:"type"`      // limit, market, limit_maker, ioc
+	Price         string `json:"price"`
+	Size          string `json:"size"`
+	Notional      string `json:"notional"`
+	Fee           string `json:"fee"`
+	FeeCoinName   string `json:"feeCoinName"`
+	TradeRole     string `json:"tradeRole"` // taker or maker
+	CreateTime    int64  `json:"createTime"`
+	UpdateTime    int64  `json:"updateTime"`
+}
@@ -77,6 +77,24 @@ type SubmitOrderRequest struct {
 	StpMode int `json:"stp_mode,omitempty"`
 }
 
+// ModifyLimitOrderRequest represents request for modifying a resting contract limit order
+type ModifyLimitOrderRequest struct {
+	// Symbol is the contract trading pair (e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+
+	// OrderID is the order ID to modify (either OrderID or ClientOrderID is required)
+	OrderID int64 `json:"order_id,omitempty"`
+
+	// ClientOrderID is the user-defined order ID to modify
+	ClientOrderID string `json:"client_order_id,omitempty"`
+
+	// Price is the new order price (optional, unchanged if empty)
+	Price string `json:"price,omitempty"`
+
+	// Size is the new order quantity in contracts (optional, unchanged if zero)
+	Size int `json:"size,omitempty"`
+}
+
 // GetPositionV2Request represents request for getting position details V2
 type GetPositionV2Request struct {
 	// Symbol is the contract trading pair (optional, e.g., BTCUSDT)
@@ -140,6 +158,30 @@ type GetContractOrderBookRequest struct {
 	Count int `json:"count,omitempty"`
 }
 
+// GetContractMarketTradesRequest represents request for getting recent contract market trades
+type GetContractMarketTradesRequest struct {
+	// Symbol is the contract trading pair (required, e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+
+	// Limit is the number of trades (optional, default 50, max 100)
+	Limit int `json:"limit,omitempty"`
+}
+
+// GetContractFundingRateRequest represents request for getting the current funding rate of a contract
+type GetContractFundingRateRequest struct {
+	// Symbol is the contract trading pair (required, e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+}
+
+// GetContractFundingRateHistoryRequest represents request for getting settled funding rates of a contract
+type GetContractFundingRateHistoryRequest struct {
+	// Symbol is the contract trading pair (required, e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+
+	// Limit is the number of records (optional, default 100, max 100)
+	Limit int `json:"limit,omitempty"`
+}
+
 // GetContractKlineRequest represents request for getting contract kline/candlestick data
 type GetContractKlineRequest struct {
 	// Symbol is the contract trading pair (required, e.g., BTCUSDT)
@@ -155,3 +197,211 @@ type GetContractKlineRequest struct {
 	// EndTime is the end timestamp in seconds (required)
 	EndTime int64 `json:"end_time"`
 }
+
+// GetContractOrderRequest represents request for getting a single contract order
+type GetContractOrderRequest struct {
+	// Symbol is the contract trading pair (required, e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+
+	// OrderID is the order ID (either OrderID or ClientOrderID is required)
+	OrderID string `json:"order_id,omitempty"`
+
+	// ClientOrderID is the client-defined order ID
+	ClientOrderID string `json:"client_order_id,omitempty"`
+}
+
+// GetContractOpenOrdersRequest represents request for getting incomplete contract orders
+type GetContractOpenOrdersRequest struct {
+	// Symbol is the contract trading pair (optional, e.g., BTCUSDT)
+	Symbol string `json:"symbol,omitempty"`
+
+	// Type is the order type filter (optional)
+	// - "limit" = Limit order
+	// - "market" = Market order
+	// - "trailing" = Trailing order
+	Type string `json:"type,omitempty"`
+
+	// OrderState is the order state filter (optional)
+	// - "all" = All incomplete orders (default)
+	// - "partially_filled" = Partially filled orders only
+	OrderState string `json:"order_state,omitempty"`
+
+	// Limit is the number of orders to return (optional, default 100, max 100)
+	Limit int `json:"limit,omitempty"`
+}
+
+// GetContractOrderHistoryRequest represents request for getting contract order history
+type GetContractOrderHistoryRequest struct {
+	// Symbol is the contract trading pair (optional, e.g., BTCUSDT)
+	Symbol string `json:"symbol,omitempty"`
+
+	// StartTime is the start timestamp in seconds (optional)
+	StartTime int64 `json:"start_time,omitempty"`
+
+	// EndTime is the end timestamp in seconds (optional)
+	EndTime int64 `json:"end_time,omitempty"`
+}
+
+// CancelContractOrderRequest represents request for cancelling a single contract order
+type CancelContractOrderRequest struct {
+	// Symbol is the contract trading pair (required, e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+
+	// OrderID is the order ID (either OrderID or ClientOrderID is required)
+	OrderID string `json:"order_id,omitempty"`
+
+	// ClientOrderID is the user-defined order ID
+	ClientOrderID string `json:"client_order_id,omitempty"`
+}
+
+// CancelContractOrdersRequest represents request for cancelling all contract orders of a symbol
+type CancelContractOrdersRequest struct {
+	// Symbol is the contract trading pair (required, e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+}
+
+// SubmitPlanOrderRequest represents request for placing a contract plan (trigger) order
+type SubmitPlanOrderRequest struct {
+	// Symbol is the contract trading pair (e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+
+	// Side is the order direction, same values as SubmitOrderRequest.Side
+	Side int `json:"side"`
+
+	// Leverage is the leverage multiplier
+	Leverage string `json:"leverage,omitempty"`
+
+	// OpenType is the position type ("cross" or "isolated")
+	OpenType string `json:"open_type,omitempty"`
+
+	// Mode is the order mode, same values as SubmitOrderRequest.Mode (optional)
+	Mode int `json:"mode,omitempty"`
+
+	// Type is the order type placed on trigger ("limit" or "market")
+	Type string `json:"type"`
+
+	// Size is the order quantity in contracts
+	Size int `json:"size"`
+
+	// TriggerPrice is the trigger price
+	TriggerPrice string `json:"trigger_price"`
+
+	// ExecutivePrice is the order price placed on trigger (required for limit)
+	ExecutivePrice string `json:"executive_price,omitempty"`
+
+	// PriceWay is the trigger direction
+	// - 1 = Trigger when the price rises to TriggerPrice
+	// - 2 = Trigger when the price falls to TriggerPrice
+	PriceWay int `json:"price_way"`
+
+	// PriceType is the trigger price type
+	// - 1 = Last price
+	// - 2 = Fair (mark) price
+	PriceType int `json:"price_type"`
+}
+
+// SubmitTPSLOrderRequest represents request for placing a contract take-profit or stop-loss order
+type SubmitTPSLOrderRequest struct {
+	// Symbol is the contract trading pair (e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+
+	// Side is the closing direction
+	// - 2 = Buy close short
+	// - 3 = Sell close long
+	Side int `json:"side"`
+
+	// Type is the order type ("take_profit" or "stop_loss")
+	Type string `json:"type"`
+
+	// Size is the order quantity in contracts (optional for position TP/SL)
+	Size int `json:"size,omitempty"`
+
+	// TriggerPrice is the trigger price
+	TriggerPrice string `json:"trigger_price"`
+
+	// ExecutivePrice is the order price placed on trigger (required for limit)
+	ExecutivePrice string `json:"executive_price,omitempty"`
+
+	// PriceType is the trigger price type
+	// - 1 = Last price
+	// - 2 = Fair (mark) price
+	PriceType int `json:"price_type"`
+
+	// PlanCategory is the TP/SL kind (optional)
+	// - 1 = TP/SL for a given size (default)
+	// - 2 = Position TP/SL
+	PlanCategory int `json:"plan_category,omitempty"`
+
+	// ClientOrderID is user-defined ID (optional)
+	ClientOrderID string `json:"client_order_id,omitempty"`
+
+	// Category is the order type placed on trigger ("limit" or "market")
+	Category string `json:"category,omitempty"`
+}
+
+// SubmitTrailOrderRequest represents request for placing a contract trailing stop order
+type SubmitTrailOrderRequest struct {
+	// Symbol is the contract trading pair (e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+
+	// Side is the order direction, same values as SubmitOrderRequest.Side
+	Side int `json:"side"`
+
+	// Leverage is the leverage multiplier
+	Leverage string `json:"leverage,omitempty"`
+
+	// OpenType is the position type ("cross" or "isolated")
+	OpenType string `json:"open_type,omitempty"`
+
+	// Size is the order quantity in contracts
+	Size int `json:"size"`
+
+	// ActivationPrice is the activation price
+	ActivationPrice string `json:"activation_price"`
+
+	// CallbackRate is the callback rate in percent (0.1 - 5)
+	CallbackRate string `json:"callback_rate"`
+
+	// ActivationPriceType is the activation price type
+	// - 1 = Last price
+	// - 2 = Fair (mark) price
+	ActivationPriceType int `json:"activation_price_type"`
+}
+
+// CancelPlanOrderRequest represents request for cancelling a plan or TP/SL order
+type CancelPlanOrderRequest struct {
+	// Symbol is the contract trading pair (required, e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+
+	// OrderID is the order ID (either OrderID or ClientOrderID is required)
+	OrderID string `json:"order_id,omitempty"`
+
+	// ClientOrderID is the user-defined order ID
+	ClientOrderID string `json:"client_order_id,omitempty"`
+}
+
+// CancelTrailOrderRequest represents request for cancelling a trailing stop order
+type CancelTrailOrderRequest struct {
+	// Symbol is the contract trading pair (required, e.g., BTCUSDT)
+	Symbol string `json:"symbol"`
+
+	// OrderID is the order ID
+	OrderID string `json:"order_id,omitempty"`
+}
+
+// GetCurrentPlanOrdersRequest represents request for listing untriggered plan and TP/SL orders
+type GetCurrentPlanOrdersRequest struct {
+	// Symbol is the contract trading pair (optional, e.g., BTCUSDT)
+	Symbol string `json:"symbol,omitempty"`
+
+	// Type is the order type filter ("limit" or "market", optional)
+	Type string `json:"type,omitempty"`
+
+	// Limit is the number of orders to return (optional, default 100, max 100)
+	Limit int `json:"limit,omitempty"`
+
+	// PlanType is the plan type filter (optional)
+	// - "plan" = Plan (trigger) orders
+	// - "profit_loss" = TP/SL orders
+	PlanType string `json:"plan_type,omitempty"`
+}
@@ -49,3 +49,29 @@ type GetTradesRequest struct {
 	Offset    int    `json:"offset,omitempty"`
 	Limit     int    `json:"limit,omitempty"` // Default 50, max 200
 }
+
+// QueryOrdersRequest represents request for the v4 open-orders and history-orders queries
+type QueryOrdersRequest struct {
+	Symbol     string `json:"symbol,omitempty"`
+	OrderMode  string `json:"orderMode,omitempty"` // spot or iso_margin, empty for all
+	StartTime  int64  `json:"startTime,omitempty"` // Milliseconds
+	EndTime    int64  `json:"endTime,omitempty"`   // Milliseconds
+	Limit      int    `json:"limit,omitempty"`     // Default 200, max 200
+	RecvWindow int64  `json:"recvWindow,omitempty"`
+}
+
+// QueryTradesRequest represents request for the v4 account trade list query
+type QueryTradesRequest struct {
+	Symbol     string `json:"symbol,omitempty"`
+	OrderMode  string `json:"orderMode,omitempty"` // spot or iso_margin, empty for all
+	StartTime  int64  `json:"startTime,omitempty"` // Milliseconds
+	EndTime    int64  `json:"endTime,omitempty"`   // Milliseconds
+	Limit      int    `json:"limit,omitempty"`     // Default 200, max 200
+	RecvWindow int64  `json:"recvWindow,omitempty"`
+}
+
+// QueryOrderTradesRequest represents request for the v4 order trade list query
+type QueryOrderTradesRequest struct {
+	OrderID    string `json:"orderId"`
+	RecvWindow int64  `json:"recvWindow,omitempty"`
+}
@@ -26,6 +26,15 @@ type SubmitOrderResponse struct {
 	} `json:"data"`
 }
 
+// ModifyLimitOrderResponse represents modify contract limit order API response
+type ModifyLimitOrderResponse struct {
+	BaseResponse
+	Data struct {
+		OrderID       int64  `json:"order_id"`        // Order ID
+		ClientOrderID string `json:"client_order_id"` // Client order ID
+	} `json:"data"`
+}
+
 // PositionV2 represents position details from V2 API
 type PositionV2 struct {
 	Symbol            string `json:"symbol"`              // Contract trading pair (e.g., BTCUSDT)
@@ -95,6 +104,23 @@ type GetContractTradesResponse struct {
 	Data []ContractTrade `json:"data"`
 }
 
+// ContractMarketTrade represents a public contract market trade
+type ContractMarketTrade struct {
+	Symbol       string `json:"symbol"`         // Contract symbol
+	Price        string `json:"price"`          // Deal price
+	Qty          string `json:"qty"`            // Deal quantity (contracts)
+	QuoteQty     string `json:"quote_qty"`      // Deal value
+	Time         int64  `json:"time"`           // Deal time (ms)
+	IsBuyerMaker bool   `json:"is_buyer_maker"` // Whether the buyer was the maker, i.e. the seller the aggressor
+}
+
+// GetContractMarketTradesResponse represents contract market trades API response
+// API: GET /contract/public/market-trade
+type GetContractMarketTradesResponse struct {
+	BaseResponse
+	Data []ContractMarketTrade `json:"data"`
+}
+
 // GetPositionV2Response represents position details V2 API response
 type GetPositionV2Response struct {
 	BaseResponse
@@ -126,6 +152,40 @@ type GetContractOrderBookResponse struct {
 	} `json:"data"`
 }
 
+// GetContractFundingRateResponse represents contract funding rate API response
+// API: GET /contract/public/funding-rate
+type GetContractFundingRateResponse struct {
+	BaseResponse
+	Data ContractCurrentFundingRate `json:"data"`
+}
+
+// ContractCurrentFundingRate represents the current funding rate of a contract
+type ContractCurrentFundingRate struct {
+	Timestamp         int64  `json:"timestamp"`           // Data time (ms)
+	Symbol            string `json:"symbol"`              // Contract symbol
+	RateValue         string `json:"rate_value"`          // Funding rate settled at FundingTime
+	ExpectedRate      string `json:"expected_rate"`       // Predicted funding rate of the following period
+	FundingTime       int64  `json:"funding_time"`        // Next settlement time (ms)
+	FundingUpperLimit string `json:"funding_upper_limit"` // Funding rate upper limit
+	FundingLowerLimit string `json:"funding_lower_limit"` // Funding rate lower limit
+}
+
+// ContractFundingRate represents a settled funding rate
+type ContractFundingRate struct {
+	Symbol      string `json:"symbol"`       // Contract symbol
+	FundingRate string `json:"funding_rate"` // Settled funding rate
+	FundingTime string `json:"funding_time"` // Settlement time (ms)
+}
+
+// GetContractFundingRateHistoryResponse represents contract funding rate history API response
+// API: GET /contract/public/funding-rate-history
+type GetContractFundingRateHistoryResponse struct {
+	BaseResponse
+	Data struct {
+		List []ContractFundingRate `json:"list"`
+	} `json:"data"`
+}
+
 // ContractKlineData represents a single kline/candlestick data point
 type ContractKlineData struct {
 	Timestamp  int64  `json:"timestamp"`   // Time window (seconds)
@@ -142,3 +202,92 @@ type GetContractKlineResponse struct {
 	BaseResponse
 	Data []ContractKlineData `json:"data"`
 }
+
+// ContractOrder represents a contract order
+type ContractOrder struct {
+	OrderID       string `json:"order_id"`        // Order ID
+	ClientOrderID string `json:"client_order_id"` // Client order ID
+	Price         string `json:"price"`           // Order price
+	Size          string `json:"size"`            // Order size in contracts
+	Symbol        string `json:"symbol"`          // Contract symbol (e.g., BTCUSDT)
+	State         int    `json:"state"`           // Order state (1=approval, 2=check/working, 4=finished)
+	Side          int    `json:"side"`            // Order direction (1=open long, 2=close short, 3=close long, 4=open short)
+	Type          string `json:"type"`            // Order type (limit, market, liquidate, bankruptcy, adl, trailing)
+	Leverage      string `json:"leverage"`        // Leverage multiplier
+	OpenType      string `json:"open_type"`       // Margin mode (cross/isolated)
+	DealAvgPrice  string `json:"deal_avg_price"`  // Average fill price
+	DealSize      string `json:"deal_size"`       // Filled size in contracts
+	CreateTime    int64  `json:"create_time"`     // Creation time (ms)
+	UpdateTime    int64  `json:"update_time"`     // Last update time (ms)
+
+	ActivationPrice     string `json:"activation_price"`      // Trailing activation price
+	CallbackRate        string `json:"callback_rate"`         // Trailing callback rate in percent
+	ActivationPriceType int    `json:"activation_price_type"` // Trailing activation price type (1=last, 2=fair)
+}
+
+// GetContractOrderResponse represents get contract order API response
+// API: GET /contract/private/order
+type GetContractOrderResponse struct {
+	BaseResponse
+	Data ContractOrder `json:"data"`
+}
+
+// GetContractOrdersResponse represents contract order list API response
+// API: GET /contract/private/get-open-orders, GET /contract/private/order-history
+type GetContractOrdersResponse struct {
+	BaseResponse
+	Data []ContractOrder `json:"data"`
+}
+
+// CancelContractOrderResponse represents cancel contract order(s) API response
+// API: POST /contract/private/cancel-order, POST /contract/private/cancel-orders
+type CancelContractOrderResponse struct {
+	BaseResponse
+}
+
+// SubmitPlanOrderResponse represents submit plan order and submit trail order API response
+// API: POST /contract/private/submit-plan-order, POST /contract/private/submit-trail-order
+type SubmitPlanOrderResponse struct {
+	BaseResponse
+	Data struct {
+		OrderID int64 `json:"order_id"` // Order ID
+	} `json:"data"`
+}
+
+// SubmitTPSLOrderResponse represents submit TP/SL order API response
+// API: POST /contract/private/submit-tp-sl-order
+type SubmitTPSLOrderResponse struct {
+	BaseResponse
+	Data struct {
+		OrderID       string `json:"order_id"`        // Order ID
+		ClientOrderID string `json:"client_order_id"` // Client order ID
+	} `json:"data"`
+}
+
+// PlanOrder represents an untriggered contract plan or TP/SL order
+type PlanOrder struct {
+	OrderID        string `json:"order_id"`        // Order ID
+	ClientOrderID  string `json:"client_order_id"` // Client order ID
+	Symbol         string `json:"symbol"`          // Contract symbol (e.g., BTCUSDT)
+	Side           int    `json:"side"`            // Order direction (1=open long, 2=close short, 3=close long, 4=open short)
+	Type           string `json:"type"`            // Order type (limit, market, take_profit, stop_loss)
+	Mode           int    `json:"mode"`            // Order mode
+	Size           string `json:"size"`            // Order size in contracts
+	TriggerPrice   string `json:"trigger_price"`   // Trigger price
+	ExecutivePrice string `json:"executive_price"` // Order price placed on trigger
+	PriceWay       int    `json:"price_way"`       // Trigger direction (1=rising, 2=falling)
+	PriceType      int    `json:"price_type"`      // Trigger price type (1=last, 2=fair)
+	PlanCategory   int    `json:"plan_category"`   // TP/SL kind (1=TP/SL, 2=position TP/SL)
+	State          int    `json:"state"`           // Order state (1=approval, 2=check/working)
+	Leverage       string `json:"leverage"`        // Leverage multiplier
+	OpenType       string `json:"open_type"`       // Margin mode (cross/isolated)
+	CreateTime     int64  `json:"create_time"`     // Creation time (ms)
+	UpdateTime     int64  `json:"update_time"`     // Last update time (ms)
+}
+
+// GetCurrentPlanOrdersResponse represents current plan order list API response
+// API: GET /contract/private/current-plan-order
+type GetCurrentPlanOrdersResponse struct {
+	BaseResponse
+	Data []PlanOrder `json:"data"`
+}
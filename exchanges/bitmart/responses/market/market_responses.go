@@ -69,3 +69,11 @@ type SymbolsDetailsResponse struct {
 		Symbols []market.Symbol `json:"symbols"`
 	} `json:"data"`
 }
+
+// ServerTimeResponse represents system time API response
+type ServerTimeResponse struct {
+	BaseResponse
+	Data struct {
+		ServerTime int64 `json:"server_time"` // Milliseconds
+	} `json:"data"`
+}
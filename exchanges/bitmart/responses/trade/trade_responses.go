@@ -52,3 +52,15 @@ type TradesResponse struct {
 	BaseResponse
 	Data []trade.Trade `json:"data"`
 }
+
+// QueryOrdersResponse represents v4 open-orders / history-orders API response
+type QueryOrdersResponse struct {
+	BaseResponse
+	Data []trade.OrderV4 `json:"data"`
+}
+
+// QueryTradesResponse represents v4 trade list API response
+type QueryTradesResponse struct {
+	BaseResponse
+	Data []trade.TradeV4 `json:"data"`
+}
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/djpken/go-exc/exchanges/bitmart/requests/rest/account"
@@ -23,7 +24,7 @@ func NewAccount(c *ClientRest) *Account {
 // Parameters:
 //   - currency: Optional currency code (e.g., "BTC")
 //   - needUsdValuation: Optional flag to return USD valuation (default: false)
-func (a *Account) GetWalletBalance(req account.GetWalletBalanceRequest) (*responses.WalletBalanceResponse, error) {
+func (a *Account) GetWalletBalance(ctx context.Context, req account.GetWalletBalanceRequest) (*responses.WalletBalanceResponse, error) {
 	endpoint := "/account/v1/wallet"
 
 	// Build query parameters
@@ -47,7 +48,7 @@ func (a *Account) GetWalletBalance(req account.GetWalletBalanceRequest) (*respon
 	}
 
 	var result responses.WalletBalanceResponse
-	if err := a.client.GET(endpoint, &result); err != nil {
+	if err := a.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -5,21 +5,40 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/djpken/go-exc/clock"
 	"github.com/djpken/go-exc/exchanges/bitmart/utils"
+	"github.com/djpken/go-exc/metrics"
+	"github.com/djpken/go-exc/middleware"
+	"github.com/djpken/go-exc/ratelimit"
+	"github.com/djpken/go-exc/retry"
+	commontypes "github.com/djpken/go-exc/types"
 )
 
+// codeSuccess is the BitMart response code of a successful request
+const codeSuccess = 1000
+
+// exchangeName is the exchange label of middleware requests and metrics
+const exchangeName = "bitmart"
+
 // ClientRest represents the BitMart REST API client
 type ClientRest struct {
-	ctx        context.Context
 	httpClient *http.Client
 	apiKey     string
 	secretKey  string
 	memo       string
 	baseURL    string
+	userAgent  string
+	limiter    *ratelimit.Limiter
+	retry      retry.Policy
+	clock      *clock.Clock
+	logger     *slog.Logger
+	middleware []middleware.Middleware
+	metrics    metrics.Recorder
 
 	// API endpoints
 	Market   *Market
@@ -54,7 +73,7 @@ func (c *BitMartConfig) Validate() error {
 }
 
 // NewClientRest creates a new BitMart REST API client
-func NewClientRest(ctx context.Context, cfg Config) (*ClientRest, error) {
+func NewClientRest(cfg Config) (*ClientRest, error) {
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
@@ -66,7 +85,6 @@ func NewClientRest(ctx context.Context, cfg Config) (*ClientRest, error) {
 	}
 
 	client := &ClientRest{
-		ctx: ctx,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
@@ -74,6 +92,10 @@ func NewClientRest(ctx context.Context, cfg Config) (*ClientRest, error) {
 		secretKey: bmConfig.SecretKey,
 		memo:      bmConfig.Memo,
 		baseURL:   cfg.GetBaseURL(),
+		limiter:   ratelimit.New(RateLimits),
+		retry:     retry.DefaultPolicy,
+		logger:    slog.New(slog.DiscardHandler),
+		metrics:   metrics.Nop{},
 	}
 
 	// Initialize API endpoints
@@ -86,8 +108,102 @@ func NewClientRest(ctx context.Context, cfg Config) (*ClientRest, error) {
 	return client, nil
 }
 
-// doRequest performs HTTP request with authentication
-func (c *ClientRest) doRequest(method, endpoint string, body interface{}, result interface{}) error {
+// SetHTTPClient sets the HTTP client used for all requests
+func (c *ClientRest) SetHTTPClient(client *http.Client) {
+	c.httpClient = client
+}
+
+// SetUserAgent sets the User-Agent header sent with every request
+func (c *ClientRest) SetUserAgent(userAgent string) {
+	c.userAgent = userAgent
+}
+
+// SetClock sets the clock used to timestamp signed requests. A nil clock
+// signs with the local time.
+func (c *ClientRest) SetClock(clk *clock.Clock) {
+	c.clock = clk
+}
+
+// Clock returns the clock used to timestamp signed requests, e.g. to monitor
+// its offset and latency
+func (c *ClientRest) Clock() *clock.Clock {
+	return c.clock
+}
+
+// SetLogger sets the logger receiving retries and rate-limit rejections.
+// A nil logger discards them.
+func (c *ClientRest) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	c.logger = logger
+}
+
+// SetMiddleware sets the middleware run around every request, the first
+// being the outermost
+func (c *ClientRest) SetMiddleware(middlewares ...middleware.Middleware) {
+	c.middleware = middlewares
+}
+
+// SetMetrics sets the recorder of rate-limit waits. A nil recorder records nothing.
+func (c *ClientRest) SetMetrics(recorder metrics.Recorder) {
+	c.metrics = metrics.OrNop(recorder)
+}
+
+// SetRetryPolicy sets the policy used to retry transient failures
+func (c *ClientRest) SetRetryPolicy(policy retry.Policy) {
+	c.retry = policy
+}
+
+// readEndpoints are the endpoints that only read data, keyed by path. Their
+// requests are retried on transient failures whatever their HTTP method, as
+// BitMart serves several spot reads over POST.
+var readEndpoints = map[string]bool{
+	// System and spot market data
+	"/system/time":               true,
+	"/spot/quotation/v3/ticker":  true,
+	"/spot/quotation/v3/tickers": true,
+	"/spot/quotation/v3/books":   true,
+	"/spot/quotation/v3/trades":  true,
+	"/spot/quotation/v3/klines":  true,
+	"/spot/v1/symbols":           true,
+	"/spot/v1/symbols/details":   true,
+
+	// Spot trading
+	"/spot/v2/order_detail":         true,
+	"/spot/v2/orders":               true,
+	"/spot/v2/trades":               true,
+	"/spot/v4/query/open-orders":    true,
+	"/spot/v4/query/history-orders": true,
+	"/spot/v4/query/trades":         true,
+	"/spot/v4/query/order-trades":   true,
+
+	// Account and funding
+	"/account/v1/wallet":                   true,
+	"/account/v1/deposit/address":          true,
+	"/account/v2/deposit-withdraw/history": true,
+
+	// Futures
+	"/contract/public/details":              true,
+	"/contract/public/depth":                true,
+	"/contract/public/funding-rate":         true,
+	"/contract/public/funding-rate-history": true,
+	"/contract/public/kline":                true,
+	"/contract/public/markprice-kline":      true,
+	"/contract/public/market-trade":         true,
+	"/contract/private/assets-detail":       true,
+	"/contract/private/position-v2":         true,
+	"/contract/private/order":               true,
+	"/contract/private/get-open-orders":     true,
+	"/contract/private/order-history":       true,
+	"/contract/private/trades":              true,
+	"/contract/private/current-plan-order":  true,
+}
+
+// doRequest performs HTTP request with authentication. Requests to
+// readEndpoints are retried on transient failures according to the retry
+// policy.
+func (c *ClientRest) doRequest(ctx context.Context, method, endpoint string, body interface{}, result interface{}) error {
 	var reqBody []byte
 	var err error
 
@@ -98,37 +214,87 @@ func (c *ClientRest) doRequest(method, endpoint string, body interface{}, result
 		}
 	}
 
+	read := readEndpoints[rateLimitKey(endpoint)]
+	for attempt := 1; ; attempt++ {
+		err = c.send(ctx, method, endpoint, reqBody, result)
+		if err == nil || !read || !c.retry.Retry(attempt) || !retry.IsTransient(err) {
+			return err
+		}
+		c.logger.Warn("retrying request", "path", rateLimitKey(endpoint), "attempt", attempt, "error", err)
+		if sleepErr := c.retry.Sleep(ctx, attempt); sleepErr != nil {
+			return err
+		}
+	}
+}
+
+// send signs and executes a single request
+func (c *ClientRest) send(ctx context.Context, method, endpoint string, reqBody []byte, result interface{}) error {
+	key := rateLimitKey(endpoint)
+	if err := c.wait(ctx, key); err != nil {
+		return err
+	}
+
 	url := c.baseURL + endpoint
-	req, err := http.NewRequestWithContext(c.ctx, method, url, bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(reqBody))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
-	timestamp := utils.GetTimestamp()
+	now, err := c.clock.SignTime()
+	if err != nil {
+		return err
+	}
+	timestamp := utils.FormatTimestamp(now)
 	sign := utils.GenerateSignature(timestamp, c.memo, string(reqBody), c.secretKey)
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-BM-KEY", c.apiKey)
 	req.Header.Set("X-BM-SIGN", sign)
 	req.Header.Set("X-BM-TIMESTAMP", timestamp)
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
 
 	// Execute request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+	mwReq := &middleware.Request{
+		Exchange: exchangeName,
+		Method:   method,
+		Endpoint: req.URL.Path,
+		Private:  privateEndpoint(req.URL.Path),
+		Params:   queryParams(req),
+		Body:     reqBody,
+		Header:   req.Header,
 	}
-	defer resp.Body.Close()
-
-	// Read response
-	respBody, err := io.ReadAll(resp.Body)
+	mwResp, err := middleware.Chain(middleware.Send(c.httpClient, req), c.middleware...)(ctx, mwReq)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		err = fmt.Errorf("failed to execute request: %w", err)
+		if retry.Transient(nil, err) {
+			return retry.MarkTransient(err)
+		}
+		return err
 	}
+	resp := mwResp.HTTPResponse(req)
+	respBody := mwResp.Body
+	c.observeRateLimit(key, resp)
 
-	// Check status code
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API error: status=%d, body=%s", resp.StatusCode, string(respBody))
+	// Check status and response code
+	var envelope struct {
+		Code    *int   `json:"code"`
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(respBody, &envelope)
+	if resp.StatusCode != http.StatusOK || (envelope.Code != nil && *envelope.Code != codeSuccess) {
+		var apiErr *commontypes.APIError
+		if envelope.Code != nil {
+			apiErr = newAPIError(resp.StatusCode, *envelope.Code, envelope.Message)
+		} else {
+			apiErr = newAPIError(resp.StatusCode, 0, string(respBody))
+		}
+		if apiErr.Retryable || retry.Transient(resp, nil) {
+			return retry.MarkTransient(apiErr)
+		}
+		return apiErr
 	}
 
 	// Parse response
@@ -141,17 +307,60 @@ func (c *ClientRest) doRequest(method, endpoint string, body interface{}, result
 	return nil
 }
 
+// submitOrder calls submit and, for orders with a client order ID, retries it
+// on transient failures once lookup confirms the failed attempt did not place
+// the order. When lookup finds the order, its ID is returned with a nil error.
+func (c *ClientRest) submitOrder(ctx context.Context, clientOrderID string, submit func() error, lookup func(context.Context) (orderID string, found bool, err error)) (string, error) {
+	for attempt := 1; ; attempt++ {
+		err := submit()
+		if err == nil || clientOrderID == "" || !c.retry.Retry(attempt) || !retry.IsTransient(err) {
+			return "", err
+		}
+		c.logger.Warn("order failed, confirming before retry", "clientOrderId", clientOrderID, "attempt", attempt, "error", err)
+		if sleepErr := c.retry.Sleep(ctx, attempt); sleepErr != nil {
+			return "", err
+		}
+		orderID, found, lookupErr := lookup(ctx)
+		if lookupErr != nil {
+			return "", err
+		}
+		if found {
+			return orderID, nil
+		}
+	}
+}
+
+// privateEndpoint reports whether endpoint requires authentication
+func privateEndpoint(endpoint string) bool {
+	for _, prefix := range []string{"/spot/quotation/", "/spot/v1/symbols", "/contract/public/", "/system/"} {
+		if strings.HasPrefix(endpoint, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// queryParams returns the query parameters of r
+func queryParams(r *http.Request) map[string]string {
+	q := r.URL.Query()
+	params := make(map[string]string, len(q))
+	for k := range q {
+		params[k] = q.Get(k)
+	}
+	return params
+}
+
 // GET performs a GET request
-func (c *ClientRest) GET(endpoint string, result interface{}) error {
-	return c.doRequest(http.MethodGet, endpoint, nil, result)
+func (c *ClientRest) GET(ctx context.Context, endpoint string, result interface{}) error {
+	return c.doRequest(ctx, http.MethodGet, endpoint, nil, result)
 }
 
 // POST performs a POST request
-func (c *ClientRest) POST(endpoint string, body interface{}, result interface{}) error {
-	return c.doRequest(http.MethodPost, endpoint, body, result)
+func (c *ClientRest) POST(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	return c.doRequest(ctx, http.MethodPost, endpoint, body, result)
 }
 
 // DELETE performs a DELETE request
-func (c *ClientRest) DELETE(endpoint string, body interface{}, result interface{}) error {
-	return c.doRequest(http.MethodDelete, endpoint, body, result)
+func (c *ClientRest) DELETE(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	return c.doRequest(ctx, http.MethodDelete, endpoint, body, result)
 }
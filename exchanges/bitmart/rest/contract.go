@@ -1,11 +1,15 @@
 package rest
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 
 	"github.com/djpken/go-exc/exchanges/bitmart/requests/rest/contract"
 	responses "github.com/djpken/go-exc/exchanges/bitmart/responses/contract"
+	commontypes "github.com/djpken/go-exc/types"
 )
 
 // Contract provides access to BitMart contract API
@@ -22,7 +26,7 @@ func NewContract(c *ClientRest) *Contract {
 //
 // API: GET /contract/public/details
 // Documentation: https://developer-pro.bitmart.com/en/futures/#get-contract-details
-func (c *Contract) GetContractDetails(req contract.GetContractDetailsRequest) (*responses.ContractDetailsResponse, error) {
+func (c *Contract) GetContractDetails(ctx context.Context, req contract.GetContractDetailsRequest) (*responses.ContractDetailsResponse, error) {
 	endpoint := "/contract/public/details"
 
 	// Add symbol parameter if specified
@@ -31,14 +35,16 @@ func (c *Contract) GetContractDetails(req contract.GetContractDetailsRequest) (*
 	}
 
 	var result responses.ContractDetailsResponse
-	if err := c.client.GET(endpoint, &result); err != nil {
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
 
-// SubmitOrder places a new contract order
+// SubmitOrder places a new contract order. Orders with a ClientOrderID are
+// retried on transient failures once a lookup by client order ID confirms
+// the failed attempt did not place them.
 //
 // API: POST /contract/private/submit-order
 // Documentation: https://developer-pro.bitmart.com/en/futures/#place-order-signed
@@ -54,11 +60,64 @@ func (c *Contract) GetContractDetails(req contract.GetContractDetailsRequest) (*
 //	    Leverage: "10",
 //	    OpenType: "isolated",
 //	})
-func (c *Contract) SubmitOrder(req contract.SubmitOrderRequest) (*responses.SubmitOrderResponse, error) {
+func (c *Contract) SubmitOrder(ctx context.Context, req contract.SubmitOrderRequest) (*responses.SubmitOrderResponse, error) {
 	endpoint := "/contract/private/submit-order"
 
 	var result responses.SubmitOrderResponse
-	if err := c.client.POST(endpoint, req, &result); err != nil {
+	orderID, err := c.client.submitOrder(ctx, req.ClientOrderID, func() error {
+		return c.client.POST(ctx, endpoint, req, &result)
+	}, func(ctx context.Context) (string, bool, error) {
+		return c.findOrder(ctx, req.Symbol, req.ClientOrderID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if orderID != "" {
+		id, err := strconv.ParseInt(orderID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid order ID %q: %w", orderID, err)
+		}
+		result.Code = codeSuccess
+		result.Data.OrderID = id
+	}
+
+	return &result, nil
+}
+
+// findOrder looks up a contract order by its client order ID. It reports
+// found=false only when BitMart confirms the order does not exist.
+func (c *Contract) findOrder(ctx context.Context, symbol, clientOrderID string) (string, bool, error) {
+	resp, err := c.GetOrder(ctx, contract.GetContractOrderRequest{Symbol: symbol, ClientOrderID: clientOrderID})
+	if errors.Is(err, commontypes.ErrOrderNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	if resp.Data.OrderID == "" {
+		return "", false, fmt.Errorf("bitmart: order %s: empty lookup result", clientOrderID)
+	}
+	return resp.Data.OrderID, true, nil
+}
+
+// ModifyLimitOrder modifies the price and/or size of a resting contract limit order
+//
+// API: POST /contract/private/modify-limit-order
+// Documentation: https://developer-pro.bitmart.com/en/futures/#modify-limit-order-signed
+//
+// Example:
+//
+//	resp, err := client.Contract.ModifyLimitOrder(contract.ModifyLimitOrderRequest{
+//	    Symbol:  "BTCUSDT",
+//	    OrderID: 220609666322019,
+//	    Price:   "41000",
+//	    Size:    5,
+//	})
+func (c *Contract) ModifyLimitOrder(ctx context.Context, req contract.ModifyLimitOrderRequest) (*responses.ModifyLimitOrderResponse, error) {
+	endpoint := "/contract/private/modify-limit-order"
+
+	var result responses.ModifyLimitOrderResponse
+	if err := c.client.POST(ctx, endpoint, req, &result); err != nil {
 		return nil, err
 	}
 
@@ -83,7 +142,7 @@ func (c *Contract) SubmitOrder(req contract.SubmitOrderRequest) (*responses.Subm
 //	resp, err := client.Contract.GetPositionV2(contract.GetPositionV2Request{
 //	    Symbol: "BTCUSDT",
 //	})
-func (c *Contract) GetPositionV2(req contract.GetPositionV2Request) (*responses.GetPositionV2Response, error) {
+func (c *Contract) GetPositionV2(ctx context.Context, req contract.GetPositionV2Request) (*responses.GetPositionV2Response, error) {
 	endpoint := "/contract/private/position-v2"
 
 	// Build query parameters
@@ -100,7 +159,7 @@ func (c *Contract) GetPositionV2(req contract.GetPositionV2Request) (*responses.
 	endpoint += params
 
 	var result responses.GetPositionV2Response
-	if err := c.client.GET(endpoint, &result); err != nil {
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -127,11 +186,11 @@ func (c *Contract) GetPositionV2(req contract.GetPositionV2Request) (*responses.
 //	    Leverage: "10",
 //	    OpenType: "cross",
 //	})
-func (c *Contract) SubmitLeverage(req contract.SubmitLeverageRequest) (*responses.SubmitLeverageResponse, error) {
+func (c *Contract) SubmitLeverage(ctx context.Context, req contract.SubmitLeverageRequest) (*responses.SubmitLeverageResponse, error) {
 	endpoint := "/contract/private/submit-leverage"
 
 	var result responses.SubmitLeverageResponse
-	if err := c.client.POST(endpoint, req, &result); err != nil {
+	if err := c.client.POST(ctx, endpoint, req, &result); err != nil {
 		return nil, err
 	}
 
@@ -162,11 +221,11 @@ func (c *Contract) SubmitLeverage(req contract.SubmitLeverageRequest) (*response
 //	    fmt.Printf("%s: Available=%s, Equity=%s, Unrealized=%s\n",
 //	        asset.Currency, asset.AvailableBalance, asset.Equity, asset.Unrealized)
 //	}
-func (c *Contract) GetContractAssets() (*responses.GetContractAssetsResponse, error) {
+func (c *Contract) GetContractAssets(ctx context.Context) (*responses.GetContractAssetsResponse, error) {
 	endpoint := "/contract/private/assets-detail"
 
 	var result responses.GetContractAssetsResponse
-	if err := c.client.GET(endpoint, &result); err != nil {
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -203,7 +262,7 @@ func (c *Contract) GetContractAssets() (*responses.GetContractAssetsResponse, er
 //	    StartTime: 1662368173,
 //	    EndTime:   1662368179,
 //	})
-func (c *Contract) GetContractTrades(req contract.GetContractTradesRequest) (*responses.GetContractTradesResponse, error) {
+func (c *Contract) GetContractTrades(ctx context.Context, req contract.GetContractTradesRequest) (*responses.GetContractTradesResponse, error) {
 	endpoint := "/contract/private/trades"
 
 	// Build query parameters
@@ -241,7 +300,7 @@ func (c *Contract) GetContractTrades(req contract.GetContractTradesRequest) (*re
 	}
 
 	var result responses.GetContractTradesResponse
-	if err := c.client.GET(endpoint, &result); err != nil {
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -252,7 +311,7 @@ func (c *Contract) GetContractTrades(req contract.GetContractTradesRequest) (*re
 //
 // API: GET /contract/public/depth
 // Documentation: https://developer-pro.bitmart.com/en/futures/#get-depth
-func (c *Contract) GetOrderBook(req contract.GetContractOrderBookRequest) (*responses.GetContractOrderBookResponse, error) {
+func (c *Contract) GetOrderBook(ctx context.Context, req contract.GetContractOrderBookRequest) (*responses.GetContractOrderBookResponse, error) {
 	endpoint := fmt.Sprintf("/contract/public/depth?symbol=%s", req.Symbol)
 
 	if req.Precision != "" {
@@ -264,7 +323,26 @@ func (c *Contract) GetOrderBook(req contract.GetContractOrderBookRequest) (*resp
 	}
 
 	var result responses.GetContractOrderBookResponse
-	if err := c.client.GET(endpoint, &result); err != nil {
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetMarketTrades retrieves the most recent public trades of a contract trading pair
+//
+// API: GET /contract/public/market-trade
+// Documentation: https://developer-pro.bitmart.com/en/futures/#get-market-trade
+func (c *Contract) GetMarketTrades(ctx context.Context, req contract.GetContractMarketTradesRequest) (*responses.GetContractMarketTradesResponse, error) {
+	endpoint := fmt.Sprintf("/contract/public/market-trade?symbol=%s", req.Symbol)
+
+	if req.Limit > 0 {
+		endpoint += fmt.Sprintf("&limit=%d", req.Limit)
+	}
+
+	var result responses.GetContractMarketTradesResponse
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -290,7 +368,7 @@ func (c *Contract) GetOrderBook(req contract.GetContractOrderBookRequest) (*resp
 //	    StartTime: 1662518172,
 //	    EndTime:   1662518172,
 //	})
-func (c *Contract) GetKline(req contract.GetContractKlineRequest) (*responses.GetContractKlineResponse, error) {
+func (c *Contract) GetKline(ctx context.Context, req contract.GetContractKlineRequest) (*responses.GetContractKlineResponse, error) {
 	params := url.Values{}
 	params.Set("symbol", req.Symbol)
 	params.Set("start_time", fmt.Sprintf("%d", req.StartTime))
@@ -301,7 +379,290 @@ func (c *Contract) GetKline(req contract.GetContractKlineRequest) (*responses.Ge
 	endpoint := "/contract/public/kline?" + params.Encode()
 
 	var result responses.GetContractKlineResponse
-	if err := c.client.GET(endpoint, &result); err != nil {
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetMarkPriceKline retrieves mark price kline data for contract trading pairs,
+// taking the same parameters as GetKline
+//
+// API: GET /contract/public/markprice-kline
+// Documentation: https://developer-pro.bitmart.com/en/futures/#get-markprice-k-line
+func (c *Contract) GetMarkPriceKline(ctx context.Context, req contract.GetContractKlineRequest) (*responses.GetContractKlineResponse, error) {
+	params := url.Values{}
+	params.Set("symbol", req.Symbol)
+	params.Set("start_time", fmt.Sprintf("%d", req.StartTime))
+	params.Set("end_time", fmt.Sprintf("%d", req.EndTime))
+	if req.Step > 0 {
+		params.Set("step", fmt.Sprintf("%d", req.Step))
+	}
+	endpoint := "/contract/public/markprice-kline?" + params.Encode()
+
+	var result responses.GetContractKlineResponse
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetFundingRate retrieves the current funding rate of a contract
+//
+// API: GET /contract/public/funding-rate
+// Documentation: https://developer-pro.bitmart.com/en/futures/#get-current-funding-rate
+func (c *Contract) GetFundingRate(ctx context.Context, req contract.GetContractFundingRateRequest) (*responses.GetContractFundingRateResponse, error) {
+	endpoint := fmt.Sprintf("/contract/public/funding-rate?symbol=%s", req.Symbol)
+
+	var result responses.GetContractFundingRateResponse
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetFundingRateHistory retrieves settled funding rates of a contract, newest first
+//
+// API: GET /contract/public/funding-rate-history
+// Documentation: https://developer-pro.bitmart.com/en/futures/#get-funding-rate-history
+func (c *Contract) GetFundingRateHistory(ctx context.Context, req contract.GetContractFundingRateHistoryRequest) (*responses.GetContractFundingRateHistoryResponse, error) {
+	endpoint := fmt.Sprintf("/contract/public/funding-rate-history?symbol=%s", req.Symbol)
+
+	if req.Limit > 0 {
+		endpoint += fmt.Sprintf("&limit=%d", req.Limit)
+	}
+
+	var result responses.GetContractFundingRateHistoryResponse
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetOrder retrieves details of a single contract order
+//
+// API: GET /contract/private/order
+// Documentation: https://developer-pro.bitmart.com/en/futures/#get-order-detail-keyed
+func (c *Contract) GetOrder(ctx context.Context, req contract.GetContractOrderRequest) (*responses.GetContractOrderResponse, error) {
+	params := url.Values{}
+	params.Set("symbol", req.Symbol)
+	if req.OrderID != "" {
+		params.Set("order_id", req.OrderID)
+	}
+	if req.ClientOrderID != "" {
+		params.Set("client_order_id", req.ClientOrderID)
+	}
+	endpoint := "/contract/private/order?" + params.Encode()
+
+	var result responses.GetContractOrderResponse
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetOpenOrders retrieves incomplete contract orders
+//
+// API: GET /contract/private/get-open-orders
+// Documentation: https://developer-pro.bitmart.com/en/futures/#get-all-open-orders-keyed
+//
+// Notes:
+// - Returns max 100 records per request, newest first
+func (c *Contract) GetOpenOrders(ctx context.Context, req contract.GetContractOpenOrdersRequest) (*responses.GetContractOrdersResponse, error) {
+	params := url.Values{}
+	if req.Symbol != "" {
+		params.Set("symbol", req.Symbol)
+	}
+	if req.Type != "" {
+		params.Set("type", req.Type)
+	}
+	if req.OrderState != "" {
+		params.Set("order_state", req.OrderState)
+	}
+	if req.Limit > 0 {
+		params.Set("limit", fmt.Sprintf("%d", req.Limit))
+	}
+	endpoint := "/contract/private/get-open-orders"
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	var result responses.GetContractOrdersResponse
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetOrderHistory retrieves completed contract orders
+//
+// API: GET /contract/private/order-history
+// Documentation: https://developer-pro.bitmart.com/en/futures/#get-order-history-keyed
+//
+// Notes:
+// - If no time range specified, queries last 7 days
+// - Time range: max 90 days interval
+// - start_time and end_time are Unix timestamps in seconds
+func (c *Contract) GetOrderHistory(ctx context.Context, req contract.GetContractOrderHistoryRequest) (*responses.GetContractOrdersResponse, error) {
+	params := url.Values{}
+	if req.Symbol != "" {
+		params.Set("symbol", req.Symbol)
+	}
+	if req.StartTime > 0 {
+		params.Set("start_time", fmt.Sprintf("%d", req.StartTime))
+	}
+	if req.EndTime > 0 {
+		params.Set("end_time", fmt.Sprintf("%d", req.EndTime))
+	}
+	endpoint := "/contract/private/order-history"
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	var result responses.GetContractOrdersResponse
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CancelOrder cancels a single contract order
+//
+// API: POST /contract/private/cancel-order
+// Documentation: https://developer-pro.bitmart.com/en/futures/#cancel-order-signed
+func (c *Contract) CancelOrder(ctx context.Context, req contract.CancelContractOrderRequest) (*responses.CancelContractOrderResponse, error) {
+	endpoint := "/contract/private/cancel-order"
+
+	var result responses.CancelContractOrderResponse
+	if err := c.client.POST(ctx, endpoint, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CancelAllOrders cancels all open contract orders of a symbol
+//
+// API: POST /contract/private/cancel-orders
+// Documentation: https://developer-pro.bitmart.com/en/futures/#cancel-all-orders-signed
+func (c *Contract) CancelAllOrders(ctx context.Context, req contract.CancelContractOrdersRequest) (*responses.CancelContractOrderResponse, error) {
+	endpoint := "/contract/private/cancel-orders"
+
+	var result responses.CancelContractOrderResponse
+	if err := c.client.POST(ctx, endpoint, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SubmitPlanOrder places a contract plan (trigger) order
+//
+// API: POST /contract/private/submit-plan-order
+// Documentation: https://developer-pro.bitmart.com/en/futures/#submit-plan-order-signed
+func (c *Contract) SubmitPlanOrder(ctx context.Context, req contract.SubmitPlanOrderRequest) (*responses.SubmitPlanOrderResponse, error) {
+	endpoint := "/contract/private/submit-plan-order"
+
+	var result responses.SubmitPlanOrderResponse
+	if err := c.client.POST(ctx, endpoint, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SubmitTPSLOrder places a contract take-profit or stop-loss order
+//
+// API: POST /contract/private/submit-tp-sl-order
+// Documentation: https://developer-pro.bitmart.com/en/futures/#submit-tp-or-sl-order-signed
+func (c *Contract) SubmitTPSLOrder(ctx context.Context, req contract.SubmitTPSLOrderRequest) (*responses.SubmitTPSLOrderResponse, error) {
+	endpoint := "/contract/private/submit-tp-sl-order"
+
+	var result responses.SubmitTPSLOrderResponse
+	if err := c.client.POST(ctx, endpoint, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SubmitTrailOrder places a contract trailing stop order
+//
+// API: POST /contract/private/submit-trail-order
+// Documentation: https://developer-pro.bitmart.com/en/futures/#submit-trail-order-signed
+func (c *Contract) SubmitTrailOrder(ctx context.Context, req contract.SubmitTrailOrderRequest) (*responses.SubmitPlanOrderResponse, error) {
+	endpoint := "/contract/private/submit-trail-order"
+
+	var result responses.SubmitPlanOrderResponse
+	if err := c.client.POST(ctx, endpoint, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CancelPlanOrder cancels a contract plan or TP/SL order
+//
+// API: POST /contract/private/cancel-plan-order
+// Documentation: https://developer-pro.bitmart.com/en/futures/#cancel-plan-order-signed
+func (c *Contract) CancelPlanOrder(ctx context.Context, req contract.CancelPlanOrderRequest) (*responses.CancelContractOrderResponse, error) {
+	endpoint := "/contract/private/cancel-plan-order"
+
+	var result responses.CancelContractOrderResponse
+	if err := c.client.POST(ctx, endpoint, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CancelTrailOrder cancels a contract trailing stop order
+//
+// API: POST /contract/private/cancel-trail-order
+// Documentation: https://developer-pro.bitmart.com/en/futures/#cancel-trail-order-signed
+func (c *Contract) CancelTrailOrder(ctx context.Context, req contract.CancelTrailOrderRequest) (*responses.CancelContractOrderResponse, error) {
+	endpoint := "/contract/private/cancel-trail-order"
+
+	var result responses.CancelContractOrderResponse
+	if err := c.client.POST(ctx, endpoint, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetCurrentPlanOrders retrieves untriggered contract plan and TP/SL orders
+//
+// API: GET /contract/private/current-plan-order
+// Documentation: https://developer-pro.bitmart.com/en/futures/#get-all-current-plan-orders-keyed
+func (c *Contract) GetCurrentPlanOrders(ctx context.Context, req contract.GetCurrentPlanOrdersRequest) (*responses.GetCurrentPlanOrdersResponse, error) {
+	params := url.Values{}
+	if req.Symbol != "" {
+		params.Set("symbol", req.Symbol)
+	}
+	if req.Type != "" {
+		params.Set("type", req.Type)
+	}
+	if req.Limit > 0 {
+		params.Set("limit", fmt.Sprintf("%d", req.Limit))
+	}
+	if req.PlanType != "" {
+		params.Set("plan_type", req.PlanType)
+	}
+	endpoint := "/contract/private/current-plan-order"
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	var result responses.GetCurrentPlanOrdersResponse
+	if err := c.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
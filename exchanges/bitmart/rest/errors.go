@@ -0,0 +1,43 @@
+package rest
+
+import (
+	commontypes "github.com/djpken/go-exc/types"
+)
+
+// errorCodes maps BitMart spot and futures error codes onto the common errors
+//
+// https://developer-pro.bitmart.com/en/spot/#error-code
+var errorCodes = map[int]commontypes.ErrorCode{
+	// Authentication
+	30001: {Err: commontypes.ErrAuthentication}, // X-BM-KEY is empty
+	30002: {Err: commontypes.ErrAuthentication}, // X-BM-KEY not found
+	30003: {Err: commontypes.ErrAuthentication}, // Account has been frozen
+	30004: {Err: commontypes.ErrAuthentication}, // X-BM-SIGN is empty
+	30005: {Err: commontypes.ErrAuthentication}, // X-BM-SIGN is wrong
+	30006: {Err: commontypes.ErrAuthentication}, // X-BM-TIMESTAMP is empty
+	30007: {Err: commontypes.ErrAuthentication}, // X-BM-TIMESTAMP out of range
+	30008: {Err: commontypes.ErrAuthentication}, // X-BM-TIMESTAMP has an invalid format
+	30010: {Err: commontypes.ErrAuthentication}, // IP is forbidden
+	30011: {Err: commontypes.ErrAuthentication}, // X-BM-KEY has expired
+	30012: {Err: commontypes.ErrAuthentication}, // X-BM-KEY is forbidden for this request
+
+	// Rate limits and availability
+	30013: {Err: commontypes.ErrRateLimitExceeded, Retryable: true},   // Too many requests
+	30014: {Err: commontypes.ErrExchangeUnavailable, Retryable: true}, // Service unavailable
+	30016: {Err: commontypes.ErrExchangeUnavailable, Retryable: true}, // Service maintenance
+
+	// Spot orders
+	50005: {Err: commontypes.ErrOrderNotFound},       // Order ID not found
+	50020: {Err: commontypes.ErrInsufficientBalance}, // Insufficient balance
+
+	// Futures
+	40012: {Err: commontypes.ErrInsufficientBalance}, // Insufficient balance
+	40034: {Err: commontypes.ErrInvalidSymbol},       // Symbol does not exist
+	40035: {Err: commontypes.ErrOrderNotFound},       // Order does not exist
+	40037: {Err: commontypes.ErrOrderNotFound},       // Order ID does not exist
+}
+
+// newAPIError returns the APIError of a failed request
+func newAPIError(httpStatus, code int, message string) *commontypes.APIError {
+	return commontypes.NewAPIError("BitMart", httpStatus, code, message, errorCodes)
+}
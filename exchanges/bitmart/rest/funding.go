@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/djpken/go-exc/exchanges/bitmart/requests/rest/funding"
@@ -20,7 +21,7 @@ func NewFunding(c *ClientRest) *Funding {
 // GetDepositAddress retrieves deposit address for a currency
 //
 // API: GET /account/v1/deposit/address
-func (f *Funding) GetDepositAddress(req funding.GetDepositAddressRequest) (*responses.DepositAddressResponse, error) {
+func (f *Funding) GetDepositAddress(ctx context.Context, req funding.GetDepositAddressRequest) (*responses.DepositAddressResponse, error) {
 	endpoint := fmt.Sprintf("/account/v1/deposit/address?currency=%s", req.Currency)
 
 	if req.Chain != "" {
@@ -28,7 +29,7 @@ func (f *Funding) GetDepositAddress(req funding.GetDepositAddressRequest) (*resp
 	}
 
 	var result responses.DepositAddressResponse
-	if err := f.client.GET(endpoint, &result); err != nil {
+	if err := f.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -38,11 +39,11 @@ func (f *Funding) GetDepositAddress(req funding.GetDepositAddressRequest) (*resp
 // Withdraw initiates a withdrawal
 //
 // API: POST /account/v1/withdraw/apply
-func (f *Funding) Withdraw(req funding.WithdrawRequest) (*responses.WithdrawResponse, error) {
+func (f *Funding) Withdraw(ctx context.Context, req funding.WithdrawRequest) (*responses.WithdrawResponse, error) {
 	endpoint := "/account/v1/withdraw/apply"
 
 	var result responses.WithdrawResponse
-	if err := f.client.POST(endpoint, req, &result); err != nil {
+	if err := f.client.POST(ctx, endpoint, req, &result); err != nil {
 		return nil, err
 	}
 
@@ -52,7 +53,7 @@ func (f *Funding) Withdraw(req funding.WithdrawRequest) (*responses.WithdrawResp
 // GetDepositHistory retrieves deposit history
 //
 // API: GET /account/v2/deposit-withdraw/history
-func (f *Funding) GetDepositHistory(req funding.GetDepositHistoryRequest) (*responses.DepositHistoryResponse, error) {
+func (f *Funding) GetDepositHistory(ctx context.Context, req funding.GetDepositHistoryRequest) (*responses.DepositHistoryResponse, error) {
 	endpoint := "/account/v2/deposit-withdraw/history?operation_type=deposit"
 
 	if req.Currency != "" {
@@ -60,7 +61,7 @@ func (f *Funding) GetDepositHistory(req funding.GetDepositHistoryRequest) (*resp
 	}
 
 	var result responses.DepositHistoryResponse
-	if err := f.client.GET(endpoint, &result); err != nil {
+	if err := f.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -70,7 +71,7 @@ func (f *Funding) GetDepositHistory(req funding.GetDepositHistoryRequest) (*resp
 // GetWithdrawHistory retrieves withdrawal history
 //
 // API: GET /account/v2/deposit-withdraw/history
-func (f *Funding) GetWithdrawHistory(req funding.GetWithdrawHistoryRequest) (*responses.WithdrawHistoryResponse, error) {
+func (f *Funding) GetWithdrawHistory(ctx context.Context, req funding.GetWithdrawHistoryRequest) (*responses.WithdrawHistoryResponse, error) {
 	endpoint := "/account/v2/deposit-withdraw/history?operation_type=withdraw"
 
 	if req.Currency != "" {
@@ -78,7 +79,7 @@ func (f *Funding) GetWithdrawHistory(req funding.GetWithdrawHistoryRequest) (*re
 	}
 
 	var result responses.WithdrawHistoryResponse
-	if err := f.client.GET(endpoint, &result); err != nil {
+	if err := f.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
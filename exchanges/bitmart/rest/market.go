@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -21,11 +22,11 @@ func NewMarket(c *ClientRest) *Market {
 // GetTicker retrieves ticker information for a specific symbol
 //
 // API: GET /spot/quotation/v3/ticker
-func (m *Market) GetTicker(req market.GetTickerRequest) (*responses.TickerResponse, error) {
+func (m *Market) GetTicker(ctx context.Context, req market.GetTickerRequest) (*responses.TickerResponse, error) {
 	endpoint := fmt.Sprintf("/spot/quotation/v3/ticker?symbol=%s", req.Symbol)
 
 	var result responses.TickerResponse
-	if err := m.client.GET(endpoint, &result); err != nil {
+	if err := m.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -35,11 +36,11 @@ func (m *Market) GetTicker(req market.GetTickerRequest) (*responses.TickerRespon
 // GetTickers retrieves ticker information for all symbols
 //
 // API: GET /spot/quotation/v3/tickers
-func (m *Market) GetTickers() (*responses.TickersResponse, error) {
+func (m *Market) GetTickers(ctx context.Context) (*responses.TickersResponse, error) {
 	endpoint := "/spot/quotation/v3/tickers"
 
 	var result responses.TickersResponse
-	if err := m.client.GET(endpoint, &result); err != nil {
+	if err := m.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -49,7 +50,7 @@ func (m *Market) GetTickers() (*responses.TickersResponse, error) {
 // GetOrderBook retrieves order book for a specific symbol
 //
 // API: GET /spot/quotation/v3/books
-func (m *Market) GetOrderBook(req market.GetOrderBookRequest) (*responses.OrderBookResponse, error) {
+func (m *Market) GetOrderBook(ctx context.Context, req market.GetOrderBookRequest) (*responses.OrderBookResponse, error) {
 	endpoint := fmt.Sprintf("/spot/quotation/v3/books?symbol=%s", req.Symbol)
 
 	if req.Depth > 0 {
@@ -57,7 +58,7 @@ func (m *Market) GetOrderBook(req market.GetOrderBookRequest) (*responses.OrderB
 	}
 
 	var result responses.OrderBookResponse
-	if err := m.client.GET(endpoint, &result); err != nil {
+	if err := m.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -67,7 +68,7 @@ func (m *Market) GetOrderBook(req market.GetOrderBookRequest) (*responses.OrderB
 // GetTrades retrieves recent trades for a specific symbol
 //
 // API: GET /spot/quotation/v3/trades
-func (m *Market) GetTrades(req market.GetTradesRequest) (*responses.TradesResponse, error) {
+func (m *Market) GetTrades(ctx context.Context, req market.GetTradesRequest) (*responses.TradesResponse, error) {
 	endpoint := fmt.Sprintf("/spot/quotation/v3/trades?symbol=%s", req.Symbol)
 
 	if req.Limit > 0 {
@@ -75,7 +76,7 @@ func (m *Market) GetTrades(req market.GetTradesRequest) (*responses.TradesRespon
 	}
 
 	var result responses.TradesResponse
-	if err := m.client.GET(endpoint, &result); err != nil {
+	if err := m.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -85,7 +86,7 @@ func (m *Market) GetTrades(req market.GetTradesRequest) (*responses.TradesRespon
 // GetKlines retrieves candlestick/kline data for a specific symbol
 //
 // API: GET /spot/quotation/v3/klines
-func (m *Market) GetKlines(req market.GetKlineRequest) (*responses.KlineResponse, error) {
+func (m *Market) GetKlines(ctx context.Context, req market.GetKlineRequest) (*responses.KlineResponse, error) {
 	endpoint := fmt.Sprintf("/spot/quotation/v3/klines?symbol=%s&step=%d",
 		req.Symbol, req.Step)
 
@@ -102,7 +103,7 @@ func (m *Market) GetKlines(req market.GetKlineRequest) (*responses.KlineResponse
 	}
 
 	var result responses.KlineResponse
-	if err := m.client.GET(endpoint, &result); err != nil {
+	if err := m.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -112,11 +113,11 @@ func (m *Market) GetKlines(req market.GetKlineRequest) (*responses.KlineResponse
 // GetSymbols retrieves all available trading pairs
 //
 // API: GET /spot/v1/symbols
-func (m *Market) GetSymbols() (*responses.SymbolsResponse, error) {
+func (m *Market) GetSymbols(ctx context.Context) (*responses.SymbolsResponse, error) {
 	endpoint := "/spot/v1/symbols"
 
 	var result responses.SymbolsResponse
-	if err := m.client.GET(endpoint, &result); err != nil {
+	if err := m.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -126,11 +127,25 @@ func (m *Market) GetSymbols() (*responses.SymbolsResponse, error) {
 // GetSymbolDetail retrieves details for a specific trading pair
 //
 // API: GET /spot/v1/symbols/details
-func (m *Market) GetSymbolDetail(req market.GetSymbolDetailRequest) (*responses.SymbolDetailResponse, error) {
+func (m *Market) GetSymbolDetail(ctx context.Context, req market.GetSymbolDetailRequest) (*responses.SymbolDetailResponse, error) {
 	endpoint := fmt.Sprintf("/spot/v1/symbols/details?symbol=%s", req.Symbol)
 
 	var result responses.SymbolDetailResponse
-	if err := m.client.GET(endpoint, &result); err != nil {
+	if err := m.client.GET(ctx, endpoint, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetServerTime retrieves the system time
+//
+// API: GET /system/time
+func (m *Market) GetServerTime(ctx context.Context) (*responses.ServerTimeResponse, error) {
+	endpoint := "/system/time"
+
+	var result responses.ServerTimeResponse
+	if err := m.client.GET(ctx, endpoint, &result); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,118 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/djpken/go-exc/ratelimit"
+)
+
+// RateLimits are the BitMart per-endpoint rate limits, keyed by path.
+// Private endpoints are limited per UID, public endpoints per IP.
+//
+// https://developer-pro.bitmart.com/en/spot/#rate-limit
+var RateLimits = map[string]ratelimit.Rule{
+	// System and spot market data
+	"/system/time":               {Limit: 10, Window: time.Second},
+	"/spot/quotation/v3/ticker":  {Limit: 15, Window: 2 * time.Second},
+	"/spot/quotation/v3/tickers": {Limit: 10, Window: 2 * time.Second},
+	"/spot/quotation/v3/books":   {Limit: 15, Window: 2 * time.Second},
+	"/spot/quotation/v3/trades":  {Limit: 15, Window: 2 * time.Second},
+	"/spot/quotation/v3/klines":  {Limit: 15, Window: 2 * time.Second},
+	"/spot/v1/symbols":           {Limit: 8, Window: 2 * time.Second},
+	"/spot/v1/symbols/details":   {Limit: 12, Window: 2 * time.Second},
+
+	// Spot trading
+	"/spot/v2/submit_order":         {Limit: 40, Window: 2 * time.Second},
+	"/spot/v3/cancel_order":         {Limit: 40, Window: 2 * time.Second},
+	"/spot/v1/cancel_orders":        {Limit: 1, Window: 3 * time.Second},
+	"/spot/v2/order_detail":         {Limit: 50, Window: 2 * time.Second},
+	"/spot/v2/orders":               {Limit: 50, Window: 2 * time.Second},
+	"/spot/v2/trades":               {Limit: 50, Window: 2 * time.Second},
+	"/spot/v4/query/open-orders":    {Limit: 12, Window: 2 * time.Second},
+	"/spot/v4/query/history-orders": {Limit: 12, Window: 2 * time.Second},
+	"/spot/v4/query/trades":         {Limit: 12, Window: 2 * time.Second},
+	"/spot/v4/query/order-trades":   {Limit: 12, Window: 2 * time.Second},
+
+	// Account and funding
+	"/account/v1/wallet":                   {Limit: 12, Window: 2 * time.Second},
+	"/account/v1/deposit/address":          {Limit: 2, Window: 2 * time.Second},
+	"/account/v1/withdraw/apply":           {Limit: 8, Window: 2 * time.Second},
+	"/account/v2/deposit-withdraw/history": {Limit: 8, Window: 2 * time.Second},
+
+	// Futures
+	"/contract/public/details":              {Limit: 12, Window: 2 * time.Second},
+	"/contract/public/depth":                {Limit: 12, Window: 2 * time.Second},
+	"/contract/public/funding-rate":         {Limit: 12, Window: 2 * time.Second},
+	"/contract/public/funding-rate-history": {Limit: 12, Window: 2 * time.Second},
+	"/contract/public/kline":                {Limit: 12, Window: 2 * time.Second},
+	"/contract/public/markprice-kline":      {Limit: 12, Window: 2 * time.Second},
+	"/contract/public/market-trade":         {Limit: 12, Window: 2 * time.Second},
+	"/contract/private/assets-detail":       {Limit: 12, Window: 2 * time.Second},
+	"/contract/private/position-v2":         {Limit: 6, Window: 2 * time.Second},
+	"/contract/private/submit-leverage":     {Limit: 24, Window: 2 * time.Second},
+	"/contract/private/submit-order":        {Limit: 24, Window: 2 * time.Second},
+	"/contract/private/modify-limit-order":  {Limit: 24, Window: 2 * time.Second},
+	"/contract/private/cancel-order":        {Limit: 40, Window: 2 * time.Second},
+	"/contract/private/cancel-orders":       {Limit: 2, Window: 2 * time.Second},
+	"/contract/private/order":               {Limit: 50, Window: 2 * time.Second},
+	"/contract/private/get-open-orders":     {Limit: 50, Window: 2 * time.Second},
+	"/contract/private/order-history":       {Limit: 6, Window: 2 * time.Second},
+	"/contract/private/trades":              {Limit: 6, Window: 2 * time.Second},
+	"/contract/private/submit-plan-order":   {Limit: 24, Window: 2 * time.Second},
+	"/contract/private/submit-tp-sl-order":  {Limit: 24, Window: 2 * time.Second},
+	"/contract/private/submit-trail-order":  {Limit: 24, Window: 2 * time.Second},
+	"/contract/private/cancel-plan-order":   {Limit: 40, Window: 2 * time.Second},
+	"/contract/private/cancel-trail-order":  {Limit: 40, Window: 2 * time.Second},
+	"/contract/private/current-plan-order":  {Limit: 50, Window: 2 * time.Second},
+}
+
+// SetRateLimiter replaces the rate limiter. A limiter created with
+// ratelimit.New(nil) disables limiting.
+func (c *ClientRest) SetRateLimiter(limiter *ratelimit.Limiter) {
+	c.limiter = limiter
+}
+
+// RateLimiter returns the rate limiter, e.g. to monitor its headroom
+func (c *ClientRest) RateLimiter() *ratelimit.Limiter {
+	return c.limiter
+}
+
+// wait blocks until a request can be sent on key, recording any wait
+func (c *ClientRest) wait(ctx context.Context, key string) error {
+	d, err := c.limiter.WaitTimed(ctx, key, 1)
+	if d > 0 {
+		c.metrics.RateLimitWait(exchangeName, key, d)
+	}
+	return err
+}
+
+// rateLimitKey returns the RateLimits key of an endpoint: its path without the query
+func rateLimitKey(endpoint string) string {
+	path, _, _ := strings.Cut(endpoint, "?")
+	return path
+}
+
+// observeRateLimit syncs the limiter with the X-BM-RateLimit-* response
+// headers. X-BM-RateLimit-Remaining counts the requests already used in the
+// current window of X-BM-RateLimit-Reset seconds.
+func (c *ClientRest) observeRateLimit(key string, resp *http.Response) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		c.logger.Warn("rate limit exceeded", "endpoint", key)
+		c.limiter.Sync(key, 0, 0)
+		return
+	}
+	used, err := strconv.Atoi(resp.Header.Get("X-BM-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	limit, err := strconv.Atoi(resp.Header.Get("X-BM-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	reset, _ := strconv.Atoi(resp.Header.Get("X-BM-RateLimit-Reset"))
+	c.limiter.Sync(key, limit-used, time.Duration(reset)*time.Second)
+}
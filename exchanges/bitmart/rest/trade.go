@@ -1,8 +1,13 @@
 package rest
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/djpken/go-exc/exchanges/bitmart/requests/rest/trade"
 	responses "github.com/djpken/go-exc/exchanges/bitmart/responses/trade"
+	commontypes "github.com/djpken/go-exc/types"
 )
 
 // Trade provides access to BitMart trading API
@@ -15,28 +20,56 @@ func NewTrade(c *ClientRest) *Trade {
 	return &Trade{client: c}
 }
 
-// PlaceOrder places a new order
+// PlaceOrder places a new order. Orders with a ClientOrderID are retried on
+// transient failures once a lookup by client order ID confirms the failed
+// attempt did not place them.
 //
 // API: POST /spot/v2/submit_order
-func (t *Trade) PlaceOrder(req trade.PlaceOrderRequest) (*responses.PlaceOrderResponse, error) {
+func (t *Trade) PlaceOrder(ctx context.Context, req trade.PlaceOrderRequest) (*responses.PlaceOrderResponse, error) {
 	endpoint := "/spot/v2/submit_order"
 
 	var result responses.PlaceOrderResponse
-	if err := t.client.POST(endpoint, req, &result); err != nil {
+	orderID, err := t.client.submitOrder(ctx, req.ClientOrderID, func() error {
+		return t.client.POST(ctx, endpoint, req, &result)
+	}, func(ctx context.Context) (string, bool, error) {
+		return t.findOrder(ctx, req.ClientOrderID)
+	})
+	if err != nil {
 		return nil, err
 	}
+	if orderID != "" {
+		result.Code = codeSuccess
+		result.Data.OrderID = orderID
+		result.Data.ClientOrderID = req.ClientOrderID
+	}
 
 	return &result, nil
 }
 
+// findOrder looks up an order by its client order ID. It reports found=false
+// only when BitMart confirms the order does not exist.
+func (t *Trade) findOrder(ctx context.Context, clientOrderID string) (string, bool, error) {
+	resp, err := t.GetOrder(ctx, trade.GetOrderRequest{ClientOrderID: clientOrderID})
+	if errors.Is(err, commontypes.ErrOrderNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	if resp.Data.OrderID == "" {
+		return "", false, fmt.Errorf("bitmart: order %s: empty lookup result", clientOrderID)
+	}
+	return resp.Data.OrderID, true, nil
+}
+
 // CancelOrder cancels an existing order
 //
 // API: POST /spot/v3/cancel_order
-func (t *Trade) CancelOrder(req trade.CancelOrderRequest) (*responses.CancelOrderResponse, error) {
+func (t *Trade) CancelOrder(ctx context.Context, req trade.CancelOrderRequest) (*responses.CancelOrderResponse, error) {
 	endpoint := "/spot/v3/cancel_order"
 
 	var result responses.CancelOrderResponse
-	if err := t.client.POST(endpoint, req, &result); err != nil {
+	if err := t.client.POST(ctx, endpoint, req, &result); err != nil {
 		return nil, err
 	}
 
@@ -46,11 +79,11 @@ func (t *Trade) CancelOrder(req trade.CancelOrderRequest) (*responses.CancelOrde
 // CancelAllOrders cancels all orders for a symbol or all symbols
 //
 // API: POST /spot/v1/cancel_orders
-func (t *Trade) CancelAllOrders(req trade.CancelAllOrdersRequest) (*responses.CancelAllOrdersResponse, error) {
+func (t *Trade) CancelAllOrders(ctx context.Context, req trade.CancelAllOrdersRequest) (*responses.CancelAllOrdersResponse, error) {
 	endpoint := "/spot/v1/cancel_orders"
 
 	var result responses.CancelAllOrdersResponse
-	if err := t.client.POST(endpoint, req, &result); err != nil {
+	if err := t.client.POST(ctx, endpoint, req, &result); err != nil {
 		return nil, err
 	}
 
@@ -59,12 +92,12 @@ func (t *Trade) CancelAllOrders(req trade.CancelAllOrdersRequest) (*responses.Ca
 
 // GetOrder retrieves order details
 //
-// API: GET /spot/v2/order_detail
-func (t *Trade) GetOrder(req trade.GetOrderRequest) (*responses.OrderResponse, error) {
+// API: POST /spot/v2/order_detail
+func (t *Trade) GetOrder(ctx context.Context, req trade.GetOrderRequest) (*responses.OrderResponse, error) {
 	endpoint := "/spot/v2/order_detail"
 
 	var result responses.OrderResponse
-	if err := t.client.POST(endpoint, req, &result); err != nil {
+	if err := t.client.POST(ctx, endpoint, req, &result); err != nil {
 		return nil, err
 	}
 
@@ -74,11 +107,11 @@ func (t *Trade) GetOrder(req trade.GetOrderRequest) (*responses.OrderResponse, e
 // GetOrders retrieves order list
 //
 // API: POST /spot/v2/orders
-func (t *Trade) GetOrders(req trade.GetOrdersRequest) (*responses.OrdersResponse, error) {
+func (t *Trade) GetOrders(ctx context.Context, req trade.GetOrdersRequest) (*responses.OrdersResponse, error) {
 	endpoint := "/spot/v2/orders"
 
 	var result responses.OrdersResponse
-	if err := t.client.POST(endpoint, req, &result); err != nil {
+	if err := t.client.POST(ctx, endpoint, req, &result); err != nil {
 		return nil, err
 	}
 
@@ -88,11 +121,67 @@ func (t *Trade) GetOrders(req trade.GetOrdersRequest) (*responses.OrdersResponse
 // GetTrades retrieves trade history
 //
 // API: POST /spot/v2/trades
-func (t *Trade) GetTrades(req trade.GetTradesRequest) (*responses.TradesResponse, error) {
+func (t *Trade) GetTrades(ctx context.Context, req trade.GetTradesRequest) (*responses.TradesResponse, error) {
 	endpoint := "/spot/v2/trades"
 
 	var result responses.TradesResponse
-	if err := t.client.POST(endpoint, req, &result); err != nil {
+	if err := t.client.POST(ctx, endpoint, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetOpenOrders retrieves incomplete orders (new and partially filled)
+//
+// API: POST /spot/v4/query/open-orders
+func (t *Trade) GetOpenOrders(ctx context.Context, req trade.QueryOrdersRequest) (*responses.QueryOrdersResponse, error) {
+	endpoint := "/spot/v4/query/open-orders"
+
+	var result responses.QueryOrdersResponse
+	if err := t.client.POST(ctx, endpoint, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetHistoryOrders retrieves completed orders (filled and canceled)
+//
+// API: POST /spot/v4/query/history-orders
+func (t *Trade) GetHistoryOrders(ctx context.Context, req trade.QueryOrdersRequest) (*responses.QueryOrdersResponse, error) {
+	endpoint := "/spot/v4/query/history-orders"
+
+	var result responses.QueryOrdersResponse
+	if err := t.client.POST(ctx, endpoint, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// QueryTrades retrieves account trade executions
+//
+// API: POST /spot/v4/query/trades
+func (t *Trade) QueryTrades(ctx context.Context, req trade.QueryTradesRequest) (*responses.QueryTradesResponse, error) {
+	endpoint := "/spot/v4/query/trades"
+
+	var result responses.QueryTradesResponse
+	if err := t.client.POST(ctx, endpoint, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// QueryOrderTrades retrieves trade executions of a single order
+//
+// API: POST /spot/v4/query/order-trades
+func (t *Trade) QueryOrderTrades(ctx context.Context, req trade.QueryOrderTradesRequest) (*responses.QueryTradesResponse, error) {
+	endpoint := "/spot/v4/query/order-trades"
+
+	var result responses.QueryTradesResponse
+	if err := t.client.POST(ctx, endpoint, req, &result); err != nil {
 		return nil, err
 	}
 
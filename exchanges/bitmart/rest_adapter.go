@@ -3,6 +3,8 @@ package bitmart
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -68,60 +70,69 @@ type TradeAPIAdapter struct {
 }
 
 // PlaceOrder places a new order
-// Routes to either spot or contract API based on parameters
+// Routes to the contract API for contract symbols (BTCUSDT) and to the spot API
+// for spot symbols (BTC_USDT), unless Extra["account_type"] names the account
 func (a *TradeAPIAdapter) PlaceOrder(ctx context.Context, req commontypes.PlaceOrderRequest) (*commontypes.Order, error) {
-	// Determine if this is a contract or spot order
-	isContract := false
-	posSide := req.PosSide
-
-	// Check if position side is specified (contract trading)
-	if posSide != "" && posSide != commontypes.PositionSideNet {
-		isContract = true
+	if orderAccountType(req.Symbol, req.Extra) == commontypes.AccountTypeFutures {
+		return a.placeContractOrder(ctx, req)
 	}
 
-	extra := req.Extra
-	if extra != nil {
-		if _, hasLeverage := extra["leverage"]; hasLeverage {
-			isContract = true
-		}
-		if _, hasOpenType := extra["open_type"]; hasOpenType {
-			isContract = true
-		}
-		if tdMode, hasTdMode := extra["tdMode"]; hasTdMode && tdMode != "" {
-			isContract = true
-		}
+	// Position sides and reduce-only orders only exist on contracts
+	if req.ReduceOnly || req.PosSide == commontypes.PositionSideLong || req.PosSide == commontypes.PositionSideShort {
+		return nil, fmt.Errorf("bitmart: position side or reduce-only on spot symbol %s: %w", req.Symbol, commontypes.ErrInvalidOrder)
 	}
+	return a.placeSpotOrder(ctx, req)
+}
 
-	// Route to appropriate API
-	if isContract {
-		return a.placeContractOrder(ctx, req)
+// orderAccountType returns the account an order on symbol belongs to:
+// Extra["account_type"] when set, else futures for contract symbols and spot
+// for spot symbols
+func orderAccountType(symbol string, extra map[string]interface{}) string {
+	if accType, ok := extra["account_type"].(string); ok && accType != "" {
+		return accType
 	}
-	return a.placeSpotOrder(ctx, req)
+	if symbol != "" && !isSpotSymbol(symbol) {
+		return commontypes.AccountTypeFutures
+	}
+	return commontypes.AccountTypeSpot
 }
 
 // placeSpotOrder places a spot trading order
 func (a *TradeAPIAdapter) placeSpotOrder(ctx context.Context, commonReq commontypes.PlaceOrderRequest) (*commontypes.Order, error) {
+	if commonReq.TakeProfit != nil || commonReq.StopLoss != nil {
+		return nil, fmt.Errorf("bitmart: take-profit/stop-loss legs on spot orders: %w", commontypes.ErrNotSupported)
+	}
+
+	tif, err := commontypes.OrderTimeInForce(commonReq)
+	if err != nil {
+		return nil, fmt.Errorf("bitmart: %w", err)
+	}
+	orderType, err := a.converter.ToSpotOrderType(commonReq.Type, tif)
+	if err != nil {
+		return nil, err
+	}
+
 	req := tradereq.PlaceOrderRequest{
 		Symbol:        commonReq.Symbol,
 		ClientOrderID: commonReq.ClientOrderID,
 		Side:          a.converter.ConvertOrderSide(string(commonReq.Side)),
-		Type:          a.converter.ConvertOrderType(commonReq.Type),
-		Size:          a.converter.formatFloat(commonReq.Quantity),
+		Type:          orderType,
+		Size:          commonReq.Quantity.String(),
 	}
 
 	price := commonReq.Price
-	if price > 0 {
-		req.Price = a.converter.formatFloat(price)
+	if price.IsPositive() {
+		req.Price = price.String()
 	}
 
-	resp, err := a.client.Trade.PlaceOrder(req)
+	resp, err := a.client.Trade.PlaceOrder(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	// Query order details
 	orderReq := tradereq.GetOrderRequest{OrderID: resp.Data.OrderID}
-	orderResp, err := a.client.Trade.GetOrder(orderReq)
+	orderResp, err := a.client.Trade.GetOrder(ctx, orderReq)
 	if err != nil {
 		return nil, err
 	}
@@ -133,18 +144,46 @@ func (a *TradeAPIAdapter) placeSpotOrder(ctx context.Context, commonReq commonty
 func (a *TradeAPIAdapter) placeContractOrder(ctx context.Context, commonReq commontypes.PlaceOrderRequest) (*commontypes.Order, error) {
 	// Convert side and position side to BitMart contract side value
 	contractSide := a.converter.ConvertToContractSide(commonReq.Side, commonReq.PosSide)
+	if commonReq.ReduceOnly {
+		// Sides 2 and 3 close a position, which is reduce-only in both
+		// one-way and hedge mode; sides 1 and 4 in hedge mode always open
+		switch {
+		case commonReq.PosSide == commontypes.PositionSideLong && contractSide == 1,
+			commonReq.PosSide == commontypes.PositionSideShort && contractSide == 4:
+			return nil, fmt.Errorf("bitmart: reduce-only %s order would open a %s position: %w", commonReq.Side, commonReq.PosSide, commontypes.ErrInvalidOrder)
+		case contractSide == 1:
+			contractSide = 2
+		case contractSide == 4:
+			contractSide = 3
+		}
+	}
+
+	tif, err := commontypes.OrderTimeInForce(commonReq)
+	if err != nil {
+		return nil, fmt.Errorf("bitmart: %w", err)
+	}
+	mode, err := a.converter.ToContractMode(commonReq.Type, tif)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := contractSize(commonReq.Quantity)
+	if err != nil {
+		return nil, err
+	}
 
 	req := contractreq.SubmitOrderRequest{
 		Symbol:        commonReq.Symbol,
 		ClientOrderID: commonReq.ClientOrderID,
 		Side:          contractSide,
 		Type:          a.converter.ConvertOrderType(commonReq.Type),
-		Size:          int(commonReq.Quantity), // Contract orders use integer size
+		Size:          size,
+		Mode:          mode,
 	}
 
 	price := commonReq.Price
-	if price > 0 {
-		req.Price = a.converter.formatFloat(price)
+	if price.IsPositive() {
+		req.Price = price.String()
 	}
 
 	extra := commonReq.Extra
@@ -164,12 +203,16 @@ func (a *TradeAPIAdapter) placeContractOrder(ctx context.Context, commonReq comm
 				req.OpenType = "isolated"
 			}
 		}
-		if mode, ok := extra["mode"].(int); ok {
+		if mode, ok := extra["mode"].(int); ok && req.Mode == 0 {
 			req.Mode = mode
 		}
 	}
 
-	resp, err := a.client.Contract.SubmitOrder(req)
+	if err := a.applyPresetTPSL(&req, commonReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Contract.SubmitOrder(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -178,6 +221,34 @@ func (a *TradeAPIAdapter) placeContractOrder(ctx context.Context, commonReq comm
 	return a.converter.ConvertContractOrder(resp), nil
 }
 
+// applyPresetTPSL maps attached TakeProfit/StopLoss legs to BitMart preset fields
+// BitMart executes preset TP/SL at market, so legs with an order price are rejected.
+func (a *TradeAPIAdapter) applyPresetTPSL(req *contractreq.SubmitOrderRequest, commonReq commontypes.PlaceOrderRequest) error {
+	if tp := commonReq.TakeProfit; tp != nil {
+		if tp.OrderPrice.IsPositive() {
+			return fmt.Errorf("bitmart: limit take-profit leg: %w", commontypes.ErrNotSupported)
+		}
+		priceType, err := a.converter.ToContractPriceType(tp.TriggerPriceType)
+		if err != nil {
+			return err
+		}
+		req.PresetTakeProfitPrice = tp.TriggerPrice.String()
+		req.PresetTakeProfitPriceType = priceType
+	}
+	if sl := commonReq.StopLoss; sl != nil {
+		if sl.OrderPrice.IsPositive() {
+			return fmt.Errorf("bitmart: limit stop-loss leg: %w", commontypes.ErrNotSupported)
+		}
+		priceType, err := a.converter.ToContractPriceType(sl.TriggerPriceType)
+		if err != nil {
+			return err
+		}
+		req.PresetStopLossPrice = sl.TriggerPrice.String()
+		req.PresetStopLossPriceType = priceType
+	}
+	return nil
+}
+
 // PlaceSingleOrder places exactly one order and wraps the result in PlaceOrderResult.
 func (a *TradeAPIAdapter) PlaceSingleOrder(ctx context.Context, req commontypes.PlaceOrderRequest) (*commontypes.PlaceOrderResult, error) {
 	order, err := a.PlaceOrder(ctx, req)
@@ -199,34 +270,291 @@ func (a *TradeAPIAdapter) PlaceMultiOrder(ctx context.Context, reqs []commontype
 }
 
 // CancelOrder cancels an existing order
+// Supports both spot and contract orders, routed from the symbol like PlaceOrder
+//
+// Usage:
+//   - Spot order: CancelOrder(ctx, "BTC_USDT", orderID, nil)
+//   - Contract order: CancelOrder(ctx, "BTCUSDT", orderID, nil)
 func (a *TradeAPIAdapter) CancelOrder(ctx context.Context, symbol, orderID string, extra map[string]interface{}) error {
+	if orderAccountType(symbol, extra) == commontypes.AccountTypeFutures {
+		_, err := a.client.Contract.CancelOrder(ctx, contractreq.CancelContractOrderRequest{
+			Symbol:  symbol,
+			OrderID: orderID,
+		})
+		return err
+	}
+
 	req := tradereq.CancelOrderRequest{
 		Symbol:  symbol,
 		OrderID: orderID,
 	}
 
-	_, err := a.client.Trade.CancelOrder(req)
-	return err
+	resp, err := a.client.Trade.CancelOrder(ctx, req)
+	if err != nil {
+		return err
+	}
+	if !resp.Data.Result {
+		return errNotCancelled(orderID, resp.Message)
+	}
+	return nil
 }
 
-// GetOrderDetail gets order details
-// Supports both spot and contract orders
+// errNotCancelled reports a cancel request BitMart accepted without cancelling
+// the order, which happens when the order is no longer open
+func errNotCancelled(orderID, msg string) error {
+	if msg == "" {
+		return fmt.Errorf("bitmart: order %s not cancelled: %w", orderID, commontypes.ErrOrderNotFound)
+	}
+	return fmt.Errorf("bitmart: order %s not cancelled (%s): %w", orderID, msg, commontypes.ErrOrderNotFound)
+}
+
+// CancelOrders cancels multiple orders sequentially and returns per-order results.
+// BitMart has no native batch-cancel API for mixed orders, so orders are sent one by one.
+func (a *TradeAPIAdapter) CancelOrders(ctx context.Context, reqs []commontypes.CancelOrderRequest) ([]*commontypes.CancelOrderResult, error) {
+	results := make([]*commontypes.CancelOrderResult, len(reqs))
+	for i, req := range reqs {
+		err := a.CancelOrder(ctx, req.Symbol, req.OrderID, req.Extra)
+		results[i] = &commontypes.CancelOrderResult{Symbol: req.Symbol, OrderID: req.OrderID, Error: err}
+	}
+	return results, nil
+}
+
+// contractOpenOrdersLimit is the largest page of the contract open-orders API
+const contractOpenOrdersLimit = 100
+
+// CancelAllOrders cancels all open orders
+// Contract orders are selected with instType swap/futures or a contract symbol;
+// otherwise spot orders are cancelled.
 //
-// Usage:
-//   - Spot order: GetOrderDetail(ctx, symbol, orderID, nil)
-//   - Contract order: GetOrderDetail(ctx, symbol, orderID, map[string]interface{}{"account_type": types.AccountTypeFutures})
+// The contract cancel-all API works per symbol and does not report which orders
+// it cancelled, so open orders are listed first and reported as cancelled when
+// the request for their symbol succeeds. The open-orders API has no cursor, so
+// listing and cancelling repeat until a page comes back short.
+func (a *TradeAPIAdapter) CancelAllOrders(ctx context.Context, symbol string, instType commontypes.InstrumentType) ([]*commontypes.CancelOrderResult, error) {
+	if isContractQuery(instType, symbol, nil) {
+		var results []*commontypes.CancelOrderResult
+		seen := make(map[string]bool)
+		for {
+			resp, err := a.client.Contract.GetOpenOrders(ctx, contractreq.GetContractOpenOrdersRequest{Symbol: symbol, Limit: contractOpenOrdersLimit})
+			if err != nil {
+				return nil, err
+			}
+
+			bySymbol := make(map[string][]*commontypes.CancelOrderResult)
+			var symbols []string
+			for _, o := range resp.Data {
+				// Orders whose cancel failed are listed again on the next page
+				if seen[o.OrderID] {
+					continue
+				}
+				seen[o.OrderID] = true
+				if _, ok := bySymbol[o.Symbol]; !ok {
+					symbols = append(symbols, o.Symbol)
+				}
+				r := &commontypes.CancelOrderResult{Symbol: o.Symbol, OrderID: o.OrderID, ClientOrderID: o.ClientOrderID}
+				bySymbol[o.Symbol] = append(bySymbol[o.Symbol], r)
+				results = append(results, r)
+			}
+
+			for _, sym := range symbols {
+				if _, err := a.client.Contract.CancelAllOrders(ctx, contractreq.CancelContractOrdersRequest{Symbol: sym}); err != nil {
+					for _, r := range bySymbol[sym] {
+						r.Error = err
+					}
+				}
+			}
+
+			if len(resp.Data) < contractOpenOrdersLimit || len(symbols) == 0 {
+				return results, nil
+			}
+		}
+	}
+
+	resp, err := a.client.Trade.CancelAllOrders(ctx, tradereq.CancelAllOrdersRequest{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*commontypes.CancelOrderResult, 0, len(resp.Data.Success)+len(resp.Data.Failed))
+	for _, id := range resp.Data.Success {
+		results = append(results, &commontypes.CancelOrderResult{Symbol: symbol, OrderID: id})
+	}
+	for _, id := range resp.Data.Failed {
+		results = append(results, &commontypes.CancelOrderResult{
+			Symbol:  symbol,
+			OrderID: id,
+			Error:   errNotCancelled(id, ""),
+		})
+	}
+	return results, nil
+}
+
+// AmendOrder amends the price and/or quantity of a resting order
+// Supports both spot and contract orders, routed from the symbol like PlaceOrder
 //
-// For contract orders, returns aggregated trade executions as an Order object
-func (a *TradeAPIAdapter) GetOrderDetail(ctx context.Context, commonReq commontypes.GetOrderRequest) (*commontypes.Order, error) {
-	// Check if this is a contract order
-	accountType := commontypes.AccountTypeSpot // default to spot
-	extra := commonReq.Extra
-	if extra != nil {
-		if accType, ok := extra["account_type"].(string); ok {
-			accountType = accType
+// Contract limit orders are modified in place via the native modify-limit-order API.
+// BitMart spot has no amend API, so the original order is cancelled and a new one is
+// placed with the same side and type and NewClientOrderID; the result is flagged as Replaced.
+func (a *TradeAPIAdapter) AmendOrder(ctx context.Context, commonReq commontypes.AmendOrderRequest) (*commontypes.AmendOrderResult, error) {
+	if !commonReq.NewQuantity.IsPositive() && !commonReq.NewPrice.IsPositive() {
+		return nil, fmt.Errorf("bitmart: amend order requires a new quantity or price: %w", commontypes.ErrInvalidOrder)
+	}
+	if commonReq.OrderID == "" && commonReq.ClientOrderID == "" {
+		return nil, fmt.Errorf("bitmart: amend order requires an order ID or client order ID: %w", commontypes.ErrInvalidOrder)
+	}
+
+	switch orderAccountType(commonReq.Symbol, commonReq.Extra) {
+	case commontypes.AccountTypeFutures:
+		return a.amendContractOrder(ctx, commonReq)
+	case commontypes.AccountTypeSpot:
+		return a.replaceSpotOrder(ctx, commonReq)
+	default:
+		return nil, commontypes.ErrNotSupported
+	}
+}
+
+// amendContractOrder modifies a contract limit order in place
+func (a *TradeAPIAdapter) amendContractOrder(ctx context.Context, commonReq commontypes.AmendOrderRequest) (*commontypes.AmendOrderResult, error) {
+	size, err := contractSize(commonReq.NewQuantity)
+	if err != nil {
+		return nil, err
+	}
+	req := contractreq.ModifyLimitOrderRequest{
+		Symbol:        commonReq.Symbol,
+		ClientOrderID: commonReq.ClientOrderID,
+		Size:          size,
+	}
+	if commonReq.OrderID != "" {
+		orderID, err := strconv.ParseInt(commonReq.OrderID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bitmart: invalid contract order ID %q: %w", commonReq.OrderID, err)
 		}
+		req.OrderID = orderID
 	}
-	switch accountType {
+	if commonReq.NewPrice.IsPositive() {
+		req.Price = commonReq.NewPrice.String()
+	}
+
+	resp, err := a.client.Contract.ModifyLimitOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID := strconv.FormatInt(resp.Data.OrderID, 10)
+	order := &commontypes.Order{
+		ID:            orderID,
+		ClientOrderID: resp.Data.ClientOrderID,
+		Symbol:        commonReq.Symbol,
+		Extra:         map[string]interface{}{"account_type": commontypes.AccountTypeFutures},
+	}
+	if req.Size > 0 {
+		order.Quantity = commontypes.NewDecimalFromInt(int64(req.Size))
+	}
+	if commonReq.NewPrice.IsPositive() {
+		order.Price = commonReq.NewPrice
+	}
+
+	return &commontypes.AmendOrderResult{
+		Order:           order,
+		Replaced:        false,
+		OriginalOrderID: orderID,
+	}, nil
+}
+
+// replaceSpotOrder emulates an amend on spot by cancelling the order and placing a new one.
+// NewQuantity is the new total size, so the replacement is placed for NewQuantity minus
+// the size filled once the cancel has taken effect. Unset fields fall back to the
+// original price and the remaining unfilled size.
+func (a *TradeAPIAdapter) replaceSpotOrder(ctx context.Context, commonReq commontypes.AmendOrderRequest) (*commontypes.AmendOrderResult, error) {
+	origResp, err := a.client.Trade.GetOrder(ctx, tradereq.GetOrderRequest{
+		OrderID:       commonReq.OrderID,
+		ClientOrderID: commonReq.ClientOrderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	orig := origResp.Data
+
+	symbol := commonReq.Symbol
+	if symbol == "" {
+		symbol = orig.Symbol
+	}
+
+	price := commonReq.NewPrice.String()
+	if !commonReq.NewPrice.IsPositive() {
+		price = orig.Price
+	}
+
+	total := commonReq.NewQuantity
+	if !total.IsPositive() {
+		total = a.converter.stringToDecimal(orig.Size)
+	}
+	remaining, err := total.Sub(a.converter.stringToDecimal(orig.FilledSize))
+	if err != nil {
+		return nil, err
+	}
+	if !remaining.IsPositive() {
+		return nil, fmt.Errorf("bitmart: new quantity %s does not exceed filled size %s of order %s: %w", total, orig.FilledSize, orig.OrderID, commontypes.ErrInvalidOrder)
+	}
+
+	cancelResp, err := a.client.Trade.CancelOrder(ctx, tradereq.CancelOrderRequest{
+		Symbol:        symbol,
+		OrderID:       orig.OrderID,
+		ClientOrderID: commonReq.ClientOrderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !cancelResp.Data.Result {
+		return nil, errNotCancelled(orig.OrderID, cancelResp.Message)
+	}
+
+	// Fills may land between the lookup above and the cancel, so the
+	// replacement is sized from the filled size of the cancelled order
+	finalResp, err := a.client.Trade.GetOrder(ctx, tradereq.GetOrderRequest{OrderID: orig.OrderID})
+	if err != nil {
+		return nil, fmt.Errorf("bitmart: order %s cancelled but its final filled size is unknown: %w", orig.OrderID, err)
+	}
+	remaining, err = total.Sub(a.converter.stringToDecimal(finalResp.Data.FilledSize))
+	if err != nil {
+		return nil, err
+	}
+	if !remaining.IsPositive() {
+		return nil, fmt.Errorf("bitmart: order %s cancelled after filling %s, leaving nothing of new quantity %s to place: %w", orig.OrderID, finalResp.Data.FilledSize, total, commontypes.ErrInvalidOrder)
+	}
+
+	placeReq := tradereq.PlaceOrderRequest{
+		Symbol:        symbol,
+		Side:          orig.Side,
+		Type:          orig.Type,
+		Size:          remaining.String(),
+		Price:         price,
+		ClientOrderID: commonReq.NewClientOrderID,
+	}
+
+	placeResp, err := a.client.Trade.PlaceOrder(ctx, placeReq)
+	if err != nil {
+		return nil, fmt.Errorf("bitmart: order %s cancelled but replacement failed: %w", orig.OrderID, err)
+	}
+
+	orderResp, err := a.client.Trade.GetOrder(ctx, tradereq.GetOrderRequest{OrderID: placeResp.Data.OrderID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &commontypes.AmendOrderResult{
+		Order:           a.converter.ConvertOrderDetail(&orderResp.Data),
+		Replaced:        true,
+		OriginalOrderID: orig.OrderID,
+	}, nil
+}
+
+// GetOrderDetail gets order details
+// Supports both spot and contract orders, routed from the symbol like PlaceOrder
+//
+// For contract orders, returns aggregated trade executions as an Order object
+func (a *TradeAPIAdapter) GetOrderDetail(ctx context.Context, commonReq commontypes.GetOrderRequest) (*commontypes.Order, error) {
+	switch orderAccountType(commonReq.Symbol, commonReq.Extra) {
 	case commontypes.AccountTypeFutures:
 		// Query contract trades for this order
 		req := contractreq.GetContractTradesRequest{}
@@ -239,13 +567,13 @@ func (a *TradeAPIAdapter) GetOrderDetail(ctx context.Context, commonReq commonty
 		if commonReq.ClientOrderID != "" {
 			req.ClientOrderID = commonReq.ClientOrderID
 		}
-		resp, err := a.client.Contract.GetContractTrades(req)
+		resp, err := a.client.Contract.GetContractTrades(ctx, req)
 		if err != nil {
 			return nil, err
 		}
 
 		if len(resp.Data) == 0 {
-			return nil, fmt.Errorf("no trades found for order %s", commonReq.OrderID)
+			return nil, fmt.Errorf("bitmart: no trades found for order %s: %w", commonReq.OrderID, commontypes.ErrOrderNotFound)
 		}
 
 		// Convert contract trades to Order
@@ -254,7 +582,7 @@ func (a *TradeAPIAdapter) GetOrderDetail(ctx context.Context, commonReq commonty
 	case commontypes.AccountTypeSpot:
 		// Query spot order detail (existing implementation)
 		req := tradereq.GetOrderRequest{OrderID: commonReq.OrderID, ClientOrderID: commonReq.ClientOrderID}
-		resp, err := a.client.Trade.GetOrder(req)
+		resp, err := a.client.Trade.GetOrder(ctx, req)
 		if err != nil {
 			return nil, err
 		}
@@ -266,6 +594,625 @@ func (a *TradeAPIAdapter) GetOrderDetail(ctx context.Context, commonReq commonty
 	}
 }
 
+// isContractQuery reports whether an order query targets the futures account:
+// Extra["account_type"] when set, else InstType when set, else the symbol
+func isContractQuery(instType commontypes.InstrumentType, symbol string, extra map[string]interface{}) bool {
+	if accType, ok := extra["account_type"].(string); ok && accType != "" {
+		return accType == commontypes.AccountTypeFutures
+	}
+	switch instType {
+	case commontypes.InstrumentSwap, commontypes.InstrumentFutures:
+		return true
+	case "":
+		return orderAccountType(symbol, nil) == commontypes.AccountTypeFutures
+	default:
+		return false
+	}
+}
+
+// GetOpenOrders lists incomplete orders
+// Supports both spot and contract orders
+//
+// Usage:
+//   - Spot orders: GetOpenOrders(ctx, GetOpenOrdersRequest{Symbol: "BTC_USDT"})
+//   - Contract orders: GetOpenOrders(ctx, GetOpenOrdersRequest{Symbol: "BTCUSDT"}), or set
+//     InstType to types.InstrumentSwap to list the orders of every contract
+//
+// BitMart has no order ID cursor; After is resolved client-side.
+func (a *TradeAPIAdapter) GetOpenOrders(ctx context.Context, commonReq commontypes.GetOpenOrdersRequest) ([]*commontypes.Order, error) {
+	if isContractQuery(commonReq.InstType, commonReq.Symbol, commonReq.Extra) {
+		resp, err := a.client.Contract.GetOpenOrders(ctx, contractreq.GetContractOpenOrdersRequest{
+			Symbol: commonReq.Symbol,
+			Limit:  100, // Fetch the max page so the cursor can be resolved
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		orders := make([]*commontypes.Order, 0, len(resp.Data))
+		for i := range resp.Data {
+			orders = append(orders, a.converter.ConvertContractOrderDetail(&resp.Data[i]))
+		}
+		return commontypes.PaginateOrders(orders, commonReq.After, commonReq.Limit), nil
+	}
+
+	req := tradereq.QueryOrdersRequest{Symbol: commonReq.Symbol}
+	if commonReq.After != "" {
+		endTime, err := a.spotOrderCreateTime(ctx, commonReq.After)
+		if err != nil {
+			return nil, err
+		}
+		req.EndTime = endTime
+	}
+	if commonReq.Limit > 0 {
+		req.Limit = min(commonReq.Limit+1, 200) // Room for the cursor order itself
+	}
+
+	resp, err := a.client.Trade.GetOpenOrders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*commontypes.Order, 0, len(resp.Data))
+	for i := range resp.Data {
+		orders = append(orders, a.converter.ConvertOrderV4(&resp.Data[i]))
+	}
+	return commontypes.PaginateOrders(orders, commonReq.After, commonReq.Limit), nil
+}
+
+// GetOrderHistory lists completed orders
+// Supports both spot and contract orders, routed the same way as GetOpenOrders.
+// BitMart has no order ID cursor; After is resolved to the cursor order's
+// creation time and used as the end of the time window.
+func (a *TradeAPIAdapter) GetOrderHistory(ctx context.Context, commonReq commontypes.GetOrderHistoryRequest) ([]*commontypes.Order, error) {
+	if isContractQuery(commonReq.InstType, commonReq.Symbol, commonReq.Extra) {
+		req := contractreq.GetContractOrderHistoryRequest{Symbol: commonReq.Symbol}
+		if !commonReq.StartTime.IsZero() {
+			req.StartTime = commonReq.StartTime.Unix()
+		}
+		if !commonReq.EndTime.IsZero() {
+			req.EndTime = commonReq.EndTime.Unix()
+		}
+		if commonReq.After != "" {
+			orderResp, err := a.client.Contract.GetOrder(ctx, contractreq.GetContractOrderRequest{
+				Symbol:  commonReq.Symbol,
+				OrderID: commonReq.After,
+			})
+			if err != nil {
+				return nil, err
+			}
+			// Round up so orders created in the same second as the cursor are kept
+			req.EndTime = (orderResp.Data.CreateTime + 999) / 1000
+		}
+
+		resp, err := a.client.Contract.GetOrderHistory(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		orders := make([]*commontypes.Order, 0, len(resp.Data))
+		for i := range resp.Data {
+			orders = append(orders, a.converter.ConvertContractOrderDetail(&resp.Data[i]))
+		}
+		return commontypes.PaginateOrders(orders, commonReq.After, commonReq.Limit), nil
+	}
+
+	req := tradereq.QueryOrdersRequest{Symbol: commonReq.Symbol}
+	if !commonReq.StartTime.IsZero() {
+		req.StartTime = commonReq.StartTime.UnixMilli()
+	}
+	if !commonReq.EndTime.IsZero() {
+		req.EndTime = commonReq.EndTime.UnixMilli()
+	}
+	if commonReq.After != "" {
+		endTime, err := a.spotOrderCreateTime(ctx, commonReq.After)
+		if err != nil {
+			return nil, err
+		}
+		req.EndTime = endTime
+	}
+	if commonReq.Limit > 0 {
+		req.Limit = min(commonReq.Limit+1, 200) // Room for the cursor order itself
+	}
+
+	resp, err := a.client.Trade.GetHistoryOrders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*commontypes.Order, 0, len(resp.Data))
+	for i := range resp.Data {
+		orders = append(orders, a.converter.ConvertOrderV4(&resp.Data[i]))
+	}
+	return commontypes.PaginateOrders(orders, commonReq.After, commonReq.Limit), nil
+}
+
+// GetFills lists own trade executions
+// Supports both spot and contract fills, routed the same way as GetOpenOrders.
+//
+// BitMart has no fill cursor, so pages are walked backwards by moving the end of
+// the window to the oldest fill of the previous page; duplicates at the page
+// boundary are dropped. Contract queries are additionally split into windows of
+// at most 90 days, and default to the last 7 days when StartTime is zero.
+func (a *TradeAPIAdapter) GetFills(ctx context.Context, commonReq commontypes.GetFillsRequest) ([]*commontypes.Trade, error) {
+	if isContractQuery(commonReq.InstType, commonReq.Symbol, commonReq.Extra) {
+		return a.getContractFills(ctx, commonReq)
+	}
+
+	if commonReq.OrderID != "" {
+		resp, err := a.client.Trade.QueryOrderTrades(ctx, tradereq.QueryOrderTradesRequest{OrderID: commonReq.OrderID})
+		if err != nil {
+			return nil, err
+		}
+		fills := make([]*commontypes.Trade, 0, len(resp.Data))
+		for i := range resp.Data {
+			fills = append(fills, a.converter.ConvertTradeV4(&resp.Data[i]))
+		}
+		if commonReq.Limit > 0 && len(fills) > commonReq.Limit {
+			fills = fills[:commonReq.Limit]
+		}
+		return fills, nil
+	}
+
+	const pageSize = 200
+
+	req := tradereq.QueryTradesRequest{Symbol: commonReq.Symbol, Limit: pageSize}
+	if !commonReq.StartTime.IsZero() {
+		req.StartTime = commonReq.StartTime.UnixMilli()
+	}
+	if !commonReq.EndTime.IsZero() {
+		req.EndTime = commonReq.EndTime.UnixMilli()
+	}
+
+	var fills []*commontypes.Trade
+	seen := make(map[string]bool)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		resp, err := a.client.Trade.QueryTrades(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for i := range resp.Data {
+			if seen[resp.Data[i].TradeID] {
+				continue
+			}
+			seen[resp.Data[i].TradeID] = true
+			added++
+			fills = append(fills, a.converter.ConvertTradeV4(&resp.Data[i]))
+			if commonReq.Limit > 0 && len(fills) >= commonReq.Limit {
+				return fills, nil
+			}
+		}
+
+		if len(resp.Data) < pageSize || added == 0 {
+			return fills, nil
+		}
+		req.EndTime = resp.Data[len(resp.Data)-1].CreateTime
+	}
+}
+
+// getContractFills walks contract trade executions backwards from EndTime to StartTime
+func (a *TradeAPIAdapter) getContractFills(ctx context.Context, commonReq commontypes.GetFillsRequest) ([]*commontypes.Trade, error) {
+	const (
+		pageSize  = 200
+		maxWindow = 90 * 24 * time.Hour
+	)
+
+	end := commonReq.EndTime
+	if end.IsZero() {
+		end = time.Now()
+	}
+	start := commonReq.StartTime
+	if start.IsZero() {
+		start = end.Add(-7 * 24 * time.Hour)
+	}
+
+	var fills []*commontypes.Trade
+	seen := make(map[string]bool)
+	for windowEnd := end; windowEnd.After(start); {
+		windowStart := windowEnd.Add(-maxWindow)
+		if windowStart.Before(start) {
+			windowStart = start
+		}
+
+		req := contractreq.GetContractTradesRequest{
+			Symbol:    commonReq.Symbol,
+			OrderID:   commonReq.OrderID,
+			StartTime: windowStart.Unix(),
+			EndTime:   windowEnd.Unix(),
+		}
+		for {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			resp, err := a.client.Contract.GetContractTrades(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			added := 0
+			for i := range resp.Data {
+				if seen[resp.Data[i].TradeID] {
+					continue
+				}
+				seen[resp.Data[i].TradeID] = true
+				added++
+				fills = append(fills, a.converter.ConvertContractFill(&resp.Data[i]))
+				if commonReq.Limit > 0 && len(fills) >= commonReq.Limit {
+					return fills, nil
+				}
+			}
+
+			if len(resp.Data) < pageSize || added == 0 {
+				break
+			}
+			// Round up so fills in the same second as the page boundary are kept
+			req.EndTime = (resp.Data[len(resp.Data)-1].CreateTime + 999) / 1000
+		}
+
+		windowEnd = windowStart
+	}
+
+	return fills, nil
+}
+
+// PlaceConditionalOrder places a contract conditional order
+// BitMart only offers conditional orders on contracts: trigger orders map to
+// plan orders, TP/SL orders to tp-sl orders and trailing stops to trail orders.
+// A tp-sl order carries a single leg, so take-profit and stop-loss must be
+// placed as separate requests. Leverage is taken from Extra["leverage"], and
+// the trigger direction of plan orders from Extra["price_way"] (1 = rising,
+// 2 = falling) or, when absent, from the current last price. Only tp-sl
+// orders take a ClientOrderID; trigger and trailing orders carrying one are
+// rejected with ErrNotSupported.
+func (a *TradeAPIAdapter) PlaceConditionalOrder(ctx context.Context, commonReq commontypes.ConditionalOrderRequest) (*commontypes.ConditionalOrder, error) {
+	if commonReq.ClientOrderID != "" && commonReq.Type != commontypes.ConditionalOrderTypeTPSL {
+		return nil, fmt.Errorf("bitmart: client order ID on %s orders: %w", commonReq.Type, commontypes.ErrNotSupported)
+	}
+	priceType, err := a.converter.ToContractPriceType(commonReq.TriggerPriceType)
+	if err != nil {
+		return nil, err
+	}
+	leverage, _ := commonReq.Extra["leverage"].(string)
+	side := a.conditionalContractSide(commonReq)
+	size, err := contractSize(commonReq.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	var orderID string
+	switch commonReq.Type {
+	case commontypes.ConditionalOrderTypeTrigger:
+		if !commonReq.TriggerPrice.IsPositive() {
+			return nil, fmt.Errorf("bitmart: trigger order requires a trigger price: %w", commontypes.ErrInvalidOrder)
+		}
+		priceWay, err := a.planPriceWay(ctx, commonReq)
+		if err != nil {
+			return nil, err
+		}
+		req := contractreq.SubmitPlanOrderRequest{
+			Symbol:       commonReq.Symbol,
+			Side:         side,
+			Leverage:     leverage,
+			OpenType:     contractOpenType(commonReq.TdMode),
+			Type:         "market",
+			Size:         size,
+			TriggerPrice: commonReq.TriggerPrice.String(),
+			PriceWay:     priceWay,
+			PriceType:    priceType,
+		}
+		if commonReq.OrderPrice.IsPositive() {
+			req.Type = "limit"
+			req.ExecutivePrice = commonReq.OrderPrice.String()
+		}
+		resp, err := a.client.Contract.SubmitPlanOrder(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		orderID = strconv.FormatInt(resp.Data.OrderID, 10)
+
+	case commontypes.ConditionalOrderTypeTPSL:
+		hasTP := commonReq.TakeProfitTriggerPrice.IsPositive()
+		hasSL := commonReq.StopLossTriggerPrice.IsPositive()
+		if hasTP == hasSL {
+			return nil, fmt.Errorf("bitmart: tpsl order requires exactly one of take-profit or stop-loss: %w", commontypes.ErrInvalidOrder)
+		}
+		req := contractreq.SubmitTPSLOrderRequest{
+			Symbol:        commonReq.Symbol,
+			Side:          3, // Sell close long
+			Type:          "take_profit",
+			Size:          size,
+			TriggerPrice:  commonReq.TakeProfitTriggerPrice.String(),
+			PriceType:     priceType,
+			PlanCategory:  1,
+			ClientOrderID: commonReq.ClientOrderID,
+			Category:      "market",
+		}
+		if commonReq.Side == commontypes.OrderSideBuy {
+			req.Side = 2 // Buy close short
+		}
+		if size == 0 {
+			req.PlanCategory = 2 // Position TP/SL
+		}
+		price := commonReq.TakeProfitPrice
+		if hasSL {
+			req.Type = "stop_loss"
+			req.TriggerPrice = commonReq.StopLossTriggerPrice.String()
+			price = commonReq.StopLossPrice
+		}
+		if price.IsPositive() {
+			req.Category = "limit"
+			req.ExecutivePrice = price.String()
+		}
+		resp, err := a.client.Contract.SubmitTPSLOrder(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		orderID = resp.Data.OrderID
+
+	case commontypes.ConditionalOrderTypeTrailing:
+		if !commonReq.CallbackRate.IsPositive() || !commonReq.ActivationPrice.IsPositive() {
+			return nil, fmt.Errorf("bitmart: trailing order requires a callback rate and an activation price: %w", commontypes.ErrInvalidOrder)
+		}
+		// BitMart expects the callback rate in percent
+		callbackRate, err := commonReq.CallbackRate.Mul(commontypes.NewDecimalFromInt(100))
+		if err != nil {
+			return nil, err
+		}
+		resp, err := a.client.Contract.SubmitTrailOrder(ctx, contractreq.SubmitTrailOrderRequest{
+			Symbol:              commonReq.Symbol,
+			Side:                side,
+			Leverage:            leverage,
+			OpenType:            contractOpenType(commonReq.TdMode),
+			Size:                size,
+			ActivationPrice:     commonReq.ActivationPrice.String(),
+			CallbackRate:        callbackRate.String(),
+			ActivationPriceType: priceType,
+		})
+		if err != nil {
+			return nil, err
+		}
+		orderID = strconv.FormatInt(resp.Data.OrderID, 10)
+
+	default:
+		return nil, fmt.Errorf("bitmart: unsupported conditional order type %q: %w", commonReq.Type, commontypes.ErrNotSupported)
+	}
+
+	order := commontypes.ConditionalOrderFromRequest(commonReq, orderID)
+	order.Extra["account_type"] = commontypes.AccountTypeFutures
+	return order, nil
+}
+
+// conditionalContractSide converts the side of a conditional order to a BitMart
+// contract side, using the reduce-only sides in one-way mode when ReduceOnly is set
+func (a *TradeAPIAdapter) conditionalContractSide(commonReq commontypes.ConditionalOrderRequest) int {
+	side := a.converter.ConvertToContractSide(commonReq.Side, commonReq.PosSide)
+	if commonReq.ReduceOnly && commonReq.PosSide != commontypes.PositionSideLong && commonReq.PosSide != commontypes.PositionSideShort {
+		if commonReq.Side == commontypes.OrderSideBuy {
+			return 2 // Buy (reduce only)
+		}
+		return 3 // Sell (reduce only)
+	}
+	return side
+}
+
+// planPriceWay resolves the trigger direction of a plan order
+func (a *TradeAPIAdapter) planPriceWay(ctx context.Context, commonReq commontypes.ConditionalOrderRequest) (int, error) {
+	if priceWay, ok := commonReq.Extra["price_way"].(int); ok {
+		return priceWay, nil
+	}
+
+	resp, err := a.client.Contract.GetContractDetails(ctx, contractreq.GetContractDetailsRequest{Symbol: commonReq.Symbol})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Data.Symbols) == 0 {
+		return 0, fmt.Errorf("bitmart: contract not found: %s: %w", commonReq.Symbol, commontypes.ErrInvalidSymbol)
+	}
+	last, err := commontypes.NewDecimal(resp.Data.Symbols[0].LastPrice)
+	if err != nil {
+		return 0, fmt.Errorf("bitmart: invalid last price %q: %w", resp.Data.Symbols[0].LastPrice, err)
+	}
+	if commonReq.TriggerPrice.Decimal.GreaterThan(last.Decimal) {
+		return 1, nil
+	}
+	return 2, nil
+}
+
+// contractSize converts an order quantity to a whole number of contracts
+func contractSize(quantity commontypes.Decimal) (int, error) {
+	size, err := quantity.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("bitmart: contract size %s must be a whole number of contracts: %w", quantity, commontypes.ErrInvalidOrder)
+	}
+	return int(size), nil
+}
+
+// contractOpenType converts a margin mode to a BitMart contract open_type
+func contractOpenType(mode commontypes.MarginMode) string {
+	switch mode {
+	case commontypes.MarginModeCross:
+		return "cross"
+	case commontypes.MarginModeIsolated:
+		return "isolated"
+	default:
+		return ""
+	}
+}
+
+// CancelConditionalOrder cancels an untriggered contract conditional order
+// Trailing stops are cancelled through the trail order endpoint, so Type must
+// be set to ConditionalOrderTypeTrailing for them.
+func (a *TradeAPIAdapter) CancelConditionalOrder(ctx context.Context, commonReq commontypes.CancelConditionalOrderRequest) error {
+	if commonReq.Type == commontypes.ConditionalOrderTypeTrailing {
+		_, err := a.client.Contract.CancelTrailOrder(ctx, contractreq.CancelTrailOrderRequest{
+			Symbol:  commonReq.Symbol,
+			OrderID: commonReq.OrderID,
+		})
+		return err
+	}
+
+	_, err := a.client.Contract.CancelPlanOrder(ctx, contractreq.CancelPlanOrderRequest{
+		Symbol:  commonReq.Symbol,
+		OrderID: commonReq.OrderID,
+	})
+	return err
+}
+
+// GetConditionalOrders lists untriggered contract conditional orders
+// Plan and TP/SL orders come from the current plan order endpoint, trailing
+// stops from the open order endpoint.
+func (a *TradeAPIAdapter) GetConditionalOrders(ctx context.Context, commonReq commontypes.GetConditionalOrdersRequest) ([]*commontypes.ConditionalOrder, error) {
+	if commonReq.InstType == commontypes.InstrumentSpot {
+		return nil, commontypes.ErrNotSupported
+	}
+
+	var orders []*commontypes.ConditionalOrder
+	if commonReq.Type != commontypes.ConditionalOrderTypeTrailing {
+		req := contractreq.GetCurrentPlanOrdersRequest{
+			Symbol: commonReq.Symbol,
+			Limit:  100,
+		}
+		switch commonReq.Type {
+		case commontypes.ConditionalOrderTypeTrigger:
+			req.PlanType = "plan"
+		case commontypes.ConditionalOrderTypeTPSL:
+			req.PlanType = "profit_loss"
+		}
+		resp, err := a.client.Contract.GetCurrentPlanOrders(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.Data {
+			orders = append(orders, a.converter.ConvertPlanOrder(&resp.Data[i]))
+		}
+	}
+
+	if commonReq.Type == "" || commonReq.Type == commontypes.ConditionalOrderTypeTrailing {
+		resp, err := a.client.Contract.GetOpenOrders(ctx, contractreq.GetContractOpenOrdersRequest{
+			Symbol: commonReq.Symbol,
+			Type:   "trailing",
+			Limit:  100,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.Data {
+			orders = append(orders, a.converter.ConvertTrailOrder(&resp.Data[i]))
+		}
+	}
+	return orders, nil
+}
+
+// ClosePosition closes a contract position with a market order
+// BitMart has no close-position endpoint, so the position is looked up and a
+// close-long (3) or close-short (2) order is sent for the requested or full size.
+// An empty or net PosSide matches the first open position of the symbol.
+func (a *TradeAPIAdapter) ClosePosition(ctx context.Context, commonReq commontypes.ClosePositionRequest) (*commontypes.ClosePositionResult, error) {
+	resp, err := a.client.Contract.GetPositionV2(ctx, contractreq.GetPositionV2Request{Symbol: commonReq.Symbol})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get positions for %s: %w", commonReq.Symbol, err)
+	}
+
+	for i := range resp.Data {
+		pos := a.converter.ConvertPositionV2ToPosition(&resp.Data[i])
+		if pos == nil {
+			continue
+		}
+		if commonReq.PosSide != "" && commonReq.PosSide != commontypes.PositionSideNet && pos.PosSide != commonReq.PosSide {
+			continue
+		}
+		if commonReq.MarginMode != "" && pos.MarginMode != commonReq.MarginMode {
+			continue
+		}
+
+		order, err := a.closeContractPosition(ctx, pos, commonReq.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		return &commontypes.ClosePositionResult{
+			Symbol:  pos.Symbol,
+			PosSide: pos.PosSide,
+			Order:   order,
+		}, nil
+	}
+	return nil, fmt.Errorf("bitmart: no open position for %s", commonReq.Symbol)
+}
+
+// CloseAllPositions closes every open contract position with a market order
+func (a *TradeAPIAdapter) CloseAllPositions(ctx context.Context) ([]*commontypes.ClosePositionResult, error) {
+	resp, err := a.client.Contract.GetPositionV2(ctx, contractreq.GetPositionV2Request{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get positions: %w", err)
+	}
+
+	var results []*commontypes.ClosePositionResult
+	for i := range resp.Data {
+		pos := a.converter.ConvertPositionV2ToPosition(&resp.Data[i])
+		if pos == nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		order, err := a.closeContractPosition(ctx, pos, commontypes.ZeroDecimal)
+		results = append(results, &commontypes.ClosePositionResult{
+			Symbol:  pos.Symbol,
+			PosSide: pos.PosSide,
+			Order:   order,
+			Error:   err,
+		})
+	}
+	return results, nil
+}
+
+// closeContractPosition sends a market order closing quantity contracts of pos
+// A zero quantity closes the whole position.
+func (a *TradeAPIAdapter) closeContractPosition(ctx context.Context, pos *commontypes.Position, quantity commontypes.Decimal) (*commontypes.Order, error) {
+	// Side 3 closes a long (sell), side 2 closes a short (buy); both are
+	// reduce-only in one-way mode as well
+	side := 3
+	if pos.PosSide == commontypes.PositionSideShort {
+		side = 2
+	}
+
+	size, err := contractSize(quantity)
+	if err != nil {
+		return nil, err
+	}
+	if size <= 0 {
+		size = int(pos.Quantity.IntPart())
+	}
+
+	resp, err := a.client.Contract.SubmitOrder(ctx, contractreq.SubmitOrderRequest{
+		Symbol:   pos.Symbol,
+		Side:     side,
+		Type:     "market",
+		Size:     size,
+		OpenType: contractOpenType(pos.MarginMode),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.converter.ConvertContractOrder(resp), nil
+}
+
+// spotOrderCreateTime returns the creation time in milliseconds of a spot order
+func (a *TradeAPIAdapter) spotOrderCreateTime(ctx context.Context, orderID string) (int64, error) {
+	resp, err := a.client.Trade.GetOrder(ctx, tradereq.GetOrderRequest{OrderID: orderID})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Data.CreateTime, nil
+}
+
 // AccountAPIAdapter implements account operations
 type AccountAPIAdapter struct {
 	client    *rest.ClientRest
@@ -289,7 +1236,7 @@ func (a *AccountAPIAdapter) GetBalance(ctx context.Context, typee string, curren
 	switch typee {
 	case commontypes.AccountTypeFutures:
 		// Query futures/contract account
-		resp, err := a.client.Contract.GetContractAssets()
+		resp, err := a.client.Contract.GetContractAssets(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -346,7 +1293,7 @@ func (a *AccountAPIAdapter) GetBalance(ctx context.Context, typee string, curren
 	case commontypes.AccountTypeSpot:
 		// Query spot account (existing implementation)
 		req := accountreq.GetWalletBalanceRequest{}
-		balances, err := a.client.Account.GetWalletBalance(req)
+		balances, err := a.client.Account.GetWalletBalance(ctx, req)
 		if err != nil {
 			return nil, err
 		}
@@ -397,7 +1344,7 @@ func (a *AccountAPIAdapter) GetPositions(ctx context.Context, symbols ...string)
 				Symbol: symbol,
 			}
 
-			resp, err := a.client.Contract.GetPositionV2(req)
+			resp, err := a.client.Contract.GetPositionV2(ctx, req)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get positions for %s: %w", symbol, err)
 			}
@@ -413,7 +1360,7 @@ func (a *AccountAPIAdapter) GetPositions(ctx context.Context, symbols ...string)
 	} else {
 		// Query all positions (only returns positions with non-zero quantity)
 		req := contractreq.GetPositionV2Request{}
-		resp, err := a.client.Contract.GetPositionV2(req)
+		resp, err := a.client.Contract.GetPositionV2(ctx, req)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get positions: %w", err)
 		}
@@ -439,7 +1386,7 @@ func (a *AccountAPIAdapter) GetLeverage(ctx context.Context, symbols []string) (
 			req := contractreq.GetPositionV2Request{
 				Symbol: symbol,
 			}
-			resp, err := a.client.Contract.GetPositionV2(req)
+			resp, err := a.client.Contract.GetPositionV2(ctx, req)
 			if err != nil {
 				return nil, err
 			}
@@ -457,7 +1404,7 @@ func (a *AccountAPIAdapter) GetLeverage(ctx context.Context, symbols []string) (
 
 	// If no specific symbols, get all positions
 	req := contractreq.GetPositionV2Request{}
-	resp, err := a.client.Contract.GetPositionV2(req)
+	resp, err := a.client.Contract.GetPositionV2(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -490,12 +1437,12 @@ func (a *AccountAPIAdapter) SetLeverage(ctx context.Context, req commontypes.Set
 	// Build BitMart request
 	leverageReq := contractreq.SubmitLeverageRequest{
 		Symbol:   req.Symbol,
-		Leverage: fmt.Sprintf("%d", req.Leverage),
+		Leverage: req.Leverage.String(),
 		OpenType: openType,
 	}
 
 	// Call BitMart API
-	resp, err := a.client.Contract.SubmitLeverage(leverageReq)
+	resp, err := a.client.Contract.SubmitLeverage(ctx, leverageReq)
 	if err != nil {
 		return nil, err
 	}
@@ -513,7 +1460,7 @@ type MarketAPIAdapter struct {
 // GetTicker gets ticker information
 func (a *MarketAPIAdapter) GetTicker(ctx context.Context, symbol string) (*commontypes.Ticker, error) {
 	req := marketreq.GetTickerRequest{Symbol: symbol}
-	ticker, err := a.client.Market.GetTicker(req)
+	ticker, err := a.client.Market.GetTicker(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -529,8 +1476,7 @@ func (a *MarketAPIAdapter) GetTickers(ctx context.Context) ([]*commontypes.Ticke
 // GetInstruments gets trading instrument information
 func (a *MarketAPIAdapter) GetInstruments(ctx context.Context) ([]*commontypes.Instrument, error) {
 	// Get all symbols
-	resp, err := a.client.Contract.GetContractDetails(
-		contractreq.GetContractDetailsRequest{})
+	resp, err := a.client.Contract.GetContractDetails(ctx, contractreq.GetContractDetailsRequest{})
 	if err != nil {
 		return nil, err
 	}
@@ -563,7 +1509,7 @@ func (a *MarketAPIAdapter) GetOrderBook(ctx context.Context, symbol string, dept
 			req.Count = depth
 		}
 
-		resp, err := a.client.Contract.GetOrderBook(req)
+		resp, err := a.client.Contract.GetOrderBook(ctx, req)
 		if err != nil {
 			return nil, err
 		}
@@ -583,7 +1529,7 @@ func (a *MarketAPIAdapter) GetOrderBook(ctx context.Context, symbol string, dept
 		Depth:  validDepth,
 	}
 
-	orderBook, err := a.client.Market.GetOrderBook(req)
+	orderBook, err := a.client.Market.GetOrderBook(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -591,6 +1537,132 @@ func (a *MarketAPIAdapter) GetOrderBook(ctx context.Context, symbol string, dept
 	return a.converter.ConvertOrderBook(&orderBook.Data, symbol), nil
 }
 
+// GetRecentTrades gets the most recent public trades, newest first
+// Automatically routes to spot or contract API based on symbol format:
+//   - Spot:     symbol contains "_" (e.g., "BTC_USDT") → /spot/quotation/v3/trades
+//   - Contract: symbol has no "_" (e.g., "BTCUSDT")    → /contract/public/market-trade
+func (a *MarketAPIAdapter) GetRecentTrades(ctx context.Context, symbol string, limit int) ([]*commontypes.Trade, error) {
+	if !strings.Contains(symbol, "_") {
+		resp, err := a.client.Contract.GetMarketTrades(ctx, contractreq.GetContractMarketTradesRequest{
+			Symbol: symbol,
+			Limit:  limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		trades := make([]*commontypes.Trade, 0, len(resp.Data))
+		for i := range resp.Data {
+			if trade := a.converter.ConvertContractMarketTrade(&resp.Data[i]); trade != nil {
+				trades = append(trades, trade)
+			}
+		}
+		sortTradesNewestFirst(trades)
+		return trades, nil
+	}
+
+	resp, err := a.client.Market.GetTrades(ctx, marketreq.GetTradesRequest{
+		Symbol: symbol,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	trades := make([]*commontypes.Trade, 0, len(resp.Data))
+	for _, t := range resp.Data {
+		if trade := a.converter.ConvertSpotTradeArray(t); trade != nil {
+			trades = append(trades, trade)
+		}
+	}
+	sortTradesNewestFirst(trades)
+	return trades, nil
+}
+
+// sortTradesNewestFirst sorts trades by descending timestamp
+func sortTradesNewestFirst(trades []*commontypes.Trade) {
+	sort.SliceStable(trades, func(i, j int) bool {
+		return trades[i].Timestamp.Time().After(trades[j].Timestamp.Time())
+	})
+}
+
+// GetFundingRate gets the current funding rate of a perpetual contract
+func (a *MarketAPIAdapter) GetFundingRate(ctx context.Context, symbol string) (*commontypes.FundingRate, error) {
+	resp, err := a.client.Contract.GetFundingRate(ctx, contractreq.GetContractFundingRateRequest{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+
+	return a.converter.ConvertContractFundingRate(&resp.Data), nil
+}
+
+// GetFundingRateHistory gets settled funding rates of a perpetual contract, newest first
+// BitMart only returns the latest records, StartTime and EndTime filtering them.
+func (a *MarketAPIAdapter) GetFundingRateHistory(ctx context.Context, req commontypes.GetFundingRateHistoryRequest) ([]*commontypes.FundingRate, error) {
+	resp, err := a.client.Contract.GetFundingRateHistory(ctx, contractreq.GetContractFundingRateHistoryRequest{
+		Symbol: req.Symbol,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]*commontypes.FundingRate, 0, len(resp.Data.List))
+	for i := range resp.Data.List {
+		rate := a.converter.ConvertContractFundingRateHistory(&resp.Data.List[i])
+		if rate == nil {
+			continue
+		}
+		if req.StartTime != nil && rate.FundingTime.Time().Before(*req.StartTime) {
+			continue
+		}
+		if req.EndTime != nil && rate.FundingTime.Time().After(*req.EndTime) {
+			continue
+		}
+		rates = append(rates, rate)
+	}
+	sort.SliceStable(rates, func(i, j int) bool {
+		return rates[i].FundingTime.Time().After(rates[j].FundingTime.Time())
+	})
+	return rates, nil
+}
+
+// GetMarkPrice gets the mark and index prices of a contract
+// The mark price is the close of the latest 1m mark price kline, the index price
+// coming from the contract details.
+func (a *MarketAPIAdapter) GetMarkPrice(ctx context.Context, symbol string) (*commontypes.MarkPrice, error) {
+	now := time.Now().Unix()
+	klines, err := a.client.Contract.GetMarkPriceKline(ctx, contractreq.GetContractKlineRequest{
+		Symbol:    symbol,
+		Step:      1,
+		StartTime: now - 5*60,
+		EndTime:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(klines.Data) == 0 {
+		return nil, fmt.Errorf("bitmart: no mark price for %s", symbol)
+	}
+	latest := klines.Data[len(klines.Data)-1]
+
+	details, err := a.client.Contract.GetContractDetails(ctx, contractreq.GetContractDetailsRequest{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+	if len(details.Data.Symbols) == 0 {
+		return nil, fmt.Errorf("bitmart: contract not found: %s: %w", symbol, commontypes.ErrInvalidSymbol)
+	}
+
+	return &commontypes.MarkPrice{
+		Symbol:     symbol,
+		MarkPrice:  a.converter.stringToDecimal(latest.ClosePrice),
+		IndexPrice: a.converter.stringToDecimal(details.Data.Symbols[0].IndexPrice),
+		Timestamp:  commontypes.Timestamp(time.Unix(latest.Timestamp, 0)),
+		Extra:      map[string]interface{}{},
+	}, nil
+}
+
 // GetCandles gets historical candlestick/kline data
 // Supports both spot and contract markets via account_type parameter in Extra
 //
@@ -659,7 +1731,7 @@ func (a *MarketAPIAdapter) GetCandles(ctx context.Context, req commontypes.GetCa
 			EndTime:   endTime,
 		}
 
-		resp, err := a.client.Contract.GetKline(contractReq)
+		resp, err := a.client.Contract.GetKline(ctx, contractReq)
 		if err != nil {
 			return nil, err
 		}
@@ -693,7 +1765,7 @@ func (a *MarketAPIAdapter) GetCandles(ctx context.Context, req commontypes.GetCa
 		}
 
 		// Call BitMart API
-		resp, err := a.client.Market.GetKlines(bitmartReq)
+		resp, err := a.client.Market.GetKlines(ctx, bitmartReq)
 		if err != nil {
 			return nil, err
 		}
@@ -725,7 +1797,7 @@ type FundingAPIAdapter struct {
 // GetDepositAddress gets deposit address
 func (a *FundingAPIAdapter) GetDepositAddress(ctx context.Context, currency string) (string, error) {
 	req := fundingreq.GetDepositAddressRequest{Currency: currency}
-	address, err := a.client.Funding.GetDepositAddress(req)
+	address, err := a.client.Funding.GetDepositAddress(ctx, req)
 	if err != nil {
 		return "", err
 	}
@@ -741,18 +1813,18 @@ func (a *FundingAPIAdapter) GetDepositAddress(ctx context.Context, currency stri
 }
 
 // Withdraw initiates a withdrawal
-func (a *FundingAPIAdapter) Withdraw(ctx context.Context, currency string, amount float64, address, tag string, extra map[string]interface{}) (string, error) {
+func (a *FundingAPIAdapter) Withdraw(ctx context.Context, commonReq commontypes.WithdrawRequest) (string, error) {
 	req := fundingreq.WithdrawRequest{
-		Currency: currency,
-		Amount:   a.converter.formatFloat(amount),
-		Address:  address,
+		Currency: commonReq.Currency,
+		Amount:   commonReq.Amount.String(),
+		Address:  commonReq.Address,
 	}
 
-	if tag != "" {
-		req.AddressTag = tag
+	if commonReq.Tag != "" {
+		req.AddressTag = commonReq.Tag
 	}
 
-	resp, err := a.client.Funding.Withdraw(req)
+	resp, err := a.client.Funding.Withdraw(ctx, req)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,231 @@
+package bitmart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/djpken/go-exc/options"
+	"github.com/djpken/go-exc/retry"
+	commontypes "github.com/djpken/go-exc/types"
+)
+
+// newTestExchange returns a BitMart exchange whose REST client talks to an
+// httptest server serving handler, with clock synchronization disabled
+func newTestExchange(t *testing.T, handler http.HandlerFunc, opts ...options.Option) *BitMartExchange {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	opts = append([]options.Option{options.WithBaseURL(srv.URL), options.WithHTTPClient(srv.Client()), options.WithClockSync(0)}, opts...)
+	client, err := NewBitMartExchange(context.Background(), "", "", "", false, opts...)
+	if err != nil {
+		t.Fatalf("NewBitMartExchange() error = %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestTradeAPIAdapter_PlaceOrderRetry(t *testing.T) {
+	var placeCalls, lookupCalls, detailCalls int
+	policy := retry.Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	client := newTestExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.Path {
+		case "POST /spot/v2/submit_order":
+			placeCalls++
+			if placeCalls == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"code":1000,"message":"OK","data":{"order_id":"12345"}}`))
+		case "POST /spot/v2/order_detail":
+			if strings.Contains(string(body), "client_order_id") {
+				lookupCalls++
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"code":50005,"message":"Order ID not found"}`))
+				return
+			}
+			detailCalls++
+			if detailCalls == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			_, _ = w.Write([]byte(`{"code":1000,"message":"OK","data":{"order_id":"12345","symbol":"BTC_USDT","side":"buy","type":"limit","price":"65000","size":"1","status":"new","client_order_id":"retry1"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}, options.WithRetryPolicy(policy))
+
+	order, err := client.PlaceOrder(context.Background(), commontypes.PlaceOrderRequest{
+		Symbol:        "BTC_USDT",
+		Side:          commontypes.OrderSideBuy,
+		Type:          "limit",
+		Quantity:      commontypes.NewDecimalFromFloat(1),
+		Price:         commontypes.NewDecimalFromFloat(65000),
+		ClientOrderID: "retry1",
+	})
+	if err != nil {
+		t.Fatalf("PlaceOrder() error = %v", err)
+	}
+	if placeCalls != 2 || lookupCalls != 1 {
+		t.Errorf("PlaceOrder() sent %d orders and %d lookups, expected 2 and 1", placeCalls, lookupCalls)
+	}
+	if detailCalls != 2 {
+		t.Errorf("PlaceOrder() sent %d order detail reads, expected 2", detailCalls)
+	}
+	if order.ID != "12345" {
+		t.Errorf("PlaceOrder() ID = %q, expected 12345", order.ID)
+	}
+}
+
+func TestTradeAPIAdapter_ReplaceSpotOrder(t *testing.T) {
+	var cancelled bool
+	var finalFilled, placedSize, placedClientID string
+	client := newTestExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		switch r.Method + " " + r.URL.Path {
+		case "POST /spot/v2/order_detail":
+			filled := "2"
+			if cancelled {
+				filled = finalFilled
+			}
+			if body["order_id"] == "222" {
+				filled = "0"
+			}
+			_, _ = fmt.Fprintf(w, `{"code":1000,"message":"OK","data":{"order_id":%q,"symbol":"BTC_USDT","side":"buy","type":"limit","price":"65000","size":"10","filled_size":%q,"status":"new"}}`, body["order_id"], filled)
+		case "POST /spot/v3/cancel_order":
+			cancelled = true
+			_, _ = w.Write([]byte(`{"code":1000,"message":"OK","data":{"result":true}}`))
+		case "POST /spot/v2/submit_order":
+			placedSize, placedClientID = body["size"], body["client_order_id"]
+			_, _ = w.Write([]byte(`{"code":1000,"message":"OK","data":{"order_id":"222"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	req := commontypes.AmendOrderRequest{
+		Symbol:           "BTC_USDT",
+		OrderID:          "111",
+		NewQuantity:      commontypes.NewDecimalFromFloat(10),
+		NewClientOrderID: "amend1",
+	}
+
+	// Two more units fill between the lookup and the cancel
+	finalFilled = "4"
+	result, err := client.AmendOrder(ctx, req)
+	if err != nil {
+		t.Fatalf("AmendOrder() error = %v", err)
+	}
+	if placedSize != "6" {
+		t.Errorf("AmendOrder() placed size %q, expected 6", placedSize)
+	}
+	if placedClientID != "amend1" {
+		t.Errorf("AmendOrder() placed client order ID %q, expected amend1", placedClientID)
+	}
+	if !result.Replaced || result.Order.ID != "222" {
+		t.Errorf("AmendOrder() = %+v, expected replacement order 222", result)
+	}
+
+	// The order fills completely before the cancel takes effect
+	cancelled, finalFilled, placedSize = false, "10", ""
+	if _, err := client.AmendOrder(ctx, req); !errors.Is(err, commontypes.ErrInvalidOrder) {
+		t.Errorf("AmendOrder() error = %v, expected ErrInvalidOrder", err)
+	}
+	if placedSize != "" {
+		t.Errorf("AmendOrder() placed size %q after a full fill, expected no replacement", placedSize)
+	}
+}
+
+func TestTradeAPIAdapter_PlaceConditionalOrderClientOrderID(t *testing.T) {
+	client := newTestExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	for _, typ := range []commontypes.ConditionalOrderType{commontypes.ConditionalOrderTypeTrigger, commontypes.ConditionalOrderTypeTrailing} {
+		_, err := client.PlaceConditionalOrder(context.Background(), commontypes.ConditionalOrderRequest{
+			Symbol:          "BTCUSDT",
+			Side:            commontypes.OrderSideBuy,
+			Type:            typ,
+			Quantity:        commontypes.NewDecimalFromInt(1),
+			TriggerPrice:    commontypes.NewDecimalFromInt(65000),
+			CallbackRate:    commontypes.NewDecimalFromFloat(0.01),
+			ActivationPrice: commontypes.NewDecimalFromInt(65000),
+			ClientOrderID:   "cond1",
+		})
+		if !errors.Is(err, commontypes.ErrNotSupported) {
+			t.Errorf("PlaceConditionalOrder(%s) error = %v, expected ErrNotSupported", typ, err)
+		}
+	}
+}
+
+func TestTradeAPIAdapter_RouteBySymbol(t *testing.T) {
+	var paths []string
+	client := newTestExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/contract/private/submit-order":
+			_, _ = w.Write([]byte(`{"code":1000,"message":"Ok","data":{"order_id":12345,"price":"65000"}}`))
+		case "/contract/private/cancel-order":
+			_, _ = w.Write([]byte(`{"code":1000,"message":"Ok","data":{}}`))
+		case "/spot/v3/cancel_order":
+			_, _ = w.Write([]byte(`{"code":1000,"message":"OK","data":{"result":true}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	_, err := client.PlaceOrder(ctx, commontypes.PlaceOrderRequest{
+		Symbol:   "BTCUSDT",
+		Side:     commontypes.OrderSideBuy,
+		Type:     "limit",
+		Quantity: commontypes.NewDecimalFromInt(1),
+		Price:    commontypes.NewDecimalFromInt(65000),
+	})
+	if err != nil {
+		t.Fatalf("PlaceOrder() error = %v", err)
+	}
+	if err := client.CancelOrder(ctx, commontypes.CancelOrderRequest{Symbol: "BTCUSDT", OrderID: "12345"}); err != nil {
+		t.Fatalf("CancelOrder() error = %v", err)
+	}
+	// Extra overrides the symbol
+	err = client.CancelOrder(ctx, commontypes.CancelOrderRequest{
+		Symbol:  "BTCUSDT",
+		OrderID: "12345",
+		Extra:   map[string]interface{}{"account_type": commontypes.AccountTypeSpot},
+	})
+	if err != nil {
+		t.Fatalf("CancelOrder() with a spot account type error = %v", err)
+	}
+
+	expected := []string{"/contract/private/submit-order", "/contract/private/cancel-order", "/spot/v3/cancel_order"}
+	if strings.Join(paths, " ") != strings.Join(expected, " ") {
+		t.Errorf("requests = %v, expected %v", paths, expected)
+	}
+
+	// Reduce-only orders only exist on contracts
+	_, err = client.PlaceOrder(ctx, commontypes.PlaceOrderRequest{
+		Symbol:     "BTC_USDT",
+		Side:       commontypes.OrderSideSell,
+		Type:       "market",
+		Quantity:   commontypes.NewDecimalFromInt(1),
+		ReduceOnly: true,
+	})
+	if !errors.Is(err, commontypes.ErrInvalidOrder) {
+		t.Errorf("PlaceOrder() reduce-only on a spot symbol error = %v, expected ErrInvalidOrder", err)
+	}
+}
@@ -0,0 +1,50 @@
+package bitmart
+
+import (
+	"fmt"
+	"strings"
+
+	commontypes "github.com/djpken/go-exc/types"
+)
+
+// contractQuoteCurrencies are the quote currencies of BitMart perpetual
+// contracts, used to split native contract symbols such as BTCUSDT
+var contractQuoteCurrencies = []string{"USDT", "USDC", "USD"}
+
+// SymbolMapper translates canonical symbols to BitMart symbols:
+// BTC_USDT for spot and BTCUSDT for perpetual contracts.
+type SymbolMapper struct{}
+
+// NewSymbolMapper creates a new BitMart symbol mapper
+func NewSymbolMapper() *SymbolMapper {
+	return &SymbolMapper{}
+}
+
+// ToNative converts a canonical symbol to a BitMart symbol
+func (m *SymbolMapper) ToNative(sym commontypes.Symbol) (string, error) {
+	switch sym.InstType {
+	case commontypes.InstrumentSpot, "":
+		return sym.Base + "_" + sym.Quote, nil
+	case commontypes.InstrumentSwap:
+		if sym.Settle != sym.Quote {
+			return "", fmt.Errorf("bitmart: %s settled in %s: %w", sym, sym.Settle, commontypes.ErrNotSupported)
+		}
+		return sym.Base + sym.Quote, nil
+	default:
+		return "", fmt.Errorf("bitmart: %s instrument symbols: %w", sym.InstType, commontypes.ErrNotSupported)
+	}
+}
+
+// FromNative converts a BitMart symbol to a canonical symbol
+// Spot symbols contain an underscore; contract symbols do not.
+func (m *SymbolMapper) FromNative(native string, _ commontypes.InstrumentType) (commontypes.Symbol, error) {
+	if base, quote, ok := strings.Cut(native, "_"); ok && base != "" && quote != "" {
+		return commontypes.Symbol{Base: base, Quote: quote, InstType: commontypes.InstrumentSpot}, nil
+	}
+	for _, quote := range contractQuoteCurrencies {
+		if base, ok := strings.CutSuffix(native, quote); ok && base != "" {
+			return commontypes.Symbol{Base: base, Quote: quote, Settle: quote, InstType: commontypes.InstrumentSwap}, nil
+		}
+	}
+	return commontypes.Symbol{}, fmt.Errorf("bitmart: unrecognised symbol %q", native)
+}
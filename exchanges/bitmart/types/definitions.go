@@ -44,9 +44,10 @@ const (
 type OrderStatus string
 
 const (
-	OrderStatusNew             OrderStatus = "new"
-	OrderStatusPartiallyFilled OrderStatus = "partially_filled"
-	OrderStatusFilled          OrderStatus = "filled"
-	OrderStatusCanceled        OrderStatus = "canceled"
-	OrderStatusPendingCancel   OrderStatus = "pending_cancel"
+	OrderStatusNew               OrderStatus = "new"
+	OrderStatusPartiallyFilled   OrderStatus = "partially_filled"
+	OrderStatusFilled            OrderStatus = "filled"
+	OrderStatusCanceled          OrderStatus = "canceled"
+	OrderStatusPendingCancel     OrderStatus = "pending_cancel"
+	OrderStatusPartiallyCanceled OrderStatus = "partially_canceled"
 )
@@ -20,7 +20,12 @@ func GenerateSignature(timestamp, memo, body, secretKey string) string {
 
 // GetTimestamp returns current timestamp in milliseconds
 func GetTimestamp() string {
-	return strconv.FormatInt(time.Now().UnixMilli(), 10)
+	return FormatTimestamp(time.Now())
+}
+
+// FormatTimestamp formats t as a timestamp in milliseconds
+func FormatTimestamp(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
 }
 
 // FormatFloat formats float64 to string with given precision
@@ -5,15 +5,22 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/djpken/go-exc/clock"
 	"github.com/djpken/go-exc/exchanges/bitmart/utils"
+	"github.com/djpken/go-exc/metrics"
 	commontypes "github.com/djpken/go-exc/types"
 	"github.com/gorilla/websocket"
 )
 
+// exchangeName is the exchange label of metrics
+const exchangeName = "bitmart"
+
 // MessageHandler is a function that handles WebSocket messages
 type MessageHandler func([]byte)
 
@@ -25,6 +32,11 @@ type ClientWs struct {
 	secretKey string
 	memo      string
 	wsURL     string
+	dialer    *websocket.Dialer
+	header    http.Header
+	clock     *clock.Clock
+	logger    *slog.Logger
+	metrics   metrics.Recorder
 
 	mu              sync.RWMutex
 	isConnected     bool
@@ -102,6 +114,8 @@ func NewClientWs(ctx context.Context, cfg Config) (*ClientWs, error) {
 		isConnected:     false,
 		isAuthenticated: false,
 		handlers:        make(map[string]MessageHandler),
+		logger:          slog.New(slog.DiscardHandler),
+		metrics:         metrics.Nop{},
 	}
 
 	// Initialize API endpoints
@@ -111,6 +125,62 @@ func NewClientWs(ctx context.Context, cfg Config) (*ClientWs, error) {
 	return client, nil
 }
 
+// SetDialer sets a custom dialer for the WebSocket connection
+func (c *ClientWs) SetDialer(dialer *websocket.Dialer) {
+	c.dialer = dialer
+}
+
+// SetUserAgent sets the User-Agent header sent with the WebSocket handshake
+func (c *ClientWs) SetUserAgent(userAgent string) {
+	c.header = http.Header{"User-Agent": []string{userAgent}}
+}
+
+// SetClock sets the clock used to timestamp the login. A nil clock uses the
+// local time.
+func (c *ClientWs) SetClock(clk *clock.Clock) {
+	c.clock = clk
+}
+
+// SetLogger sets the logger receiving undecodable or unrouted messages and the
+// system messages and errors no system channel takes. A nil logger discards them.
+func (c *ClientWs) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	c.logger = logger
+}
+
+// Logger returns the logger of the client
+func (c *ClientWs) Logger() *slog.Logger {
+	return c.logger
+}
+
+// SetMetrics sets the recorder of connections, reconnects, messages,
+// subscriptions and dropped updates. A nil recorder records nothing.
+func (c *ClientWs) SetMetrics(recorder metrics.Recorder) {
+	c.metrics = metrics.OrNop(recorder)
+}
+
+// Dropped reports an update of channel dropped because its consumer channel was full
+func (c *ClientWs) Dropped(private bool, channel, symbol string) {
+	c.logger.Warn("channel full, dropping update", "private", private, "channel", channel, "symbol", symbol)
+	c.metrics.Dropped(exchangeName, channelLabel(channel))
+}
+
+// private reports whether the connection carries credentials, labelling its metrics
+func (c *ClientWs) private() bool {
+	return c.apiKey != ""
+}
+
+// channelLabel returns channel without its symbol or currency suffix, e.g.
+// "futures/ticker" for "futures/ticker:BTCUSDT"
+func channelLabel(channel string) string {
+	if idx := strings.Index(channel, ":"); idx != -1 {
+		return channel[:idx]
+	}
+	return channel
+}
+
 // Connect establishes WebSocket connection
 func (c *ClientWs) Connect() error {
 	c.mu.Lock()
@@ -124,10 +194,15 @@ func (c *ClientWs) Connect() error {
 	// Emit without holding lock
 	c.emitSystemMessage("connection", "Connecting to WebSocket...", false)
 
-	dialer := websocket.DefaultDialer
-	dialer.HandshakeTimeout = 10 * time.Second
+	dialer := c.dialer
+	if dialer == nil {
+		dialer = &websocket.Dialer{
+			Proxy:            http.ProxyFromEnvironment,
+			HandshakeTimeout: 10 * time.Second,
+		}
+	}
 
-	conn, _, err := dialer.Dial(c.wsURL, nil)
+	conn, _, err := dialer.Dial(c.wsURL, c.header)
 	if err != nil {
 		c.emitSystemError("connection", fmt.Sprintf("Failed to connect: %v", err), false)
 		return fmt.Errorf("failed to connect: %w", err)
@@ -143,6 +218,7 @@ func (c *ClientWs) Connect() error {
 	c.connCancel = connCancel
 	c.mu.Unlock()
 
+	c.metrics.Connection(exchangeName, c.private(), true)
 	c.emitSystemMessage("connection", "WebSocket connected successfully", false)
 
 	// Start message reader
@@ -183,7 +259,11 @@ func (c *ClientWs) Login() error {
 	}
 	c.mu.RUnlock()
 
-	timestamp := utils.GetTimestamp()
+	now, err := c.clock.SignTime()
+	if err != nil {
+		return err
+	}
+	timestamp := utils.FormatTimestamp(now)
 	// Generate signature: timestamp + "#" + memo + "#" + "bitmart.WebSocket"
 	sign := utils.GenerateSignature(timestamp, c.memo, "bitmart.WebSocket", c.secretKey)
 
@@ -193,8 +273,6 @@ func (c *ClientWs) Login() error {
 		"args":   []string{c.apiKey, timestamp, sign, "web"},
 	}
 
-	// fmt.Printf("[WS] Logging in with timestamp: %s\n", timestamp)
-
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -251,6 +329,7 @@ func (c *ClientWs) sendSubscribe(channels []string) error {
 		c.subscriptionsMu.Lock()
 		c.subscriptions = append(c.subscriptions, channels...)
 		c.subscriptionsMu.Unlock()
+		c.metrics.Subscription(exchangeName, c.private(), len(channels))
 
 		for _, ch := range channels {
 			c.emitSubscribeEvent(ch)
@@ -288,8 +367,10 @@ func (c *ClientWs) Unsubscribe(channel string) error {
 				filtered = append(filtered, sub)
 			}
 		}
+		removed := len(c.subscriptions) - len(filtered)
 		c.subscriptions = filtered
 		c.subscriptionsMu.Unlock()
+		c.metrics.Subscription(exchangeName, c.private(), -removed)
 
 		c.emitUnsubscribeEvent(channel)
 		c.emitSystemMessage("subscription", fmt.Sprintf("Unsubscribed from %s", channel), false)
@@ -320,7 +401,6 @@ func (c *ClientWs) startHeartbeat() {
 			// Send ping using BitMart v2 API format
 			pingMsg := map[string]string{"action": "ping"}
 			if err := c.conn.WriteJSON(pingMsg); err != nil {
-				fmt.Printf("[WS ERROR] Failed to send ping: %v\n", err)
 				c.emitSystemError("heartbeat", fmt.Sprintf("Failed to send ping: %v", err), false)
 			}
 			c.mu.Unlock()
@@ -335,6 +415,7 @@ func (c *ClientWs) readMessages() {
 		c.isConnected = false
 		c.isAuthenticated = false
 		c.mu.Unlock()
+		c.metrics.Connection(exchangeName, c.private(), false)
 		c.emitSystemMessage("connection", "WebSocket connection closed", false)
 	}()
 
@@ -351,7 +432,6 @@ func (c *ClientWs) readMessages() {
 			if err != nil {
 				// Log all errors for debugging
 				errMsg := fmt.Sprintf("WebSocket read error: %v (type: %T)", err, err)
-				fmt.Printf("[WS ERROR] %s\n", errMsg)
 				c.emitSystemError("receiver", errMsg, false)
 
 				// Also emit detailed close error
@@ -407,6 +487,7 @@ func (c *ClientWs) reconnect() {
 	time.Sleep(100 * time.Millisecond)
 
 	// Attempt to reconnect with exponential backoff
+	c.metrics.Reconnect(exchangeName, c.private())
 	c.emitSystemMessage("reconnection", "Attempting to reconnect...", false)
 
 	maxRetries := 10
@@ -472,6 +553,7 @@ func (c *ClientWs) resubscribe() {
 	c.subscriptionsMu.Lock()
 	c.subscriptions = []string{}
 	c.subscriptionsMu.Unlock()
+	c.metrics.Subscription(exchangeName, c.private(), -len(subs))
 
 	// Batch re-subscribe all channels in a single WS message
 	if err := c.SubscribeBatch(subs); err != nil {
@@ -499,7 +581,7 @@ func (c *ClientWs) UnregisterHandler(channel string) {
 func (c *ClientWs) processMessage(message []byte) {
 	var msg map[string]interface{}
 	if err := json.Unmarshal(message, &msg); err != nil {
-		fmt.Printf("Failed to unmarshal message: %v\n", err)
+		c.logger.Warn("failed to decode message", "error", err)
 		return
 	}
 
@@ -530,9 +612,6 @@ func (c *ClientWs) processMessage(message []byte) {
 		return
 	}
 
-	// Debug: print message structure
-	// fmt.Printf("[WS] Message keys: %v\n", getMapKeys(msg))
-
 	// Route data messages to registered handlers
 	// BitMart uses "table" for public channels and "group" for private channels
 	var channelKey string
@@ -547,7 +626,7 @@ func (c *ClientWs) processMessage(message []byte) {
 		if idx := strings.Index(channelKey, "@"); idx != -1 {
 			channelKey = channelKey[:idx]
 		}
-		// fmt.Printf("[WS] Routing to handler: %s\n", channelKey)
+		c.metrics.Message(exchangeName, channelLabel(channelKey))
 		c.mu.RLock()
 		handler, exists := c.handlers[channelKey]
 		c.mu.RUnlock()
@@ -555,33 +634,13 @@ func (c *ClientWs) processMessage(message []byte) {
 		if exists {
 			handler(message)
 		} else {
-			fmt.Printf("[WS] No handler for channel: %s | registered: %v\n", channelKey, c.getHandlerKeys())
+			c.logger.Debug("no handler for channel", "channel", channelKey)
 		}
 	} else {
-		fmt.Printf("[WS] No 'table' or 'group' field in message: %s\n", string(message))
+		c.logger.Debug("message without channel", "message", string(message))
 	}
 }
 
-// getHandlerKeys returns all registered handler keys for debugging
-func (c *ClientWs) getHandlerKeys() []string {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	keys := make([]string, 0, len(c.handlers))
-	for k := range c.handlers {
-		keys = append(keys, k)
-	}
-	return keys
-}
-
-// getMapKeys returns all keys in a map for debugging
-func getMapKeys(m map[string]interface{}) []string {
-	keys := make([]string, 0, len(m))
-	for k := range m {
-		keys = append(keys, k)
-	}
-	return keys
-}
-
 // IsConnected returns connection status
 func (c *ClientWs) IsConnected() bool {
 	c.mu.RLock()
@@ -619,7 +678,7 @@ func (c *ClientWs) SetChannels(
 	c.systemErrCh = systemErrCh
 }
 
-// emitSystemMessage sends a system message event if the channel is set
+// emitSystemMessage sends a system message event if the channel is set, logging it otherwise
 // Note: This function is safe to call without holding locks
 func (c *ClientWs) emitSystemMessage(msgType, message string, private bool) {
 	// Read channel pointer without lock - it's safe since SetChannels is called once at setup
@@ -634,13 +693,15 @@ func (c *ClientWs) emitSystemMessage(msgType, message string, private bool) {
 			Timestamp: commontypes.Timestamp(time.Now()),
 			Extra:     make(map[string]interface{}),
 		}:
+			return
 		default:
-			// Channel full, drop message
+			// Channel full, log as fallback
 		}
 	}
+	c.logger.Info(message, "type", msgType, "private", private)
 }
 
-// emitSystemError sends a system error event if the channel is set
+// emitSystemError sends a system error event if the channel is set, logging it otherwise
 // Note: This function is safe to call without holding locks
 func (c *ClientWs) emitSystemError(errType, errMsg string, private bool) {
 	ch := c.systemErrCh
@@ -654,10 +715,12 @@ func (c *ClientWs) emitSystemError(errType, errMsg string, private bool) {
 			Timestamp: commontypes.Timestamp(time.Now()),
 			Extra:     make(map[string]interface{}),
 		}:
+			return
 		default:
-			// Channel full, drop message
+			// Channel full, log as fallback
 		}
 	}
+	c.logger.Error(errMsg, "type", errType, "private", private)
 }
 
 // emitLoginEvent sends a login event if the channel is set
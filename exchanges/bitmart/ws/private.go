@@ -16,6 +16,7 @@ type Private struct {
 	tradeCh          chan *private.TradeEvent
 	futuresAssetCh   chan *private.FuturesAssetEvent
 	futuresPositionCh chan *private.FuturesPositionEvent
+	futuresOrderCh    chan *private.FuturesOrderEvent
 }
 
 // NewPrivate creates a new Private instance
@@ -23,9 +24,9 @@ func NewPrivate(c *ClientWs) *Private {
 	return &Private{ClientWs: c}
 }
 
-// SubscribeOrder subscribes to order update channel
+// SubscribeOrder subscribes to order update channel for all symbols
 //
-// Channel: spot/user/order
+// Channel: spot/user/order:ALL_SYMBOLS
 // Requires authentication
 func (p *Private) SubscribeOrder(ch ...chan *private.OrderEvent) error {
 	if !p.IsAuthenticated() {
@@ -40,21 +41,21 @@ func (p *Private) SubscribeOrder(ch ...chan *private.OrderEvent) error {
 
 	channel := "spot/user/order"
 
-	// Register message handler
+	// Register message handler; pushes carry the channel without the symbol
 	p.RegisterHandler(channel, func(data []byte) {
 		var event private.OrderEvent
 		if err := json.Unmarshal(data, &event); err != nil {
-			fmt.Printf("Failed to unmarshal order event: %v\n", err)
+			p.logger.Warn("failed to decode event", "private", true, "channel", channel, "error", err)
 			return
 		}
 		select {
 		case p.orderCh <- &event:
 		default:
-			// Channel full, drop message
+			p.Dropped(true, channel, "")
 		}
 	})
 
-	return p.Subscribe(channel)
+	return p.Subscribe(channel + ":ALL_SYMBOLS")
 }
 
 // UnsubscribeOrder unsubscribes from order channel
@@ -62,7 +63,7 @@ func (p *Private) UnsubscribeOrder() error {
 	channel := "spot/user/order"
 	p.UnregisterHandler(channel)
 
-	return p.Unsubscribe(channel)
+	return p.Unsubscribe(channel + ":ALL_SYMBOLS")
 }
 
 // SubscribeBalance subscribes to balance update channel
@@ -86,13 +87,13 @@ func (p *Private) SubscribeBalance(ch ...chan *private.BalanceEvent) error {
 	p.RegisterHandler(channel, func(data []byte) {
 		var event private.BalanceEvent
 		if err := json.Unmarshal(data, &event); err != nil {
-			fmt.Printf("Failed to unmarshal balance event: %v\n", err)
+			p.logger.Warn("failed to decode event", "private", true, "channel", channel, "error", err)
 			return
 		}
 		select {
 		case p.balanceCh <- &event:
 		default:
-			// Channel full, drop message
+			p.Dropped(true, channel, "")
 		}
 	})
 
@@ -128,13 +129,13 @@ func (p *Private) SubscribeTrade(ch ...chan *private.TradeEvent) error {
 	p.RegisterHandler(channel, func(data []byte) {
 		var event private.TradeEvent
 		if err := json.Unmarshal(data, &event); err != nil {
-			fmt.Printf("Failed to unmarshal trade event: %v\n", err)
+			p.logger.Warn("failed to decode event", "private", true, "channel", channel, "error", err)
 			return
 		}
 		select {
 		case p.tradeCh <- &event:
 		default:
-			// Channel full, drop message
+			p.Dropped(true, channel, "")
 		}
 	})
 
@@ -193,13 +194,13 @@ func (p *Private) SubscribeFuturesAsset(ch chan *private.FuturesAssetEvent, curr
 		p.RegisterHandler(channel, func(data []byte) {
 			var event private.FuturesAssetEvent
 			if err := json.Unmarshal(data, &event); err != nil {
-				fmt.Printf("Failed to unmarshal futures asset event: %v\n", err)
+				p.logger.Warn("failed to decode event", "private", true, "channel", channel, "error", err)
 				return
 			}
 			select {
 			case p.futuresAssetCh <- &event:
 			default:
-				// Channel full, drop message
+				p.Dropped(true, channel, "")
 			}
 		})
 	}
@@ -210,11 +211,13 @@ func (p *Private) SubscribeFuturesAsset(ch chan *private.FuturesAssetEvent, curr
 		"args":   channels,
 	}
 
-	// fmt.Printf("[WS] Subscribing to futures asset channels: %v\n", channels)
-
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	return p.conn.WriteJSON(subscribeMsg)
+	if err := p.conn.WriteJSON(subscribeMsg); err != nil {
+		return err
+	}
+	p.metrics.Subscription(exchangeName, true, len(channels))
+	return nil
 }
 
 // UnsubscribeFuturesAsset unsubscribes from futures asset channels
@@ -240,11 +243,13 @@ func (p *Private) UnsubscribeFuturesAsset(currencies ...string) error {
 		"args":   channels,
 	}
 
-	// fmt.Printf("[WS] Unsubscribing from futures asset channels: %v\n", channels)
-
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	return p.conn.WriteJSON(unsubscribeMsg)
+	if err := p.conn.WriteJSON(unsubscribeMsg); err != nil {
+		return err
+	}
+	p.metrics.Subscription(exchangeName, true, -len(channels))
+	return nil
 }
 
 // SubscribeFuturesPosition subscribes to futures position update channel
@@ -267,13 +272,13 @@ func (p *Private) SubscribeFuturesPosition(ch chan *private.FuturesPositionEvent
 	p.RegisterHandler(channel, func(data []byte) {
 		var event private.FuturesPositionEvent
 		if err := json.Unmarshal(data, &event); err != nil {
-			fmt.Printf("Failed to unmarshal futures position event: %v\n", err)
+			p.logger.Warn("failed to decode event", "private", true, "channel", channel, "error", err)
 			return
 		}
 		select {
 		case p.futuresPositionCh <- &event:
 		default:
-			// Channel full, drop message
+			p.Dropped(true, channel, "")
 		}
 	})
 
@@ -288,6 +293,47 @@ func (p *Private) UnsubscribeFuturesPosition() error {
 	return p.Unsubscribe(channel)
 }
 
+// SubscribeFuturesOrder subscribes to futures order channel
+//
+// Channel: futures/order
+// Requires authentication
+//
+// Example:
+//   err := client.Private.SubscribeFuturesOrder(orderCh)
+func (p *Private) SubscribeFuturesOrder(ch chan *private.FuturesOrderEvent) error {
+	if !p.IsAuthenticated() {
+		return errors.New("not authenticated, please call Login() first")
+	}
+
+	p.futuresOrderCh = ch
+
+	channel := "futures/order"
+
+	// Register message handler
+	p.RegisterHandler(channel, func(data []byte) {
+		var event private.FuturesOrderEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			p.logger.Warn("failed to decode event", "private", true, "channel", channel, "error", err)
+			return
+		}
+		select {
+		case p.futuresOrderCh <- &event:
+		default:
+			p.Dropped(true, channel, "")
+		}
+	})
+
+	return p.Subscribe(channel)
+}
+
+// UnsubscribeFuturesOrder unsubscribes from futures order channel
+func (p *Private) UnsubscribeFuturesOrder() error {
+	channel := "futures/order"
+	p.UnregisterHandler(channel)
+
+	return p.Unsubscribe(channel)
+}
+
 // GetFuturesAssetChan returns the futures asset channel
 func (p *Private) GetFuturesAssetChan() chan *private.FuturesAssetEvent {
 	return p.futuresAssetCh
@@ -297,3 +343,8 @@ func (p *Private) GetFuturesAssetChan() chan *private.FuturesAssetEvent {
 func (p *Private) GetFuturesPositionChan() chan *private.FuturesPositionEvent {
 	return p.futuresPositionCh
 }
+
+// GetFuturesOrderChan returns the futures order channel
+func (p *Private) GetFuturesOrderChan() chan *private.FuturesOrderEvent {
+	return p.futuresOrderCh
+}
@@ -13,8 +13,11 @@ type Public struct {
 	tickerCh        chan *public.TickerEvent
 	futuresTickerCh chan *public.FuturesTickerEvent
 	depthCh         chan *public.DepthEvent
+	depthIncreaseCh chan *public.DepthIncreaseEvent
 	tradeCh         chan *public.TradeEvent
+	futuresTradeCh  chan *public.FuturesTradeEvent
 	klineCh         chan *public.KlineEvent
+	fundingRateCh   chan *public.FundingRateEvent
 }
 
 // NewPublic creates a new Public instance
@@ -43,13 +46,13 @@ func (p *Public) SubscribeTicker(symbol string, ch ...chan *public.TickerEvent)
 	p.RegisterHandler(channel, func(data []byte) {
 		var event public.TickerEvent
 		if err := json.Unmarshal(data, &event); err != nil {
-			fmt.Printf("Failed to unmarshal spot ticker event: %v\n", err)
+			p.logger.Warn("failed to decode event", "private", false, "channel", channel, "symbol", symbol, "error", err)
 			return
 		}
 		select {
 		case targetCh <- &event:
 		default:
-			// Channel full, drop message
+			p.Dropped(false, channel, symbol)
 		}
 	})
 
@@ -77,14 +80,13 @@ func (p *Public) SubscribeFuturesTicker(symbol string, ch ...chan *public.Future
 	p.RegisterHandler(channel, func(data []byte) {
 		var event public.FuturesTickerEvent
 		if err := json.Unmarshal(data, &event); err != nil {
-			fmt.Printf("Failed to unmarshal futures ticker event: %v\n", err)
-			fmt.Printf("Raw data: %s\n", string(data))
+			p.logger.Warn("failed to decode event", "private", false, "channel", channel, "symbol", symbol, "error", err)
 			return
 		}
 		select {
 		case targetCh <- &event:
 		default:
-			// Channel full, drop message
+			p.Dropped(false, channel, symbol)
 		}
 	})
 
@@ -114,13 +116,13 @@ func (p *Public) SubscribeFuturesTickerBatch(symbols []string, ch chan *public.F
 		p.RegisterHandler(channel, func(data []byte) {
 			var event public.FuturesTickerEvent
 			if err := json.Unmarshal(data, &event); err != nil {
-				fmt.Printf("Failed to unmarshal futures ticker batch event: %v\n", err)
+				p.logger.Warn("failed to decode event", "private", false, "channel", channel, "symbol", symbol, "error", err)
 				return
 			}
 			select {
 			case capturedCh <- &event:
 			default:
-				// Channel full, drop message
+				p.Dropped(false, channel, symbol)
 			}
 		})
 	}
@@ -170,13 +172,13 @@ func (p *Public) SubscribeDepth(symbol string, depth int, ch ...chan *public.Dep
 	p.RegisterHandler(channel, func(data []byte) {
 		var event public.DepthEvent
 		if err := json.Unmarshal(data, &event); err != nil {
-			fmt.Printf("Failed to unmarshal depth event: %v\n", err)
+			p.logger.Warn("failed to decode event", "private", false, "channel", channel, "symbol", symbol, "error", err)
 			return
 		}
 		select {
 		case targetCh <- &event:
 		default:
-			// Channel full, drop message
+			p.Dropped(false, channel, symbol)
 		}
 	})
 
@@ -193,6 +195,56 @@ func (p *Public) UnsubscribeDepth(symbol string, depth int) error {
 	return p.Unsubscribe(channel)
 }
 
+// SubscribeDepthIncrease subscribes to the futures incremental depth channel
+//
+// Channel: futures/depthIncrease{level}:{symbol}@100ms
+// Level: 5, 20, 50
+// The first push is a snapshot of the top level levels, followed by updates whose
+// version increments by 1. On a version gap, unsubscribe and subscribe again for
+// a new snapshot.
+func (p *Public) SubscribeDepthIncrease(symbol string, level int, ch ...chan *public.DepthIncreaseEvent) error {
+	var targetCh chan *public.DepthIncreaseEvent
+	if len(ch) > 0 {
+		targetCh = ch[0]
+	} else {
+		targetCh = make(chan *public.DepthIncreaseEvent, 100)
+	}
+	p.depthIncreaseCh = targetCh
+
+	channel := depthIncreaseChannel(symbol, level)
+
+	// Register message handler
+	p.RegisterHandler(channel, func(data []byte) {
+		var event public.DepthIncreaseEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			p.logger.Warn("failed to decode event", "private", false, "channel", channel, "symbol", symbol, "error", err)
+			return
+		}
+		select {
+		case targetCh <- &event:
+		default:
+			p.Dropped(false, channel, symbol)
+		}
+	})
+
+	// Handlers are looked up without the speed suffix echoed by the server
+	return p.Subscribe(channel + "@100ms")
+}
+
+// UnsubscribeDepthIncrease unsubscribes from the futures incremental depth channel
+func (p *Public) UnsubscribeDepthIncrease(symbol string, level int) error {
+	channel := depthIncreaseChannel(symbol, level)
+	p.UnregisterHandler(channel)
+
+	return p.Unsubscribe(channel + "@100ms")
+}
+
+// depthIncreaseChannel returns the incremental depth channel of symbol
+func depthIncreaseChannel(symbol string, level int) string {
+	// Convert symbol format: BTC_USDT -> BTCUSDT (remove underscore)
+	return fmt.Sprintf("futures/depthIncrease%d:%s", level, normalizeSymbol(symbol))
+}
+
 // SubscribeTrade subscribes to trade channel
 //
 // Channel: futures/trade:{symbol}
@@ -214,13 +266,13 @@ func (p *Public) SubscribeTrade(symbol string, ch ...chan *public.TradeEvent) er
 	p.RegisterHandler(channel, func(data []byte) {
 		var event public.TradeEvent
 		if err := json.Unmarshal(data, &event); err != nil {
-			fmt.Printf("Failed to unmarshal trade event: %v\n", err)
+			p.logger.Warn("failed to decode event", "private", false, "channel", channel, "symbol", symbol, "error", err)
 			return
 		}
 		select {
 		case targetCh <- &event:
 		default:
-			// Channel full, drop message
+			p.Dropped(false, channel, symbol)
 		}
 	})
 
@@ -237,6 +289,43 @@ func (p *Public) UnsubscribeTrade(symbol string) error {
 	return p.Unsubscribe(channel)
 }
 
+// SubscribeFuturesTrade subscribes to futures trade channel
+//
+// Channel: futures/trade:{symbol}
+// Note: Decodes the futures trade format, each push carrying a batch of trades
+func (p *Public) SubscribeFuturesTrade(symbol string, ch ...chan *public.FuturesTradeEvent) error {
+	var targetCh chan *public.FuturesTradeEvent
+	if len(ch) > 0 {
+		targetCh = ch[0]
+	} else {
+		targetCh = make(chan *public.FuturesTradeEvent, 100)
+	}
+	p.futuresTradeCh = targetCh
+
+	channel := fmt.Sprintf("futures/trade:%s", normalizeSymbol(symbol))
+
+	// Register message handler
+	p.RegisterHandler(channel, func(data []byte) {
+		var event public.FuturesTradeEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			p.logger.Warn("failed to decode event", "private", false, "channel", channel, "symbol", symbol, "error", err)
+			return
+		}
+		select {
+		case targetCh <- &event:
+		default:
+			p.Dropped(false, channel, symbol)
+		}
+	})
+
+	return p.Subscribe(channel)
+}
+
+// UnsubscribeFuturesTrade unsubscribes from futures trade channel
+func (p *Public) UnsubscribeFuturesTrade(symbol string) error {
+	return p.UnsubscribeTrade(symbol)
+}
+
 // SubscribeKline subscribes to kline/candlestick channel
 //
 // Channel: futures/kline{step}:{symbol}
@@ -259,13 +348,13 @@ func (p *Public) SubscribeKline(symbol string, step string, ch ...chan *public.K
 	p.RegisterHandler(channel, func(data []byte) {
 		var event public.KlineEvent
 		if err := json.Unmarshal(data, &event); err != nil {
-			fmt.Printf("Failed to unmarshal kline event: %v\n", err)
+			p.logger.Warn("failed to decode event", "private", false, "channel", channel, "symbol", symbol, "error", err)
 			return
 		}
 		select {
 		case targetCh <- &event:
 		default:
-			// Channel full, drop message
+			p.Dropped(false, channel, symbol)
 		}
 	})
 
@@ -282,6 +371,46 @@ func (p *Public) UnsubscribeKline(symbol string, step string) error {
 	return p.Unsubscribe(channel)
 }
 
+// SubscribeFundingRate subscribes to futures funding rate channel
+//
+// Channel: futures/fundingRate:{symbol}
+// Note: BitMart v2 API uses futures channels and symbol format without underscore (e.g., BTCUSDT)
+func (p *Public) SubscribeFundingRate(symbol string, ch ...chan *public.FundingRateEvent) error {
+	var targetCh chan *public.FundingRateEvent
+	if len(ch) > 0 {
+		targetCh = ch[0]
+	} else {
+		targetCh = make(chan *public.FundingRateEvent, 100)
+	}
+	p.fundingRateCh = targetCh
+
+	channel := fmt.Sprintf("futures/fundingRate:%s", normalizeSymbol(symbol))
+
+	// Register message handler
+	p.RegisterHandler(channel, func(data []byte) {
+		var event public.FundingRateEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			p.logger.Warn("failed to decode event", "private", false, "channel", channel, "symbol", symbol, "error", err)
+			return
+		}
+		select {
+		case targetCh <- &event:
+		default:
+			p.Dropped(false, channel, symbol)
+		}
+	})
+
+	return p.Subscribe(channel)
+}
+
+// UnsubscribeFundingRate unsubscribes from futures funding rate channel
+func (p *Public) UnsubscribeFundingRate(symbol string) error {
+	channel := fmt.Sprintf("futures/fundingRate:%s", normalizeSymbol(symbol))
+	p.UnregisterHandler(channel)
+
+	return p.Unsubscribe(channel)
+}
+
 // GetTickerChan returns the ticker channel (legacy spot ticker)
 func (p *Public) GetTickerChan() chan *public.TickerEvent {
 	return p.tickerCh
@@ -297,16 +426,31 @@ func (p *Public) GetDepthChan() chan *public.DepthEvent {
 	return p.depthCh
 }
 
+// GetDepthIncreaseChan returns the incremental depth event channel
+func (p *Public) GetDepthIncreaseChan() chan *public.DepthIncreaseEvent {
+	return p.depthIncreaseCh
+}
+
 // GetTradeChan returns the trade channel
 func (p *Public) GetTradeChan() chan *public.TradeEvent {
 	return p.tradeCh
 }
 
+// GetFuturesTradeChan returns the futures trade channel
+func (p *Public) GetFuturesTradeChan() chan *public.FuturesTradeEvent {
+	return p.futuresTradeCh
+}
+
 // GetKlineChan returns the kline channel
 func (p *Public) GetKlineChan() chan *public.KlineEvent {
 	return p.klineCh
 }
 
+// GetFundingRateChan returns the futures funding rate channel
+func (p *Public) GetFundingRateChan() chan *public.FundingRateEvent {
+	return p.fundingRateCh
+}
+
 // normalizeSymbol converts symbol format from BTC_USDT to BTCUSDT (removes underscore)
 // BitMart v2 API uses symbol format without underscore
 func normalizeSymbol(symbol string) string {
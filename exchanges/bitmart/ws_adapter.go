@@ -1,12 +1,16 @@
 package bitmart
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	privateevents "github.com/djpken/go-exc/exchanges/bitmart/events/private"
 	publicevents "github.com/djpken/go-exc/exchanges/bitmart/events/public"
 	"github.com/djpken/go-exc/exchanges/bitmart/ws"
+	"github.com/djpken/go-exc/orderbook"
 	commontypes "github.com/djpken/go-exc/types"
 )
 
@@ -14,10 +18,16 @@ import (
 type WebSocketAdapter struct {
 	client           *ws.ClientWs
 	converter        *Converter
+	tickerMu         sync.RWMutex                                         // guards tickerChannels and markChannels
 	tickerChannels   map[string]chan *commontypes.TickerUpdate            // symbol -> channel
+	markChannels     map[string]chan *commontypes.MarkPriceUpdate         // symbol -> channel, sharing the ticker channel
 	candleChannels   map[string]map[string]chan *commontypes.CandleUpdate // interval -> symbol -> channel
 	accountChannels  map[string]chan *commontypes.AccountUpdate           // currency -> channel
 	positionChannels map[string]chan *commontypes.PositionUpdate          // "default" -> channel
+	orderChannels    map[string]chan *commontypes.OrderUpdate             // BitMart channel -> channel
+	bookLevels       map[string]int                                       // symbol -> depth level
+	bookStops        map[string]chan struct{}                             // symbol -> forwarder stop
+	tradeStops       map[string]chan struct{}                             // symbol -> forwarder stop
 }
 
 // NewWebSocketAdapter creates a new WebSocket adapter
@@ -26,9 +36,14 @@ func NewWebSocketAdapter(client *ws.ClientWs) *WebSocketAdapter {
 		client:           client,
 		converter:        NewConverter(),
 		tickerChannels:   make(map[string]chan *commontypes.TickerUpdate),
+		markChannels:     make(map[string]chan *commontypes.MarkPriceUpdate),
 		candleChannels:   make(map[string]map[string]chan *commontypes.CandleUpdate),
 		accountChannels:  make(map[string]chan *commontypes.AccountUpdate),
 		positionChannels: make(map[string]chan *commontypes.PositionUpdate),
+		orderChannels:    make(map[string]chan *commontypes.OrderUpdate),
+		bookLevels:       make(map[string]int),
+		bookStops:        make(map[string]chan struct{}),
+		tradeStops:       make(map[string]chan struct{}),
 	}
 }
 
@@ -55,75 +70,178 @@ func (a *WebSocketAdapter) SubscribeTickers(userCh chan *commontypes.TickerUpdat
 		}
 	}
 
-	// Use batch subscribe when multiple symbols to send a single WS message,
-	// avoiding BitMart rate limiting from rapid-fire individual subscriptions.
-	if len(symbols) > 1 {
-		internalCh := make(chan *publicevents.FuturesTickerEvent, 200*len(symbols))
-		if err := a.client.Public.SubscribeFuturesTickerBatch(symbols, internalCh); err != nil {
-			return fmt.Errorf("failed to batch subscribe tickers: %w", err)
-		}
-		for _, symbol := range symbols {
-			a.tickerChannels[symbol] = userCh
+	if err := a.subscribeFuturesTickers(symbols); err != nil {
+		return err
+	}
+
+	a.tickerMu.Lock()
+	defer a.tickerMu.Unlock()
+	for _, symbol := range symbols {
+		a.tickerChannels[normalizeSymbol(symbol)] = userCh
+	}
+
+	return nil
+}
+
+// subscribeFuturesTickers subscribes to the futures ticker channel of the symbols
+// not yet subscribed, tickers and mark prices sharing the channel
+func (a *WebSocketAdapter) subscribeFuturesTickers(symbols []string) error {
+	a.tickerMu.RLock()
+	var pending []string
+	for _, symbol := range symbols {
+		key := normalizeSymbol(symbol)
+		if a.tickerChannels[key] == nil && a.markChannels[key] == nil {
+			pending = append(pending, symbol)
 		}
-		// Single goroutine drains internalCh to avoid N goroutines competing on the same channel.
-		go a.forwardTickerEvents("batch", internalCh, userCh)
-		return nil
 	}
+	a.tickerMu.RUnlock()
 
-	// Single symbol: use individual subscribe (rate-limited internally).
-	internalCh := make(chan *publicevents.FuturesTickerEvent, 100)
-	if err := a.client.Public.SubscribeFuturesTicker(symbols[0], internalCh); err != nil {
-		return fmt.Errorf("failed to subscribe to %s: %w", symbols[0], err)
+	switch len(pending) {
+	case 0:
+		return nil
+	case 1:
+		// Single symbol: use individual subscribe (rate-limited internally).
+		internalCh := make(chan *publicevents.FuturesTickerEvent, 100)
+		if err := a.client.Public.SubscribeFuturesTicker(pending[0], internalCh); err != nil {
+			return fmt.Errorf("failed to subscribe to %s: %w", pending[0], err)
+		}
+		go a.forwardTickerEvents(pending[0], internalCh)
+	default:
+		// Use batch subscribe when multiple symbols to send a single WS message,
+		// avoiding BitMart rate limiting from rapid-fire individual subscriptions.
+		internalCh := make(chan *publicevents.FuturesTickerEvent, 200*len(pending))
+		if err := a.client.Public.SubscribeFuturesTickerBatch(pending, internalCh); err != nil {
+			return fmt.Errorf("failed to batch subscribe tickers: %w", err)
+		}
+		// Single goroutine drains internalCh to avoid N goroutines competing on the same channel.
+		go a.forwardTickerEvents("batch", internalCh)
 	}
-	a.tickerChannels[symbols[0]] = userCh
-	go a.forwardTickerEvents(symbols[0], internalCh, userCh)
 
 	return nil
 }
 
-// forwardTickerEvents converts BitMart ticker events to common types and forwards them
-func (a *WebSocketAdapter) forwardTickerEvents(symbol string, internalCh chan *publicevents.FuturesTickerEvent, userCh chan *commontypes.TickerUpdate) {
+// forwardTickerEvents converts BitMart ticker events to common ticker and mark price
+// updates and forwards them to the channels subscribed for the event symbol
+func (a *WebSocketAdapter) forwardTickerEvents(symbol string, internalCh chan *publicevents.FuturesTickerEvent) {
 	for event := range internalCh {
-		event := event.Data
-		// Convert BitMart ticker event to common TickerUpdate
-		update := &commontypes.TickerUpdate{
-			Symbol:    event.Symbol,
-			LastPrice: a.converter.stringToDecimal(event.LastPrice),
-			BidPrice:  a.converter.stringToDecimal(event.BidPrice),
-			BidSize:   a.converter.stringToDecimal(event.BidVol),
-			AskPrice:  a.converter.stringToDecimal(event.AskPrice),
-			AskSize:   a.converter.stringToDecimal(event.AskVol),
+		event := &event.Data
+
+		a.tickerMu.RLock()
+		tickerCh := a.tickerChannels[event.Symbol]
+		markCh := a.markChannels[event.Symbol]
+		a.tickerMu.RUnlock()
+
+		if tickerCh != nil {
+			// Convert BitMart ticker event to common TickerUpdate
+			update := &commontypes.TickerUpdate{
+				Symbol:    event.Symbol,
+				LastPrice: a.converter.stringToDecimal(event.LastPrice),
+				BidPrice:  a.converter.stringToDecimal(event.BidPrice),
+				BidSize:   a.converter.stringToDecimal(event.BidVol),
+				AskPrice:  a.converter.stringToDecimal(event.AskPrice),
+				AskSize:   a.converter.stringToDecimal(event.AskVol),
+			}
+
+			// Forward to user channel
+			select {
+			case tickerCh <- update:
+			default:
+				// Channel full, drop message
+				a.client.Dropped(false, "tickers", symbol)
+			}
 		}
 
-		// Forward to user channel
-		select {
-		case userCh <- update:
-		default:
-			// Channel full, drop message
-			fmt.Printf("Warning: ticker channel full for %s, dropping update\n", symbol)
+		if markCh != nil && event.MarkPrice != "" {
+			select {
+			case markCh <- &commontypes.MarkPriceUpdate{MarkPrice: *a.converter.ConvertFuturesTickerMarkPrice(event)}:
+			default:
+				a.client.Dropped(false, "mark-price", symbol)
+			}
 		}
 	}
 }
 
-// UnsubscribeTickers unsubscribes from ticker updates for specified symbols
+// UnsubscribeTickers unsubscribes from ticker updates for specified symbols,
+// keeping the ticker channel of symbols still subscribed for mark prices
 func (a *WebSocketAdapter) UnsubscribeTickers(symbols ...string) error {
 	if len(symbols) == 0 {
 		return fmt.Errorf("no symbols specified")
 	}
 
 	for _, symbol := range symbols {
+		a.tickerMu.Lock()
+		delete(a.tickerChannels, normalizeSymbol(symbol))
+		shared := a.markChannels[normalizeSymbol(symbol)] != nil
+		a.tickerMu.Unlock()
+		if shared {
+			continue
+		}
+
 		// Unsubscribe from BitMart ticker channel
-		if err := a.client.Public.UnsubscribeTicker(symbol); err != nil {
+		if err := a.client.Public.UnsubscribeFuturesTicker(symbol); err != nil {
 			return fmt.Errorf("failed to unsubscribe from %s: %w", symbol, err)
 		}
+	}
 
-		// Remove from tracking
-		delete(a.tickerChannels, symbol)
+	return nil
+}
+
+// SubscribeMarkPrice subscribes to mark and index price updates for specified symbols,
+// carried by the futures ticker channel
+func (a *WebSocketAdapter) SubscribeMarkPrice(userCh chan *commontypes.MarkPriceUpdate, symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	// Ensure connection (only connect if not already connected)
+	if !a.client.IsConnected() {
+		if err := a.Connect(); err != nil {
+			return fmt.Errorf("failed to connect: %w", err)
+		}
+	}
+
+	if err := a.subscribeFuturesTickers(symbols); err != nil {
+		return err
+	}
+
+	a.tickerMu.Lock()
+	defer a.tickerMu.Unlock()
+	for _, symbol := range symbols {
+		a.markChannels[normalizeSymbol(symbol)] = userCh
 	}
 
 	return nil
 }
 
+// UnsubscribeMarkPrice unsubscribes from mark price updates for specified symbols,
+// keeping the ticker channel of symbols still subscribed for tickers
+func (a *WebSocketAdapter) UnsubscribeMarkPrice(symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		a.tickerMu.Lock()
+		delete(a.markChannels, normalizeSymbol(symbol))
+		shared := a.tickerChannels[normalizeSymbol(symbol)] != nil
+		a.tickerMu.Unlock()
+		if shared {
+			continue
+		}
+
+		if err := a.client.Public.UnsubscribeFuturesTicker(symbol); err != nil {
+			return fmt.Errorf("failed to unsubscribe from %s: %w", symbol, err)
+		}
+	}
+
+	return nil
+}
+
+// normalizeSymbol returns the contract symbol as pushed by BitMart, without underscore
+func normalizeSymbol(symbol string) string {
+	return strings.ReplaceAll(symbol, "_", "")
+}
+
 // Subscribe subscribes to a channel
 // Note: This is a simplified implementation
 // Full implementation would need to map common channel types to BitMart-specific channels
@@ -201,7 +319,7 @@ func (a *WebSocketAdapter) forwardCandleEvents(symbol, interval string, internal
 		case userCh <- update:
 		default:
 			// Channel full, drop message
-			fmt.Printf("Warning: candle channel full for %s %s, dropping update\n", symbol, interval)
+			a.client.Dropped(false, "candle"+interval, symbol)
 		}
 	}
 }
@@ -227,6 +345,321 @@ func (a *WebSocketAdapter) UnsubscribeCandles(interval string, symbols ...string
 	return nil
 }
 
+// SubscribeOrderBook streams the top depth levels of a local order book per symbol,
+// kept from the futures incremental depth channel. The channel level is the smallest
+// of 5, 20 and 50 covering depth (50 when depth is 0). A version gap resubscribes the
+// symbol, making BitMart push a new snapshot. Spot symbols return ErrNotSupported.
+func (a *WebSocketAdapter) SubscribeOrderBook(userCh chan *commontypes.OrderBookUpdate, depth int, symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+	for _, symbol := range symbols {
+		if isSpotSymbol(symbol) {
+			return fmt.Errorf("bitmart: order book stream for spot symbol %s: %w", symbol, commontypes.ErrNotSupported)
+		}
+	}
+
+	// Ensure connection (only connect if not already connected)
+	if !a.client.IsConnected() {
+		if err := a.Connect(); err != nil {
+			return fmt.Errorf("failed to connect: %w", err)
+		}
+	}
+
+	level := depthLevel(depth)
+	for _, symbol := range symbols {
+		// Create internal channel for this symbol
+		internalCh := make(chan *publicevents.DepthIncreaseEvent, 100)
+
+		if err := a.client.Public.SubscribeDepthIncrease(symbol, level, internalCh); err != nil {
+			return fmt.Errorf("failed to subscribe to %s order book: %w", symbol, err)
+		}
+
+		// Store the level for unsubscription
+		a.bookLevels[symbol] = level
+
+		// Replace the forwarder of an earlier subscription to the symbol
+		if stop, ok := a.bookStops[symbol]; ok {
+			close(stop)
+		}
+		stop := make(chan struct{})
+		a.bookStops[symbol] = stop
+
+		// Start goroutine to maintain the book and forward updates
+		go a.forwardOrderBookEvents(symbol, level, depth, internalCh, userCh, stop)
+	}
+
+	return nil
+}
+
+// isSpotSymbol reports whether symbol is a spot symbol (BTC_USDT) rather than a
+// contract symbol (BTCUSDT)
+func isSpotSymbol(symbol string) bool {
+	return strings.Contains(symbol, "_")
+}
+
+// depthLevel returns the incremental depth channel level covering depth
+func depthLevel(depth int) int {
+	switch {
+	case depth > 0 && depth <= 5:
+		return 5
+	case depth > 0 && depth <= 20:
+		return 20
+	default:
+		return 50
+	}
+}
+
+// forwardOrderBookEvents applies BitMart incremental depth events to the local book
+// of symbol and forwards its top levels until stop is closed
+func (a *WebSocketAdapter) forwardOrderBookEvents(symbol string, level, depth int, internalCh chan *publicevents.DepthIncreaseEvent, userCh chan *commontypes.OrderBookUpdate, stop chan struct{}) {
+	book := orderbook.New()
+	for {
+		var event *publicevents.DepthIncreaseEvent
+		select {
+		case <-stop:
+			return
+		case e, ok := <-internalCh:
+			if !ok {
+				return
+			}
+			event = e
+		}
+		data := event.Data
+		bids := a.converter.ConvertDepthLevels(data.Bids)
+		asks := a.converter.ConvertDepthLevels(data.Asks)
+
+		snapshot := data.Type == "snapshot"
+		var delta commontypes.OrderBookDelta
+		if snapshot {
+			delta = book.Snapshot(bids, asks, data.Version)
+		} else {
+			delta = commontypes.OrderBookDelta{Bids: bids, Asks: asks}
+			if err := book.Apply(delta, data.Version-1, data.Version); err != nil {
+				// Updates received before the new snapshot are skipped
+				if errors.Is(err, orderbook.ErrGap) {
+					a.resyncOrderBook(symbol, level, internalCh, data.Version, book.Seq())
+				}
+				continue
+			}
+		}
+
+		topBids, topAsks := book.Top(depth)
+		update := &commontypes.OrderBookUpdate{
+			Symbol:    symbol,
+			Bids:      topBids,
+			Asks:      topAsks,
+			Delta:     delta,
+			Snapshot:  snapshot,
+			Sequence:  data.Version,
+			Timestamp: commontypes.Timestamp(time.UnixMilli(data.Timestamp)),
+			Extra:     make(map[string]interface{}),
+		}
+
+		// Forward to user channel
+		select {
+		case userCh <- update:
+		default:
+			// Channel full, drop message
+			a.client.Dropped(false, "depthIncrease", symbol)
+		}
+	}
+}
+
+// resyncOrderBook resubscribes to the order book of symbol after a version gap
+func (a *WebSocketAdapter) resyncOrderBook(symbol string, level int, internalCh chan *publicevents.DepthIncreaseEvent, version, lastVersion int64) {
+	a.client.Logger().Warn("order book sequence gap, resyncing", "channel", "depthIncrease", "symbol", symbol,
+		"version", version, "last_version", lastVersion)
+
+	if err := a.client.Public.UnsubscribeDepthIncrease(symbol, level); err != nil {
+		a.client.Logger().Error("failed to unsubscribe order book", "symbol", symbol, "error", err)
+	}
+	if err := a.client.Public.SubscribeDepthIncrease(symbol, level, internalCh); err != nil {
+		a.client.Logger().Error("failed to resubscribe order book", "symbol", symbol, "error", err)
+	}
+}
+
+// UnsubscribeOrderBook unsubscribes from order book updates for specified symbols
+func (a *WebSocketAdapter) UnsubscribeOrderBook(symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		level, ok := a.bookLevels[symbol]
+		if !ok {
+			continue
+		}
+		if err := a.client.Public.UnsubscribeDepthIncrease(symbol, level); err != nil {
+			return fmt.Errorf("failed to unsubscribe from %s order book: %w", symbol, err)
+		}
+
+		// Remove from tracking and stop the forwarder
+		delete(a.bookLevels, symbol)
+		if stop, ok := a.bookStops[symbol]; ok {
+			close(stop)
+			delete(a.bookStops, symbol)
+		}
+	}
+
+	return nil
+}
+
+// SubscribeTrades subscribes to public trades for specified symbols via the futures
+// trade channel. BitMart trade IDs are not sequential, so Missed is always 0.
+// Spot symbols return ErrNotSupported.
+func (a *WebSocketAdapter) SubscribeTrades(userCh chan *commontypes.TradeUpdate, symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+	for _, symbol := range symbols {
+		if isSpotSymbol(symbol) {
+			return fmt.Errorf("bitmart: trade stream for spot symbol %s: %w", symbol, commontypes.ErrNotSupported)
+		}
+	}
+
+	// Ensure connection (only connect if not already connected)
+	if !a.client.IsConnected() {
+		if err := a.Connect(); err != nil {
+			return fmt.Errorf("failed to connect: %w", err)
+		}
+	}
+
+	for _, symbol := range symbols {
+		// Create internal channel for this symbol
+		internalCh := make(chan *publicevents.FuturesTradeEvent, 100)
+
+		if err := a.client.Public.SubscribeFuturesTrade(symbol, internalCh); err != nil {
+			return fmt.Errorf("failed to subscribe to %s trades: %w", symbol, err)
+		}
+
+		// Replace the forwarder of an earlier subscription to the symbol
+		if stop, ok := a.tradeStops[symbol]; ok {
+			close(stop)
+		}
+		stop := make(chan struct{})
+		a.tradeStops[symbol] = stop
+
+		// Start goroutine to convert and forward events
+		go a.forwardTradeEvents(symbol, internalCh, userCh, stop)
+	}
+
+	return nil
+}
+
+// forwardTradeEvents converts BitMart futures trade events to common types and
+// forwards them until stop is closed
+func (a *WebSocketAdapter) forwardTradeEvents(symbol string, internalCh chan *publicevents.FuturesTradeEvent, userCh chan *commontypes.TradeUpdate, stop chan struct{}) {
+	for {
+		var event *publicevents.FuturesTradeEvent
+		select {
+		case <-stop:
+			return
+		case e, ok := <-internalCh:
+			if !ok {
+				return
+			}
+			event = e
+		}
+		for i := range event.Data {
+			trade := a.converter.ConvertFuturesTrade(&event.Data[i])
+			if trade == nil {
+				continue
+			}
+
+			// Forward to user channel
+			select {
+			case userCh <- &commontypes.TradeUpdate{Trade: *trade}:
+			default:
+				// Channel full, drop message
+				a.client.Dropped(false, "futures/trade", symbol)
+			}
+		}
+	}
+}
+
+// UnsubscribeTrades unsubscribes from public trades for specified symbols
+func (a *WebSocketAdapter) UnsubscribeTrades(symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		if err := a.client.Public.UnsubscribeFuturesTrade(symbol); err != nil {
+			return fmt.Errorf("failed to unsubscribe from %s trades: %w", symbol, err)
+		}
+
+		// Stop the forwarder
+		if stop, ok := a.tradeStops[symbol]; ok {
+			close(stop)
+			delete(a.tradeStops, symbol)
+		}
+	}
+
+	return nil
+}
+
+// SubscribeFundingRate subscribes to funding rate updates of perpetual contracts
+func (a *WebSocketAdapter) SubscribeFundingRate(userCh chan *commontypes.FundingRateUpdate, symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	// Ensure connection (only connect if not already connected)
+	if !a.client.IsConnected() {
+		if err := a.Connect(); err != nil {
+			return fmt.Errorf("failed to connect: %w", err)
+		}
+	}
+
+	for _, symbol := range symbols {
+		// Create internal channel for this symbol
+		internalCh := make(chan *publicevents.FundingRateEvent, 100)
+
+		if err := a.client.Public.SubscribeFundingRate(symbol, internalCh); err != nil {
+			return fmt.Errorf("failed to subscribe to %s funding rate: %w", symbol, err)
+		}
+
+		// Start goroutine to convert and forward events
+		go a.forwardFundingRateEvents(symbol, internalCh, userCh)
+	}
+
+	return nil
+}
+
+// forwardFundingRateEvents converts BitMart funding rate events to common types and forwards them
+func (a *WebSocketAdapter) forwardFundingRateEvents(symbol string, internalCh chan *publicevents.FundingRateEvent, userCh chan *commontypes.FundingRateUpdate) {
+	for event := range internalCh {
+		rate := a.converter.ConvertFundingRateEvent(&event.Data)
+		if rate == nil {
+			continue
+		}
+
+		// Forward to user channel
+		select {
+		case userCh <- &commontypes.FundingRateUpdate{FundingRate: *rate}:
+		default:
+			// Channel full, drop message
+			a.client.Dropped(false, "futures/fundingRate", symbol)
+		}
+	}
+}
+
+// UnsubscribeFundingRate unsubscribes from funding rate updates for specified symbols
+func (a *WebSocketAdapter) UnsubscribeFundingRate(symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		if err := a.client.Public.UnsubscribeFundingRate(symbol); err != nil {
+			return fmt.Errorf("failed to unsubscribe from %s funding rate: %w", symbol, err)
+		}
+	}
+
+	return nil
+}
+
 // SubscribeAccount subscribes to account/balance updates
 // BitMart requires authentication before subscribing to private channels
 func (a *WebSocketAdapter) SubscribeAccount(userCh chan *commontypes.AccountUpdate, currencies ...string) error {
@@ -303,7 +736,7 @@ func (a *WebSocketAdapter) forwardAccountEvents(internalCh chan *privateevents.F
 		case userCh <- update:
 		default:
 			// Channel full, drop message
-			fmt.Printf("Warning: account channel full for %s, dropping update\n", data.Currency)
+			a.client.Dropped(true, "account", data.Currency)
 		}
 	}
 }
@@ -430,7 +863,7 @@ func (a *WebSocketAdapter) forwardPositionEvents(internalCh chan *privateevents.
 		case userCh <- update:
 		default:
 			// Channel full, drop message
-			fmt.Printf("Warning: position channel full, dropping update\n")
+			a.client.Dropped(true, "positions", "")
 		}
 	}
 }
@@ -448,6 +881,130 @@ func (a *WebSocketAdapter) UnsubscribePosition(req commontypes.WebSocketSubscrib
 	return nil
 }
 
+// SubscribeOrders subscribes to order updates
+// req.InstrumentType selects the spot/user/order channel for InstrumentSpot and the
+// futures/order channel otherwise; req.Symbols optionally filters the orders forwarded.
+// BitMart requires authentication before subscribing to private channels
+func (a *WebSocketAdapter) SubscribeOrders(userCh chan *commontypes.OrderUpdate, req commontypes.WebSocketSubscribeRequest) error {
+	// Ensure connection
+	if !a.client.IsConnected() {
+		if err := a.Connect(); err != nil {
+			return fmt.Errorf("failed to connect: %w", err)
+		}
+	}
+
+	// Authenticate if not already authenticated
+	if !a.client.IsAuthenticated() {
+		if err := a.client.Login(); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+		// Wait a bit for authentication to complete
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	symbols := make(map[string]bool, len(req.Symbols))
+	for _, symbol := range req.Symbols {
+		symbols[symbol] = true
+	}
+
+	if req.InstrumentType == commontypes.InstrumentSpot {
+		internalCh := make(chan *privateevents.OrderEvent, 100)
+		if err := a.client.Private.SubscribeOrder(internalCh); err != nil {
+			return fmt.Errorf("failed to subscribe to spot orders: %w", err)
+		}
+		a.orderChannels["spot/user/order"] = userCh
+		go a.forwardSpotOrderEvents(symbols, internalCh, userCh)
+		return nil
+	}
+
+	internalCh := make(chan *privateevents.FuturesOrderEvent, 100)
+	if err := a.client.Private.SubscribeFuturesOrder(internalCh); err != nil {
+		return fmt.Errorf("failed to subscribe to futures orders: %w", err)
+	}
+	a.orderChannels["futures/order"] = userCh
+	go a.forwardFuturesOrderEvents(symbols, internalCh, userCh)
+	return nil
+}
+
+// forwardSpotOrderEvents converts BitMart spot order events to common types and forwards them,
+// skipping symbols not in symbols unless it is empty
+func (a *WebSocketAdapter) forwardSpotOrderEvents(symbols map[string]bool, internalCh chan *privateevents.OrderEvent, userCh chan *commontypes.OrderUpdate) {
+	for event := range internalCh {
+		orders := make([]*commontypes.Order, 0, len(event.Data))
+		for i := range event.Data {
+			if len(symbols) > 0 && !symbols[event.Data[i].Symbol] {
+				continue
+			}
+			orders = append(orders, a.converter.ConvertSpotOrderData(&event.Data[i]))
+		}
+		a.sendOrderUpdate(orders, event.Table, userCh)
+	}
+}
+
+// forwardFuturesOrderEvents converts BitMart futures order events to common types and forwards them,
+// skipping symbols not in symbols unless it is empty. BitMart pushes the fee of each fill;
+// it is accumulated per order so that Fee covers all fills, like FilledQuantity.
+func (a *WebSocketAdapter) forwardFuturesOrderEvents(symbols map[string]bool, internalCh chan *privateevents.FuturesOrderEvent, userCh chan *commontypes.OrderUpdate) {
+	fees := make(map[string]commontypes.Decimal) // order ID -> fee paid so far, dropped once the order is done
+	for event := range internalCh {
+		orders := make([]*commontypes.Order, 0, len(event.Data))
+		for i := range event.Data {
+			if len(symbols) > 0 && !symbols[event.Data[i].Order.Symbol] {
+				continue
+			}
+			order := a.converter.ConvertFuturesOrderData(&event.Data[i])
+			order.Fee, _ = fees[order.ID].Add(order.Fee)
+			switch order.Status {
+			case commontypes.OrderStatusFilled, commontypes.OrderStatusCanceled:
+				delete(fees, order.ID)
+			default:
+				fees[order.ID] = order.Fee
+			}
+			orders = append(orders, order)
+		}
+		a.sendOrderUpdate(orders, event.Group, userCh)
+	}
+}
+
+// sendOrderUpdate forwards orders to userCh unless none are left after filtering
+func (a *WebSocketAdapter) sendOrderUpdate(orders []*commontypes.Order, channel string, userCh chan *commontypes.OrderUpdate) {
+	if len(orders) == 0 {
+		return
+	}
+	update := &commontypes.OrderUpdate{
+		Orders:    orders,
+		UpdatedAt: orders[len(orders)-1].UpdatedAt,
+		Extra: map[string]interface{}{
+			"channel": channel,
+		},
+	}
+
+	// Forward to user channel
+	select {
+	case userCh <- update:
+	default:
+		// Channel full, drop message
+		a.client.Dropped(true, "orders", "")
+	}
+}
+
+// UnsubscribeOrders unsubscribes from order updates of req.InstrumentType
+func (a *WebSocketAdapter) UnsubscribeOrders(req commontypes.WebSocketSubscribeRequest) error {
+	if req.InstrumentType == commontypes.InstrumentSpot {
+		if err := a.client.Private.UnsubscribeOrder(); err != nil {
+			return fmt.Errorf("failed to unsubscribe from spot orders: %w", err)
+		}
+		delete(a.orderChannels, "spot/user/order")
+		return nil
+	}
+
+	if err := a.client.Private.UnsubscribeFuturesOrder(); err != nil {
+		return fmt.Errorf("failed to unsubscribe from futures orders: %w", err)
+	}
+	delete(a.orderChannels, "futures/order")
+	return nil
+}
+
 // SetChannels sets channels for receiving WebSocket events
 // This allows users to receive notifications about connection events, errors, subscriptions, etc.
 func (a *WebSocketAdapter) SetChannels(
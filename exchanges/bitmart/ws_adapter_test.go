@@ -0,0 +1,225 @@
+package bitmart
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	privateevents "github.com/djpken/go-exc/exchanges/bitmart/events/private"
+	publicevents "github.com/djpken/go-exc/exchanges/bitmart/events/public"
+	"github.com/djpken/go-exc/exchanges/bitmart/ws"
+	commontypes "github.com/djpken/go-exc/types"
+)
+
+func TestWebSocketAdapter_ForwardOrderBookEvents(t *testing.T) {
+	client, err := ws.NewClientWs(context.Background(), &ws.BitMartConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	adapter := NewWebSocketAdapter(client)
+
+	depth := func(typ string, version int64, bids, asks []publicevents.DepthLevel) *publicevents.DepthIncreaseEvent {
+		return &publicevents.DepthIncreaseEvent{Data: publicevents.DepthIncreaseData{
+			Symbol: "BTCUSDT", Bids: bids, Asks: asks, Version: version, Type: typ,
+		}}
+	}
+	internalCh := make(chan *publicevents.DepthIncreaseEvent, 10)
+	internalCh <- depth("snapshot", 10,
+		[]publicevents.DepthLevel{{Price: "100", Volume: "1"}, {Price: "99", Volume: "2"}},
+		[]publicevents.DepthLevel{{Price: "101", Volume: "1"}})
+	internalCh <- depth("update", 11,
+		[]publicevents.DepthLevel{{Price: "100", Volume: "0"}},
+		[]publicevents.DepthLevel{{Price: "100.5", Volume: "3"}})
+	internalCh <- depth("update", 13, []publicevents.DepthLevel{{Price: "98", Volume: "1"}}, nil) // gap
+	internalCh <- depth("update", 14, []publicevents.DepthLevel{{Price: "97", Volume: "1"}}, nil)
+	internalCh <- depth("snapshot", 20,
+		[]publicevents.DepthLevel{{Price: "99", Volume: "2"}, {Price: "98", Volume: "5"}},
+		[]publicevents.DepthLevel{{Price: "100.5", Volume: "3"}})
+	close(internalCh)
+
+	userCh := make(chan *commontypes.OrderBookUpdate, 10)
+	adapter.forwardOrderBookEvents("BTCUSDT", 5, 1, internalCh, userCh, make(chan struct{}))
+	close(userCh)
+
+	var updates []*commontypes.OrderBookUpdate
+	for u := range userCh {
+		updates = append(updates, u)
+	}
+	if len(updates) != 3 {
+		t.Fatalf("got %d updates, expected 3 (updates after the gap skipped until the snapshot)", len(updates))
+	}
+
+	u := updates[1]
+	if u.Snapshot || u.Sequence != 11 || len(u.Bids) != 1 || u.Bids[0].Price.String() != "99" ||
+		len(u.Asks) != 1 || u.Asks[0].Price.String() != "100.5" {
+		t.Errorf("update = %+v, expected the top level after the delta", u)
+	}
+	if len(u.Delta.Bids) != 1 || !u.Delta.Bids[0].Quantity.IsZero() {
+		t.Errorf("update delta = %+v, expected the removed bid", u.Delta)
+	}
+
+	u = updates[2]
+	if !u.Snapshot || u.Sequence != 20 || u.Bids[0].Price.String() != "99" {
+		t.Errorf("resync update = %+v", u)
+	}
+	if len(u.Delta.Bids) != 1 || u.Delta.Bids[0].Price.String() != "98" ||
+		len(u.Delta.Asks) != 1 || u.Delta.Asks[0].Price.String() != "101" || !u.Delta.Asks[0].Quantity.IsZero() {
+		t.Errorf("resync delta = %+v, expected the added bid and the removed ask", u.Delta)
+	}
+}
+
+func TestWebSocketAdapter_ForwardOrderBookEventsStop(t *testing.T) {
+	client, err := ws.NewClientWs(context.Background(), &ws.BitMartConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	adapter := NewWebSocketAdapter(client)
+
+	// The internal channel stays open, as it does after an unsubscribe
+	internalCh := make(chan *publicevents.DepthIncreaseEvent)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		adapter.forwardOrderBookEvents("BTCUSDT", 5, 1, internalCh, make(chan *commontypes.OrderBookUpdate, 1), stop)
+		close(done)
+	}()
+
+	close(stop)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("forwardOrderBookEvents did not return after stop was closed")
+	}
+}
+
+func TestWebSocketAdapter_ForwardTradeEventsStop(t *testing.T) {
+	client, err := ws.NewClientWs(context.Background(), &ws.BitMartConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	adapter := NewWebSocketAdapter(client)
+
+	// The internal channel stays open, as it does after an unsubscribe
+	internalCh := make(chan *publicevents.FuturesTradeEvent)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		adapter.forwardTradeEvents("BTCUSDT", internalCh, make(chan *commontypes.TradeUpdate, 1), stop)
+		close(done)
+	}()
+
+	close(stop)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("forwardTradeEvents did not return after stop was closed")
+	}
+}
+
+func TestWebSocketAdapter_ForwardFuturesOrderEvents(t *testing.T) {
+	client, err := ws.NewClientWs(context.Background(), &ws.BitMartConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	adapter := NewWebSocketAdapter(client)
+
+	internalCh := make(chan *privateevents.FuturesOrderEvent, 10)
+	for _, data := range []string{
+		`{"group":"futures/order","data":[{"action":2,"order":{"order_id":"1","symbol":"BTCUSDT","side":3,"type":"limit","price":"25000","size":"10","state":2,"deal_size":"0","create_time":1700000000000,"update_time":1700000000000}}]}`,
+		`{"group":"futures/order","data":[{"action":2,"order":{"order_id":"2","symbol":"ETHUSDT","side":1,"type":"limit","price":"1500","size":"1","state":2,"deal_size":"0"}}]}`,
+		`{"group":"futures/order","data":[{"action":1,"order":{"order_id":"1","symbol":"BTCUSDT","side":3,"type":"limit","price":"25000","size":"10","state":2,"deal_size":"4","update_time":1700000000100,
+			"last_trade":{"lastTradeID":7,"fillQty":"4","fillPrice":"25000","fee":"-0.04","feeCcy":"USDT"}}}]}`,
+		`{"group":"futures/order","data":[{"action":1,"order":{"order_id":"1","symbol":"BTCUSDT","side":3,"type":"limit","price":"25000","size":"10","state":4,"deal_size":"10","update_time":1700000000200,
+			"last_trade":{"lastTradeID":9,"fillQty":"6","fillPrice":"25000.5","fee":"-0.06","feeCcy":"USDT"}}}]}`,
+	} {
+		event := &privateevents.FuturesOrderEvent{}
+		if err := json.Unmarshal([]byte(data), event); err != nil {
+			t.Fatal(err)
+		}
+		internalCh <- event
+	}
+	close(internalCh)
+
+	userCh := make(chan *commontypes.OrderUpdate, 10)
+	adapter.forwardFuturesOrderEvents(map[string]bool{"BTCUSDT": true}, internalCh, userCh)
+	close(userCh)
+
+	var orders []*commontypes.Order
+	for u := range userCh {
+		orders = append(orders, u.Orders...)
+	}
+	if len(orders) != 3 {
+		t.Fatalf("got %d orders, expected 3 (ETHUSDT filtered out)", len(orders))
+	}
+
+	if o := orders[0]; o.Status != commontypes.OrderStatusOpen || !o.LastFillQuantity.IsZero() || !o.ReduceOnly || o.Side != "sell" {
+		t.Errorf("submitted order = %+v", o)
+	}
+	if o := orders[1]; o.Status != commontypes.OrderStatusPartiallyFilled || o.LastFillQuantity.String() != "4" || o.Fee.String() != "0.04" {
+		t.Errorf("partially filled order = %+v", o)
+	}
+	o := orders[2]
+	if o.Status != commontypes.OrderStatusFilled || o.FilledQuantity.String() != "10" || !o.RemainingQuantity.IsZero() {
+		t.Errorf("filled order = %+v", o)
+	}
+	if o.LastFillPrice.String() != "25000.5" || o.LastFillQuantity.String() != "6" {
+		t.Errorf("LastFillPrice/LastFillQuantity = %v/%v, expected 25000.5/6", o.LastFillPrice, o.LastFillQuantity)
+	}
+	if o.Fee.String() != "0.1" || o.FeeCurrency != "USDT" {
+		t.Errorf("Fee = %v %s, expected the cumulative 0.1 USDT", o.Fee, o.FeeCurrency)
+	}
+}
+
+func TestWebSocketAdapter_ForwardTickerEvents(t *testing.T) {
+	client, err := ws.NewClientWs(context.Background(), &ws.BitMartConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	adapter := NewWebSocketAdapter(client)
+
+	tickerCh := make(chan *commontypes.TickerUpdate, 10)
+	markCh := make(chan *commontypes.MarkPriceUpdate, 10)
+	adapter.tickerChannels["BTCUSDT"] = tickerCh
+	adapter.markChannels["BTCUSDT"] = markCh
+	adapter.markChannels["ETHUSDT"] = markCh
+
+	internalCh := make(chan *publicevents.FuturesTickerEvent, 10)
+	for _, data := range []string{
+		`{"group":"futures/ticker:BTCUSDT","data":{"symbol":"BTCUSDT","last_price":"42000.5","mark_price":"42001.2","index_price":"41999.8","bid_price":"42000.4","bid_vol":"10","ask_price":"42000.6","ask_vol":"12"}}`,
+		`{"group":"futures/ticker:ETHUSDT","data":{"symbol":"ETHUSDT","last_price":"2200","mark_price":"2200.3","index_price":"2199.9"}}`,
+	} {
+		ev := &publicevents.FuturesTickerEvent{}
+		if err := json.Unmarshal([]byte(data), ev); err != nil {
+			t.Fatal(err)
+		}
+		internalCh <- ev
+	}
+	close(internalCh)
+
+	adapter.forwardTickerEvents("batch", internalCh)
+	close(tickerCh)
+	close(markCh)
+
+	var tickers []*commontypes.TickerUpdate
+	for u := range tickerCh {
+		tickers = append(tickers, u)
+	}
+	if len(tickers) != 1 || tickers[0].Symbol != "BTCUSDT" || tickers[0].LastPrice.String() != "42000.5" {
+		t.Fatalf("tickers = %+v, expected the BTCUSDT ticker only", tickers)
+	}
+
+	var prices []*commontypes.MarkPriceUpdate
+	for u := range markCh {
+		prices = append(prices, u)
+	}
+	if len(prices) != 2 {
+		t.Fatalf("got %d mark prices, expected 2", len(prices))
+	}
+	if p := prices[0]; p.Symbol != "BTCUSDT" || p.MarkPrice.MarkPrice.String() != "42001.2" || p.IndexPrice.String() != "41999.8" {
+		t.Errorf("mark price = %+v", p.MarkPrice)
+	}
+	if p := prices[1]; p.Symbol != "ETHUSDT" || p.MarkPrice.MarkPrice.String() != "2200.3" {
+		t.Errorf("mark price = %+v", p.MarkPrice)
+	}
+}
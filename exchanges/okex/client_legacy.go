@@ -2,9 +2,13 @@ package okex
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/djpken/go-exc/clock"
 	"github.com/djpken/go-exc/exchanges/okex/rest"
 	"github.com/djpken/go-exc/exchanges/okex/ws"
+	"github.com/djpken/go-exc/options"
 )
 
 // Client is the main api wrapper of okex
@@ -12,10 +16,17 @@ type Client struct {
 	Rest *rest.ClientRest
 	Ws   *ws.ClientWs
 	ctx  context.Context
+	stop context.CancelFunc
 }
 
+// receiveWindow is how far a request timestamp may be from the OKX server time
+const receiveWindow = 30 * time.Second
+
 // NewClient returns a pointer to a fresh Client
-func NewClient(ctx context.Context, apiKey, secretKey, passphrase string, destination Destination) (*Client, error) {
+// opts override the HTTP client, endpoints, dialer, user agent, logger, REST middleware and metrics of the clients.
+// Unless disabled with WithClockSync, the offset to the OKX server clock is measured
+// in the background until ctx is done or Close is called.
+func NewClient(ctx context.Context, apiKey, secretKey, passphrase string, destination Destination, opts ...options.Option) (*Client, error) {
 	restURL := RestURL
 	wsPubURL := PublicWsURL
 	wsPriURL := PrivateWsURL
@@ -30,8 +41,71 @@ func NewClient(ctx context.Context, apiKey, secretKey, passphrase string, destin
 		wsPriURL = DemoPrivateWsURL
 	}
 
+	o := options.NewClientOptions(opts...)
+	if o.BaseURL != "" {
+		restURL = BaseURL(o.BaseURL)
+	}
+	if o.WSURL != "" {
+		wsPubURL = BaseURL(o.WSURL + "/public")
+		wsPriURL = BaseURL(o.WSURL + "/private")
+	}
+
 	r := rest.NewClient(apiKey, secretKey, passphrase, restURL, destination)
 	c := ws.NewClient(ctx, apiKey, secretKey, passphrase, map[bool]BaseURL{true: wsPriURL, false: wsPubURL})
+	if o.HTTPClient != nil {
+		r.SetHTTPClient(o.HTTPClient)
+	}
+	if o.RateLimiter != nil {
+		r.SetRateLimiter(o.RateLimiter)
+	}
+	if o.RetryPolicy != nil {
+		r.SetRetryPolicy(*o.RetryPolicy)
+	}
+	if o.Dialer != nil {
+		c.SetDialer(o.Dialer)
+	}
+	if o.UserAgent != "" {
+		r.SetUserAgent(o.UserAgent)
+		c.SetUserAgent(o.UserAgent)
+	}
+	r.SetMiddleware(o.RESTMiddleware()...)
+	r.SetMetrics(o.Metrics)
+	c.SetMetrics(o.Metrics)
+	logger := o.LoggerFor("okx")
+	r.SetLogger(logger)
+	c.SetLogger(logger)
+
+	stop := func() {}
+	if interval := o.ClockSync(); interval > 0 {
+		clk := clock.New(serverTime(r), receiveWindow)
+		r.SetClock(clk)
+		c.SetClock(clk)
+		var clockCtx context.Context
+		clockCtx, stop = context.WithCancel(ctx)
+		go clk.Run(clockCtx, interval)
+	}
+
+	return &Client{r, c, ctx, stop}, nil
+}
+
+// Close stops the server clock synchronization
+func (c *Client) Close() {
+	c.stop()
+}
 
-	return &Client{r, c, ctx}, nil
+// serverTime returns a clock.ServerTimeFunc reading the OKX system time
+func serverTime(r *rest.ClientRest) clock.ServerTimeFunc {
+	return func(ctx context.Context) (time.Time, error) {
+		resp, err := r.PublicData.GetSystemTime(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if err := checkAPIError(resp.Basic); err != nil {
+			return time.Time{}, err
+		}
+		if len(resp.SystemTimes) == 0 {
+			return time.Time{}, fmt.Errorf("okex: no system time returned")
+		}
+		return time.Time(resp.SystemTimes[0].TS), nil
+	}
 }
@@ -53,6 +53,7 @@ type (
 
 	JSONFloat64 float64
 	JSONInt64   int64
+	JSONBool    bool
 	JSONTime    time.Time
 
 	ClientError error
@@ -217,6 +218,7 @@ const (
 	AlgoOrderTrigger     = AlgoOrderType("trigger")
 	AlgoOrderIceberg     = AlgoOrderType("iceberg")
 	AlgoOrderTwap        = AlgoOrderType("twap")
+	AlgoOrderMoveStop    = AlgoOrderType("move_order_stop")
 
 	QuantityBaseCcy  = QuantityType("base_ccy")
 	QuantityQuoteCcy = QuantityType("quote_ccy")
@@ -235,6 +237,11 @@ const (
 	OrderPartiallyFilled = OrderState("partially_filled")
 	OrderFilled          = OrderState("filled")
 	OrderUnfilled        = OrderState("unfilled")
+	OrderMMPCanceled     = OrderState("mmp_canceled")
+
+	AlgoOrderEffective          = OrderState("effective")
+	AlgoOrderPartiallyEffective = OrderState("partially_effective")
+	AlgoOrderFailed             = OrderState("order_failed")
 
 	TransferWithinAccount     = TransferType(0)
 	MasterAccountToSubAccount = TransferType(1)
@@ -349,6 +356,19 @@ func (t *JSONInt64) UnmarshalJSON(s []byte) (err error) {
 	*(*int64)(t) = q
 	return
 }
+func (t *JSONBool) UnmarshalJSON(s []byte) (err error) {
+	r := strings.Replace(string(s), `"`, ``, -1)
+	if r == "" {
+		return
+	}
+
+	q, err := strconv.ParseBool(r)
+	if err != nil {
+		return err
+	}
+	*(*bool)(t) = q
+	return
+}
 func (t *WithdrawalState) UnmarshalJSON(s []byte) (err error) {
 	r := strings.Replace(string(s), `"`, ``, -1)
 	if r == "" {
@@ -1,6 +1,9 @@
 package okex
 
 import (
+	"fmt"
+	"strings"
+
 	okexconstants "github.com/djpken/go-exc/exchanges/okex/constants"
 	commontypes "github.com/djpken/go-exc/types"
 )
@@ -85,6 +88,36 @@ func (c *ConstantsConverter) FromOKExOrderType(orderType okexconstants.OrderType
 	}
 }
 
+// ToOKExOrdType 將訂單類型與 TimeInForce 合併為 OKEx ordType
+// OKEx 沒有獨立的 timeInForce 參數，IOC/FOK/Post-only 透過 ordType 表示。
+// 無法表示的組合（例如市價 FOK）回傳 ErrNotSupported。
+func (c *ConstantsConverter) ToOKExOrdType(orderType commontypes.OrderType, tif commontypes.TimeInForce) (okexconstants.OrderType, error) {
+	ordType := c.ToOKExOrderType(orderType)
+	if tif == "" || tif == commontypes.TimeInForceGTC {
+		return ordType, nil
+	}
+
+	var native okexconstants.OrderType
+	switch tif {
+	case commontypes.TimeInForceIOC:
+		native = okexconstants.OrderIOC
+	case commontypes.TimeInForceFOK:
+		native = okexconstants.OrderFOK
+	case commontypes.TimeInForceGTX:
+		native = okexconstants.OrderPostOnly
+	}
+
+	switch {
+	case ordType == okexconstants.OrderLimit, ordType == native:
+		return native, nil
+	case ordType == okexconstants.OrderMarket && tif == commontypes.TimeInForceIOC:
+		// 市價單本身即為 IOC
+		return ordType, nil
+	default:
+		return "", fmt.Errorf("okex: %s order with time in force %s: %w", ordType, tif, commontypes.ErrNotSupported)
+	}
+}
+
 // ===========================================
 // OrderStatus 轉換
 // ===========================================
@@ -108,7 +141,7 @@ func (c *ConstantsConverter) ToOKExOrderStatus(status commontypes.OrderStatus) o
 // FromOKExOrderStatus 將 OKEx OrderState 轉換為共用 OrderStatus
 func (c *ConstantsConverter) FromOKExOrderStatus(state okexconstants.OrderState) commontypes.OrderStatus {
 	switch state {
-	case okexconstants.OrderCancel:
+	case okexconstants.OrderCancel, okexconstants.OrderMMPCanceled:
 		return commontypes.OrderStatusCanceled
 	case okexconstants.OrderLive:
 		return commontypes.OrderStatusLive
@@ -123,6 +156,54 @@ func (c *ConstantsConverter) FromOKExOrderStatus(state okexconstants.OrderState)
 	}
 }
 
+// FromOKExAlgoOrderStatus 將 OKEx 策略委託單狀態轉換為共用 OrderStatus
+func (c *ConstantsConverter) FromOKExAlgoOrderStatus(state okexconstants.OrderState) commontypes.OrderStatus {
+	switch state {
+	case okexconstants.AlgoOrderEffective, okexconstants.AlgoOrderPartiallyEffective:
+		return commontypes.OrderStatusTriggered
+	case okexconstants.AlgoOrderFailed:
+		return commontypes.OrderStatusRejected
+	default:
+		return c.FromOKExOrderStatus(state)
+	}
+}
+
+// ===========================================
+// AlgoOrderType 轉換
+// ===========================================
+
+// ToOKExAlgoOrderType 將共用 ConditionalOrderType 轉換為 OKEx AlgoOrderType
+// 止盈止損同時設定時使用 oco，否則使用 conditional
+func (c *ConstantsConverter) ToOKExAlgoOrderType(orderType commontypes.ConditionalOrderType, hasTP, hasSL bool) okexconstants.AlgoOrderType {
+	switch orderType {
+	case commontypes.ConditionalOrderTypeTrigger:
+		return okexconstants.AlgoOrderTrigger
+	case commontypes.ConditionalOrderTypeTrailing:
+		return okexconstants.AlgoOrderMoveStop
+	case commontypes.ConditionalOrderTypeTPSL:
+		if hasTP && hasSL {
+			return okexconstants.AlgoOrderOCO
+		}
+		return okexconstants.AlgoOrderConditional
+	default:
+		return okexconstants.AlgoOrderType(orderType)
+	}
+}
+
+// FromOKExAlgoOrderType 將 OKEx AlgoOrderType 轉換為共用 ConditionalOrderType
+func (c *ConstantsConverter) FromOKExAlgoOrderType(orderType okexconstants.AlgoOrderType) commontypes.ConditionalOrderType {
+	switch orderType {
+	case okexconstants.AlgoOrderTrigger:
+		return commontypes.ConditionalOrderTypeTrigger
+	case okexconstants.AlgoOrderMoveStop:
+		return commontypes.ConditionalOrderTypeTrailing
+	case okexconstants.AlgoOrderConditional, okexconstants.AlgoOrderOCO:
+		return commontypes.ConditionalOrderTypeTPSL
+	default:
+		return commontypes.ConditionalOrderType(orderType)
+	}
+}
+
 // ===========================================
 // PositionSide 轉換
 // ===========================================
@@ -224,3 +305,29 @@ func (c *ConstantsConverter) FromOKExInstrumentType(instType okexconstants.Instr
 		return commontypes.InstrumentType(instType)
 	}
 }
+
+// InstrumentTypeFromInstID 依 OKEx instId 格式推斷 InstrumentType
+// BTC-USDT → SPOT, BTC-USDT-SWAP → SWAP, BTC-USD-240329 → FUTURES, BTC-USD-240329-60000-C → OPTION
+func (c *ConstantsConverter) InstrumentTypeFromInstID(instID string) okexconstants.InstrumentType {
+	parts := strings.Split(instID, "-")
+	switch {
+	case len(parts) == 3 && parts[2] == "SWAP":
+		return okexconstants.SwapInstrument
+	case len(parts) == 3:
+		return okexconstants.FuturesInstrument
+	case len(parts) == 5:
+		return okexconstants.OptionsInstrument
+	default:
+		return okexconstants.SpotInstrument
+	}
+}
+
+// IndexInstID 依 OKEx instId 取得其標的指數的 instId
+// BTC-USDT-SWAP → BTC-USDT, BTC-USD-240329 → BTC-USD, BTC-USDT → BTC-USDT
+func (c *ConstantsConverter) IndexInstID(instID string) string {
+	parts := strings.SplitN(instID, "-", 3)
+	if len(parts) < 2 {
+		return instID
+	}
+	return parts[0] + "-" + parts[1]
+}
@@ -39,6 +39,7 @@ func (c *Converter) stringToDecimal(s string) commontypes.Decimal {
 }
 
 // ConvertOrder converts OKEx order to common Order type
+// OKEx reports fees as negative amounts; the sign is flipped so Fee is the amount paid.
 func (c *Converter) ConvertOrder(okexOrder *trade.Order) *commontypes.Order {
 	if okexOrder == nil {
 		return nil
@@ -47,18 +48,22 @@ func (c *Converter) ConvertOrder(okexOrder *trade.Order) *commontypes.Order {
 	price := float64(okexOrder.AvgPx)
 	quantity := float64(okexOrder.Sz)
 	filledQty := float64(okexOrder.AccFillSz)
-	fee := float64(okexOrder.Fee)
+	fee := -float64(okexOrder.Fee)
 
 	return &commontypes.Order{
 		ID:                okexOrder.OrdID,
+		ClientOrderID:     okexOrder.ClOrdID,
 		Symbol:            okexOrder.InstID,
 		Side:              string(okexOrder.Side),
 		Type:              string(okexOrder.OrdType),
-		Status:            commontypes.OrderStatus(okexOrder.State),
+		Status:            c.constantsConverter.FromOKExOrderStatus(okexOrder.State),
 		Price:             c.stringToDecimal(strconv.FormatFloat(price, 'f', -1, 64)),
 		Quantity:          c.stringToDecimal(strconv.FormatFloat(quantity, 'f', -1, 64)),
 		FilledQuantity:    c.stringToDecimal(strconv.FormatFloat(filledQty, 'f', -1, 64)),
 		RemainingQuantity: c.stringToDecimal(strconv.FormatFloat(quantity-filledQty, 'f', -1, 64)),
+		LastFillPrice:     commontypes.NewDecimalFromFloat(float64(okexOrder.FillPx)),
+		LastFillQuantity:  commontypes.NewDecimalFromFloat(float64(okexOrder.FillSz)),
+		ReduceOnly:        bool(okexOrder.ReduceOnly),
 		Fee:               c.stringToDecimal(strconv.FormatFloat(fee, 'f', -1, 64)),
 		FeeCurrency:       okexOrder.FeeCcy,
 		CreatedAt:         commontypes.Timestamp(okexOrder.CTime),
@@ -72,6 +77,161 @@ func (c *Converter) ConvertOrder(okexOrder *trade.Order) *commontypes.Order {
 	}
 }
 
+// ConvertFill converts OKEx transaction detail to common Trade type
+// OKEx reports fees as negative amounts; the sign is flipped so Fee is the amount paid.
+func (c *Converter) ConvertFill(okexFill *trade.TransactionDetail) *commontypes.Trade {
+	if okexFill == nil {
+		return nil
+	}
+
+	return &commontypes.Trade{
+		ID:          okexFill.TradeID,
+		OrderID:     okexFill.OrdID,
+		Symbol:      okexFill.InstID,
+		Side:        string(okexFill.Side),
+		Price:       commontypes.NewDecimalFromFloat(float64(okexFill.FillPx)),
+		Quantity:    commontypes.NewDecimalFromFloat(float64(okexFill.FillSz)),
+		Fee:         commontypes.NewDecimalFromFloat(-float64(okexFill.Fee)),
+		FeeCurrency: okexFill.FeeCcy,
+		IsMaker:     okexFill.ExecType == okexconstants.OrderMakerFlow,
+		Timestamp:   commontypes.Timestamp(okexFill.TS),
+		Extra: map[string]interface{}{
+			"billId":   okexFill.BillID,
+			"clOrdID":  okexFill.ClOrdID,
+			"tag":      okexFill.Tag,
+			"instType": okexFill.InstType,
+			"posSide":  okexFill.PosSide,
+		},
+	}
+}
+
+// ConvertPublicTrade converts an OKEx public trade to common Trade type,
+// Side being the taker side
+func (c *Converter) ConvertPublicTrade(okexTrade *market.Trade) *commontypes.Trade {
+	if okexTrade == nil {
+		return nil
+	}
+
+	return &commontypes.Trade{
+		ID:        strconv.FormatFloat(float64(okexTrade.TradeID), 'f', -1, 64),
+		Symbol:    okexTrade.InstID,
+		Side:      string(okexTrade.Side),
+		Price:     commontypes.NewDecimalFromFloat(float64(okexTrade.Px)),
+		Quantity:  commontypes.NewDecimalFromFloat(float64(okexTrade.Sz)),
+		Timestamp: commontypes.Timestamp(okexTrade.TS),
+		Extra:     map[string]interface{}{},
+	}
+}
+
+// ConvertFundingRate converts OKEx funding rate to common FundingRate type
+func (c *Converter) ConvertFundingRate(okexRate *publicdata.FundingRate) *commontypes.FundingRate {
+	if okexRate == nil {
+		return nil
+	}
+
+	return &commontypes.FundingRate{
+		Symbol:          okexRate.InstID,
+		FundingRate:     commontypes.NewDecimalFromFloat(float64(okexRate.FundingRate)),
+		FundingTime:     commontypes.Timestamp(okexRate.FundingTime),
+		NextFundingRate: commontypes.NewDecimalFromFloat(float64(okexRate.NextFundingRate)),
+		NextFundingTime: commontypes.Timestamp(okexRate.NextFundingTime),
+		Timestamp:       commontypes.Timestamp(okexRate.TS),
+		Extra:           map[string]interface{}{},
+	}
+}
+
+// ConvertFundingRateHistory converts a settled OKEx funding rate to common FundingRate type
+// FundingRate is the realized rate, the rate published before settlement being kept in Extra.
+func (c *Converter) ConvertFundingRateHistory(okexRate *publicdata.FundingRateHistory) *commontypes.FundingRate {
+	if okexRate == nil {
+		return nil
+	}
+
+	return &commontypes.FundingRate{
+		Symbol:      okexRate.InstID,
+		FundingRate: commontypes.NewDecimalFromFloat(float64(okexRate.RealizedRate)),
+		FundingTime: commontypes.Timestamp(okexRate.FundingTime),
+		Timestamp:   commontypes.Timestamp(okexRate.FundingTime),
+		Extra: map[string]interface{}{
+			"fundingRate": float64(okexRate.FundingRate),
+			"method":      okexRate.Method,
+		},
+	}
+}
+
+// ConvertMarkPrice converts OKEx mark price and the index price of its underlying
+// to common MarkPrice type
+func (c *Converter) ConvertMarkPrice(okexPrice *publicdata.MarkPrice, indexPrice float64) *commontypes.MarkPrice {
+	if okexPrice == nil {
+		return nil
+	}
+
+	return &commontypes.MarkPrice{
+		Symbol:     okexPrice.InstID,
+		MarkPrice:  commontypes.NewDecimalFromFloat(float64(okexPrice.MarkPx)),
+		IndexPrice: commontypes.NewDecimalFromFloat(indexPrice),
+		Timestamp:  commontypes.Timestamp(okexPrice.TS),
+		Extra:      map[string]interface{}{},
+	}
+}
+
+// ConvertAlgoOrder converts OKEx algo order to common ConditionalOrder type
+// OKEx uses -1 as order price for market execution; it is reported as zero.
+func (c *Converter) ConvertAlgoOrder(okexAlgo *trade.AlgoOrder) *commontypes.ConditionalOrder {
+	if okexAlgo == nil {
+		return nil
+	}
+
+	orderPx := float64(okexAlgo.OrdPx)
+	if orderPx == 0 {
+		orderPx = float64(okexAlgo.OrderPx)
+	}
+	triggerPxType := okexAlgo.TriggerPxType
+	if triggerPxType == "" {
+		triggerPxType = okexAlgo.TpTriggerPxType
+	}
+	if triggerPxType == "" {
+		triggerPxType = okexAlgo.SlTriggerPxType
+	}
+
+	return &commontypes.ConditionalOrder{
+		ID:                     okexAlgo.AlgoID,
+		ClientOrderID:          okexAlgo.AlgoClOrdID,
+		Symbol:                 okexAlgo.InstID,
+		Type:                   c.constantsConverter.FromOKExAlgoOrderType(okexAlgo.OrdType),
+		Side:                   string(okexAlgo.Side),
+		PosSide:                c.convertPositionSide(okexAlgo.PosSide),
+		Status:                 c.constantsConverter.FromOKExAlgoOrderStatus(okexAlgo.State),
+		Quantity:               commontypes.NewDecimalFromFloat(float64(okexAlgo.Sz)),
+		TriggerPrice:           commontypes.NewDecimalFromFloat(float64(okexAlgo.TriggerPx)),
+		TriggerPriceType:       commontypes.TriggerPriceType(triggerPxType),
+		OrderPrice:             c.algoOrderPrice(orderPx),
+		TakeProfitTriggerPrice: commontypes.NewDecimalFromFloat(float64(okexAlgo.TpTriggerPx)),
+		TakeProfitPrice:        c.algoOrderPrice(float64(okexAlgo.TpOrdPx)),
+		StopLossTriggerPrice:   commontypes.NewDecimalFromFloat(float64(okexAlgo.SlTriggerPx)),
+		StopLossPrice:          c.algoOrderPrice(float64(okexAlgo.SlOrdPx)),
+		CallbackRate:           commontypes.NewDecimalFromFloat(float64(okexAlgo.CallbackRatio)),
+		ActivationPrice:        commontypes.NewDecimalFromFloat(float64(okexAlgo.ActivePx)),
+		CreatedAt:              commontypes.Timestamp(okexAlgo.CTime),
+		Extra: map[string]interface{}{
+			"ordType":        okexAlgo.OrdType,
+			"ordId":          okexAlgo.OrdID,
+			"instType":       okexAlgo.InstType,
+			"tdMode":         okexAlgo.TdMode,
+			"callbackSpread": float64(okexAlgo.CallbackSpread),
+			"triggerTime":    okexAlgo.TriggerTime,
+		},
+	}
+}
+
+// algoOrderPrice converts an OKEx algo order price, mapping -1 (market) to zero
+func (c *Converter) algoOrderPrice(px float64) commontypes.Decimal {
+	if px < 0 {
+		return commontypes.ZeroDecimal
+	}
+	return commontypes.NewDecimalFromFloat(px)
+}
+
 // ConvertBalance converts OKEx balance to common AccountBalance type
 func (c *Converter) ConvertBalance(okexBalance *account.Balance) *commontypes.AccountBalance {
 	if okexBalance == nil {
@@ -427,6 +587,21 @@ func (c *Converter) ConvertOrderBook(okexOB *market.OrderBook, symbol string) *c
 	}
 }
 
+// ConvertOrderBookLevels converts OKEx order book entries to common order book levels
+func (c *Converter) ConvertOrderBookLevels(entries []*market.OrderBookEntity) []commontypes.OrderBookLevel {
+	levels := make([]commontypes.OrderBookLevel, 0, len(entries))
+	for _, e := range entries {
+		if e == nil {
+			continue
+		}
+		levels = append(levels, commontypes.OrderBookLevel{
+			Price:    c.stringToDecimal(strconv.FormatFloat(e.DepthPrice, 'f', -1, 64)),
+			Quantity: c.stringToDecimal(strconv.FormatFloat(e.Size, 'f', -1, 64)),
+		})
+	}
+	return levels
+}
+
 // ConvertTicker converts OKEx ticker to common Ticker type
 func (c *Converter) ConvertTicker(okexTicker *market.Ticker) *commontypes.Ticker {
 	if okexTicker == nil {
@@ -1,9 +1,13 @@
 package okex
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 
 	okexconstants "github.com/djpken/go-exc/exchanges/okex/constants"
+	"github.com/djpken/go-exc/exchanges/okex/models/trade"
+	"github.com/djpken/go-exc/exchanges/okex/responses"
 	commontypes "github.com/djpken/go-exc/types"
 )
 
@@ -151,3 +155,283 @@ func TestConverter_ConvertOrderType(t *testing.T) {
 		})
 	}
 }
+
+func TestConstantsConverter_InstrumentTypeFromInstID(t *testing.T) {
+	converter := NewConstantsConverter()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected okexconstants.InstrumentType
+	}{
+		{
+			name:     "spot",
+			input:    "BTC-USDT",
+			expected: okexconstants.SpotInstrument,
+		},
+		{
+			name:     "swap",
+			input:    "BTC-USDT-SWAP",
+			expected: okexconstants.SwapInstrument,
+		},
+		{
+			name:     "futures",
+			input:    "BTC-USD-240329",
+			expected: okexconstants.FuturesInstrument,
+		},
+		{
+			name:     "option",
+			input:    "BTC-USD-240329-60000-C",
+			expected: okexconstants.OptionsInstrument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := converter.InstrumentTypeFromInstID(tt.input)
+			if result != tt.expected {
+				t.Errorf("InstrumentTypeFromInstID(%v) = %v, expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConstantsConverter_ToOKExOrdType(t *testing.T) {
+	converter := NewConstantsConverter()
+
+	tests := []struct {
+		name      string
+		orderType commontypes.OrderType
+		tif       commontypes.TimeInForce
+		expected  okexconstants.OrderType
+		wantErr   bool
+	}{
+		{"limit gtc", commontypes.OrderTypeLimit, commontypes.TimeInForceGTC, okexconstants.OrderLimit, false},
+		{"limit unset", commontypes.OrderTypeLimit, "", okexconstants.OrderLimit, false},
+		{"limit ioc", commontypes.OrderTypeLimit, commontypes.TimeInForceIOC, okexconstants.OrderIOC, false},
+		{"limit fok", commontypes.OrderTypeLimit, commontypes.TimeInForceFOK, okexconstants.OrderFOK, false},
+		{"limit gtx", commontypes.OrderTypeLimit, commontypes.TimeInForceGTX, okexconstants.OrderPostOnly, false},
+		{"post_only gtx", commontypes.OrderTypePostOnly, commontypes.TimeInForceGTX, okexconstants.OrderPostOnly, false},
+		{"market ioc", commontypes.OrderTypeMarket, commontypes.TimeInForceIOC, okexconstants.OrderMarket, false},
+		{"market fok", commontypes.OrderTypeMarket, commontypes.TimeInForceFOK, "", true},
+		{"ioc fok", commontypes.OrderTypeIOC, commontypes.TimeInForceFOK, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := converter.ToOKExOrdType(tt.orderType, tt.tif)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToOKExOrdType(%v, %v) error = %v, wantErr %v", tt.orderType, tt.tif, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, commontypes.ErrNotSupported) {
+				t.Errorf("ToOKExOrdType(%v, %v) error = %v, expected ErrNotSupported", tt.orderType, tt.tif, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToOKExOrdType(%v, %v) = %v, expected %v", tt.orderType, tt.tif, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConverter_ConvertFill(t *testing.T) {
+	converter := NewConverter()
+
+	tests := []struct {
+		name        string
+		input       *trade.TransactionDetail
+		expectedFee string
+		expectMaker bool
+	}{
+		{
+			name: "taker fee",
+			input: &trade.TransactionDetail{
+				TradeID:  "1",
+				OrdID:    "100",
+				FillPx:   okexconstants.JSONFloat64(50000),
+				FillSz:   okexconstants.JSONFloat64(0.01),
+				Fee:      okexconstants.JSONFloat64(-0.25),
+				ExecType: okexconstants.OrderTakerFlow,
+			},
+			expectedFee: "0.25",
+			expectMaker: false,
+		},
+		{
+			name: "maker rebate",
+			input: &trade.TransactionDetail{
+				TradeID:  "2",
+				OrdID:    "101",
+				FillPx:   okexconstants.JSONFloat64(50000),
+				FillSz:   okexconstants.JSONFloat64(0.01),
+				Fee:      okexconstants.JSONFloat64(0.05),
+				ExecType: okexconstants.OrderMakerFlow,
+			},
+			expectedFee: "-0.05",
+			expectMaker: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := converter.ConvertFill(tt.input)
+			if result.Fee.String() != tt.expectedFee {
+				t.Errorf("ConvertFill().Fee = %v, expected %v", result.Fee, tt.expectedFee)
+			}
+			if result.IsMaker != tt.expectMaker {
+				t.Errorf("ConvertFill().IsMaker = %v, expected %v", result.IsMaker, tt.expectMaker)
+			}
+			if result.ID != tt.input.TradeID || result.OrderID != tt.input.OrdID {
+				t.Errorf("ConvertFill() IDs = %v/%v, expected %v/%v", result.ID, result.OrderID, tt.input.TradeID, tt.input.OrdID)
+			}
+		})
+	}
+}
+
+func TestConverter_ConvertOrderEvent(t *testing.T) {
+	var orders []*trade.Order
+	if err := json.Unmarshal([]byte(`[{
+		"instId":"BTC-USDT-SWAP","ordId":"312269865356374016","clOrdId":"b1","side":"sell","ordType":"limit",
+		"state":"partially_filled","px":"42000","sz":"3","accFillSz":"2","avgPx":"42000.5","fillPx":"42001",
+		"fillSz":"1","fee":"-0.84","feeCcy":"USDT","reduceOnly":"true","uTime":"1700000000100","cTime":"1700000000000"
+	}]`), &orders); err != nil {
+		t.Fatal(err)
+	}
+
+	update := NewConverter().ConvertOrderEvent(orders)
+	if update == nil || len(update.Orders) != 1 {
+		t.Fatalf("ConvertOrderEvent() = %+v, expected one order", update)
+	}
+	o := update.Orders[0]
+	if o.Status != commontypes.OrderStatusPartiallyFilled {
+		t.Errorf("Status = %v, expected %v", o.Status, commontypes.OrderStatusPartiallyFilled)
+	}
+	if o.FilledQuantity.String() != "2" || o.RemainingQuantity.String() != "1" {
+		t.Errorf("FilledQuantity/RemainingQuantity = %v/%v, expected 2/1", o.FilledQuantity, o.RemainingQuantity)
+	}
+	if o.LastFillPrice.String() != "42001" || o.LastFillQuantity.String() != "1" {
+		t.Errorf("LastFillPrice/LastFillQuantity = %v/%v, expected 42001/1", o.LastFillPrice, o.LastFillQuantity)
+	}
+	if o.Fee.String() != "0.84" || !o.ReduceOnly {
+		t.Errorf("Fee = %v, ReduceOnly = %v, expected 0.84, true", o.Fee, o.ReduceOnly)
+	}
+
+	// REST orders carry the same sign
+	if fee := NewConverter().ConvertOrder(orders[0]).Fee.String(); fee != "0.84" {
+		t.Errorf("ConvertOrder().Fee = %v, expected 0.84", fee)
+	}
+}
+
+func TestConverter_ConvertAlgoOrder(t *testing.T) {
+	converter := NewConverter()
+
+	tests := []struct {
+		name           string
+		input          *trade.AlgoOrder
+		expectedType   commontypes.ConditionalOrderType
+		expectedStatus commontypes.OrderStatus
+		expectedSlPx   string
+	}{
+		{
+			name: "oco with market stop-loss",
+			input: &trade.AlgoOrder{
+				AlgoID:      "1",
+				OrdType:     okexconstants.AlgoOrderOCO,
+				State:       okexconstants.OrderLive,
+				TpTriggerPx: okexconstants.JSONFloat64(60000),
+				TpOrdPx:     okexconstants.JSONFloat64(60000),
+				SlTriggerPx: okexconstants.JSONFloat64(40000),
+				SlOrdPx:     okexconstants.JSONFloat64(-1),
+			},
+			expectedType:   commontypes.ConditionalOrderTypeTPSL,
+			expectedStatus: commontypes.OrderStatusLive,
+			expectedSlPx:   "0",
+		},
+		{
+			name: "triggered trailing stop",
+			input: &trade.AlgoOrder{
+				AlgoID:        "2",
+				OrdType:       okexconstants.AlgoOrderMoveStop,
+				State:         okexconstants.AlgoOrderEffective,
+				CallbackRatio: okexconstants.JSONFloat64(0.02),
+			},
+			expectedType:   commontypes.ConditionalOrderTypeTrailing,
+			expectedStatus: commontypes.OrderStatusTriggered,
+			expectedSlPx:   "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := converter.ConvertAlgoOrder(tt.input)
+			if result.Type != tt.expectedType {
+				t.Errorf("ConvertAlgoOrder().Type = %v, expected %v", result.Type, tt.expectedType)
+			}
+			if result.Status != tt.expectedStatus {
+				t.Errorf("ConvertAlgoOrder().Status = %v, expected %v", result.Status, tt.expectedStatus)
+			}
+			if result.StopLossPrice.String() != tt.expectedSlPx {
+				t.Errorf("ConvertAlgoOrder().StopLossPrice = %v, expected %v", result.StopLossPrice, tt.expectedSlPx)
+			}
+			if result.ID != tt.input.AlgoID {
+				t.Errorf("ConvertAlgoOrder().ID = %v, expected %v", result.ID, tt.input.AlgoID)
+			}
+		})
+	}
+}
+
+func TestSymbolMapper(t *testing.T) {
+	mapper := NewSymbolMapper()
+
+	tests := []struct {
+		canonical string
+		native    string
+		instType  commontypes.InstrumentType
+	}{
+		{"BTC/USDT", "BTC-USDT", commontypes.InstrumentSpot},
+		{"BTC/USDT:USDT", "BTC-USDT-SWAP", commontypes.InstrumentSwap},
+		{"BTC/USD:BTC", "BTC-USD-SWAP", commontypes.InstrumentSwap},
+		{"BTC/USD:BTC-250328", "BTC-USD-250328", commontypes.InstrumentFutures},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.canonical, func(t *testing.T) {
+			sym, err := commontypes.ParseSymbol(tt.canonical)
+			if err != nil {
+				t.Fatalf("ParseSymbol(%q) error = %v", tt.canonical, err)
+			}
+			native, err := mapper.ToNative(sym)
+			if err != nil || native != tt.native {
+				t.Errorf("ToNative(%s) = %q, %v, expected %q", tt.canonical, native, err, tt.native)
+			}
+			back, err := mapper.FromNative(tt.native, tt.instType)
+			if err != nil || back != sym {
+				t.Errorf("FromNative(%q) = %+v, %v, expected %+v", tt.native, back, err, sym)
+			}
+		})
+	}
+
+	if _, err := mapper.ToNative(commontypes.Symbol{Base: "BTC", Quote: "USDT", Settle: "BTC", InstType: commontypes.InstrumentSwap}); !errors.Is(err, commontypes.ErrNotSupported) {
+		t.Errorf("ToNative(BTC/USDT:BTC) error = %v, expected ErrNotSupported", err)
+	}
+	if _, err := mapper.FromNative("BTC-USD-SWAP-X", ""); err == nil {
+		t.Error("FromNative(BTC-USD-SWAP-X) expected error")
+	}
+}
+
+func TestCheckAPIError(t *testing.T) {
+	if err := checkAPIError(responses.Basic{Code: 0}); err != nil {
+		t.Fatalf("checkAPIError(0) = %v, expected nil", err)
+	}
+
+	err := checkAPIError(responses.Basic{Code: 51008, Msg: "Order failed. Insufficient balance"})
+	if !errors.Is(err, commontypes.ErrInsufficientBalance) {
+		t.Errorf("checkAPIError(51008) = %v, expected ErrInsufficientBalance", err)
+	}
+	var apiErr *commontypes.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 51008 || apiErr.Retryable {
+		t.Errorf("checkAPIError(51008) = %#v, expected non-retryable APIError with code 51008", err)
+	}
+
+	if err := checkAPIError(responses.Basic{Code: 50011}); !commontypes.IsRetryable(err) || !errors.Is(err, commontypes.ErrRateLimitExceeded) {
+		t.Errorf("checkAPIError(50011) = %v, expected retryable ErrRateLimitExceeded", err)
+	}
+}
@@ -0,0 +1,56 @@
+package okex
+
+import (
+	commontypes "github.com/djpken/go-exc/types"
+)
+
+// errorCodes maps OKX error codes (code and sCode) onto the common errors
+//
+// https://www.okx.com/docs-v5/en/#error-code
+var errorCodes = map[int]commontypes.ErrorCode{
+	// Service unavailable
+	50001: {Err: commontypes.ErrExchangeUnavailable, Retryable: true}, // Service temporarily unavailable
+	50004: {Err: commontypes.ErrExchangeUnavailable, Retryable: true}, // API endpoint request timeout
+	50013: {Err: commontypes.ErrExchangeUnavailable, Retryable: true}, // System is busy
+	50026: {Err: commontypes.ErrExchangeUnavailable, Retryable: true}, // System error
+	51054: {Err: commontypes.ErrExchangeUnavailable, Retryable: true}, // Request timed out
+
+	// Rate limits
+	50011: {Err: commontypes.ErrRateLimitExceeded, Retryable: true}, // Rate limit reached
+	50061: {Err: commontypes.ErrRateLimitExceeded, Retryable: true}, // Sub-account rate limit reached
+
+	// Authentication
+	50100: {Err: commontypes.ErrAuthentication}, // API frozen
+	50101: {Err: commontypes.ErrAuthentication}, // APIKey does not match current environment
+	50102: {Err: commontypes.ErrAuthentication}, // Timestamp request expired
+	50103: {Err: commontypes.ErrAuthentication}, // OK-ACCESS-KEY header required
+	50104: {Err: commontypes.ErrAuthentication}, // OK-ACCESS-PASSPHRASE header required
+	50105: {Err: commontypes.ErrAuthentication}, // Incorrect OK-ACCESS-PASSPHRASE
+	50111: {Err: commontypes.ErrAuthentication}, // Invalid OK-ACCESS-KEY
+	50113: {Err: commontypes.ErrAuthentication}, // Invalid sign
+	50119: {Err: commontypes.ErrAuthentication}, // API key doesn't exist
+
+	// Instruments
+	51001: {Err: commontypes.ErrInvalidSymbol}, // Instrument ID does not exist
+
+	// Orders
+	51006: {Err: commontypes.ErrInvalidOrder},  // Order price is not within the price limit
+	51016: {Err: commontypes.ErrInvalidOrder},  // Duplicated clOrdId
+	51020: {Err: commontypes.ErrInvalidOrder},  // Order amount below the minimum
+	51121: {Err: commontypes.ErrInvalidOrder},  // Order quantity is not a multiple of the lot size
+	51400: {Err: commontypes.ErrOrderNotFound}, // Cancellation failed: order does not exist
+	51503: {Err: commontypes.ErrOrderNotFound}, // Amendment failed: order does not exist
+	51603: {Err: commontypes.ErrOrderNotFound}, // Order does not exist
+
+	// Balance
+	51008: {Err: commontypes.ErrInsufficientBalance}, // Insufficient balance
+	51119: {Err: commontypes.ErrInsufficientBalance}, // Insufficient margin
+	51127: {Err: commontypes.ErrInsufficientBalance}, // Available balance is 0
+	51131: {Err: commontypes.ErrInsufficientBalance}, // Insufficient balance
+	58350: {Err: commontypes.ErrInsufficientBalance}, // Insufficient funding balance
+}
+
+// newAPIError returns the APIError for an OKX code or per-order sCode
+func newAPIError(code int, msg string) *commontypes.APIError {
+	return commontypes.NewAPIError("OKEx", 0, code, msg, errorCodes)
+}
@@ -44,14 +44,18 @@ type (
 		TS   constants.JSONTime     `json:"ts"`
 	}
 	OrderBookWs struct {
-		Asks     []*OrderBookEntity `json:"asks"`
-		Bids     []*OrderBookEntity `json:"bids"`
-		Checksum int                `json:"checksum"`
-		TS       constants.JSONTime     `json:"ts"`
+		Asks      []*OrderBookEntity `json:"asks"`
+		Bids      []*OrderBookEntity `json:"bids"`
+		Checksum  int                `json:"checksum"`
+		SeqID     int64              `json:"seqId"`
+		PrevSeqID int64              `json:"prevSeqId"`
+		TS        constants.JSONTime     `json:"ts"`
 	}
 	OrderBookEntity struct {
 		DepthPrice      float64
 		Size            float64
+		RawDepthPrice   string // DepthPrice as sent, which order book checksums are computed over
+		RawSize         string // Size as sent
 		LiquidatedOrder int
 		OrderNumbers    int
 	}
@@ -81,6 +85,7 @@ type (
 		Sz      constants.JSONFloat64 `json:"sz"`
 		Side    constants.TradeSide   `json:"side"`
 		TS      constants.JSONTime    `json:"ts"`
+		Count   constants.JSONInt64   `json:"count"` // WebSocket only: trades aggregated, TradeID being the last
 	}
 	TotalVolume24H struct {
 		VolUsd constants.JSONFloat64 `json:"volUsd"`
@@ -116,6 +121,7 @@ func (o *OrderBookEntity) UnmarshalJSON(buf []byte) error {
 	if g, e := len(tmp), wantLen; g != e {
 		return fmt.Errorf("wrong number of fields in OrderBookEntity: %d != %d", g, e)
 	}
+	o.RawDepthPrice, o.RawSize = dp, s
 	o.DepthPrice, err = strconv.ParseFloat(dp, 64)
 	if err != nil {
 		return err
@@ -51,6 +51,15 @@ type (
 		NextFundingRate constants.JSONFloat64    `json:"NextFundingRate"`
 		FundingTime     constants.JSONTime       `json:"fundingTime"`
 		NextFundingTime constants.JSONTime       `json:"nextFundingTime"`
+		TS              constants.JSONTime       `json:"ts"`
+	}
+	FundingRateHistory struct {
+		InstID       string                   `json:"instId"`
+		InstType     constants.InstrumentType `json:"instType"`
+		FundingRate  constants.JSONFloat64    `json:"fundingRate"`
+		RealizedRate constants.JSONFloat64    `json:"realizedRate"`
+		FundingTime  constants.JSONTime       `json:"fundingTime"`
+		Method       string                   `json:"method"`
 	}
 	LimitPrice struct {
 		InstID   string                   `json:"instId"`
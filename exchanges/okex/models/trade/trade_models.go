@@ -62,6 +62,7 @@ type (
 		OrdType     constants.OrderType      `json:"ordType"`
 		InstType    constants.InstrumentType `json:"instType"`
 		TgtCcy      constants.QuantityType   `json:"tgtCcy"`
+		ReduceOnly  constants.JSONBool       `json:"reduceOnly"`
 		UTime       constants.JSONTime       `json:"uTime"`
 		CTime       constants.JSONTime       `json:"cTime"`
 	}
@@ -97,6 +98,7 @@ type (
 		Ccy          string               `json:"ccy"`
 		OrdID        string               `json:"ordId"`
 		AlgoID       string               `json:"algoId"`
+		AlgoClOrdID  string               `json:"algoClOrdId"`
 		ClOrdID      string               `json:"clOrdId"`
 		TradeID      string               `json:"tradeId"`
 		Tag          string               `json:"tag"`
@@ -124,6 +126,14 @@ type (
 		SlTriggerPx  constants.JSONFloat64    `json:"slTriggerPx"`
 		SlOrdPx      constants.JSONFloat64    `json:"slOrdPx"`
 		OrdPx        constants.JSONFloat64    `json:"ordPx"`
+		OrderPx      constants.JSONFloat64    `json:"orderPx"`
+		TriggerPx    constants.JSONFloat64    `json:"triggerPx"`
+		CallbackRatio  constants.JSONFloat64  `json:"callbackRatio"`
+		CallbackSpread constants.JSONFloat64  `json:"callbackSpread"`
+		ActivePx     constants.JSONFloat64    `json:"activePx"`
+		TriggerPxType   string                `json:"triggerPxType"`
+		TpTriggerPxType string                `json:"tpTriggerPxType"`
+		SlTriggerPxType string                `json:"slTriggerPxType"`
 		Fee          constants.JSONFloat64    `json:"fee"`
 		Rebate       constants.JSONFloat64    `json:"rebate"`
 		State        constants.OrderState     `json:"state"`
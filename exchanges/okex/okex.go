@@ -4,11 +4,13 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/djpken/go-exc/clock"
 	okexEvents "github.com/djpken/go-exc/exchanges/okex/events"
 	privateEvents "github.com/djpken/go-exc/exchanges/okex/events/private"
 	privateWs "github.com/djpken/go-exc/exchanges/okex/requests/ws/private"
 	"github.com/djpken/go-exc/exchanges/okex/rest"
 	"github.com/djpken/go-exc/exchanges/okex/ws"
+	"github.com/djpken/go-exc/options"
 	commontypes "github.com/djpken/go-exc/types"
 )
 
@@ -21,14 +23,15 @@ type OKExExchange struct {
 }
 
 // NewOKExExchange creates a new OKEx exchange instance
-func NewOKExExchange(ctx context.Context, apiKey, secretKey, passphrase string, testMode bool) (*OKExExchange, error) {
+// opts configure the HTTP client, endpoints, WebSocket dialer and user agent.
+func NewOKExExchange(ctx context.Context, apiKey, secretKey, passphrase string, testMode bool, opts ...options.Option) (*OKExExchange, error) {
 	destination := NormalServer
 	if testMode {
 		destination = DemoServer
 	}
 
 	// Create native OKEx client
-	client, err := NewClient(ctx, apiKey, secretKey, passphrase, destination)
+	client, err := NewClient(ctx, apiKey, secretKey, passphrase, destination, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +63,16 @@ func (e *OKExExchange) WebSocket() interface{} {
 	return e.wsAPI
 }
 
+// Clock returns the clock synchronized with the OKX server time
+func (e *OKExExchange) Clock() *clock.Clock {
+	return e.client.Rest.Clock()
+}
+
 // Close closes all connections
 func (e *OKExExchange) Close() error {
 	// OKEx WebSocket client doesn't have a public Close method
 	// The connection management is handled internally
+	e.client.Close()
 	return nil
 }
 
@@ -82,7 +91,7 @@ func (e *OKExExchange) NativeWs() *ws.ClientWs {
 
 // GetConfig gets account configuration
 func (e *OKExExchange) GetConfig(ctx context.Context) (*commontypes.AccountConfig, error) {
-	resp, err := e.client.Rest.Account.GetConfig()
+	resp, err := e.client.Rest.Account.GetConfig(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +125,26 @@ func (e *OKExExchange) GetOrderBook(ctx context.Context, symbol string, depth in
 	return e.restAPI.Market().GetOrderBook(ctx, symbol, depth)
 }
 
+// GetRecentTrades gets the most recent public trades
+func (e *OKExExchange) GetRecentTrades(ctx context.Context, symbol string, limit int) ([]*commontypes.Trade, error) {
+	return e.restAPI.Market().GetRecentTrades(ctx, symbol, limit)
+}
+
+// GetFundingRate gets the current funding rate of a perpetual swap
+func (e *OKExExchange) GetFundingRate(ctx context.Context, symbol string) (*commontypes.FundingRate, error) {
+	return e.restAPI.Market().GetFundingRate(ctx, symbol)
+}
+
+// GetFundingRateHistory gets settled funding rates of a perpetual swap, newest first
+func (e *OKExExchange) GetFundingRateHistory(ctx context.Context, req commontypes.GetFundingRateHistoryRequest) ([]*commontypes.FundingRate, error) {
+	return e.restAPI.Market().GetFundingRateHistory(ctx, req)
+}
+
+// GetMarkPrice gets the mark price and index price of a contract
+func (e *OKExExchange) GetMarkPrice(ctx context.Context, symbol string) (*commontypes.MarkPrice, error) {
+	return e.restAPI.Market().GetMarkPrice(ctx, symbol)
+}
+
 // GetCandles gets historical candlestick/kline data
 func (e *OKExExchange) GetCandles(ctx context.Context, req commontypes.GetCandlesRequest) ([]*commontypes.Candle, error) {
 	return e.restAPI.Market().GetCandles(ctx, req)
@@ -141,6 +170,16 @@ func (e *OKExExchange) SetLeverage(ctx context.Context, req commontypes.SetLever
 	return e.restAPI.Account().SetLeverage(ctx, req)
 }
 
+// ClosePosition closes all or part of a position
+func (e *OKExExchange) ClosePosition(ctx context.Context, req commontypes.ClosePositionRequest) (*commontypes.ClosePositionResult, error) {
+	return e.restAPI.Trade().ClosePosition(ctx, req)
+}
+
+// CloseAllPositions closes every open position
+func (e *OKExExchange) CloseAllPositions(ctx context.Context) ([]*commontypes.ClosePositionResult, error) {
+	return e.restAPI.Trade().CloseAllPositions(ctx)
+}
+
 // PlaceOrder places a new order
 func (e *OKExExchange) PlaceOrder(ctx context.Context, req commontypes.PlaceOrderRequest) (*commontypes.Order, error) {
 	return e.restAPI.Trade().PlaceOrder(ctx, req)
@@ -161,11 +200,56 @@ func (e *OKExExchange) CancelOrder(ctx context.Context, req commontypes.CancelOr
 	return e.restAPI.Trade().CancelOrder(ctx, req.Symbol, req.OrderID, req.Extra)
 }
 
+// CancelOrders cancels multiple orders using the batch cancel endpoint
+func (e *OKExExchange) CancelOrders(ctx context.Context, reqs []commontypes.CancelOrderRequest) ([]*commontypes.CancelOrderResult, error) {
+	return e.restAPI.Trade().CancelOrders(ctx, reqs)
+}
+
+// CancelAllOrders cancels all incomplete orders for a symbol and/or instrument type
+func (e *OKExExchange) CancelAllOrders(ctx context.Context, symbol string, instType commontypes.InstrumentType) ([]*commontypes.CancelOrderResult, error) {
+	return e.restAPI.Trade().CancelAllOrders(ctx, symbol, instType)
+}
+
+// AmendOrder amends an existing order in place
+func (e *OKExExchange) AmendOrder(ctx context.Context, req commontypes.AmendOrderRequest) (*commontypes.AmendOrderResult, error) {
+	return e.restAPI.Trade().AmendOrder(ctx, req)
+}
+
 // GetOrderDetail gets order details
 func (e *OKExExchange) GetOrderDetail(ctx context.Context, req commontypes.GetOrderRequest) (*commontypes.Order, error) {
 	return e.restAPI.Trade().GetOrderDetail(ctx, req)
 }
 
+// GetOpenOrders lists incomplete orders
+func (e *OKExExchange) GetOpenOrders(ctx context.Context, req commontypes.GetOpenOrdersRequest) ([]*commontypes.Order, error) {
+	return e.restAPI.Trade().GetOpenOrders(ctx, req)
+}
+
+// GetOrderHistory lists completed orders
+func (e *OKExExchange) GetOrderHistory(ctx context.Context, req commontypes.GetOrderHistoryRequest) ([]*commontypes.Order, error) {
+	return e.restAPI.Trade().GetOrderHistory(ctx, req)
+}
+
+// GetFills lists own trade executions
+func (e *OKExExchange) GetFills(ctx context.Context, req commontypes.GetFillsRequest) ([]*commontypes.Trade, error) {
+	return e.restAPI.Trade().GetFills(ctx, req)
+}
+
+// PlaceConditionalOrder places an algo order (trigger, TP/SL or trailing stop)
+func (e *OKExExchange) PlaceConditionalOrder(ctx context.Context, req commontypes.ConditionalOrderRequest) (*commontypes.ConditionalOrder, error) {
+	return e.restAPI.Trade().PlaceConditionalOrder(ctx, req)
+}
+
+// CancelConditionalOrder cancels an untriggered algo order
+func (e *OKExExchange) CancelConditionalOrder(ctx context.Context, req commontypes.CancelConditionalOrderRequest) error {
+	return e.restAPI.Trade().CancelConditionalOrder(ctx, req)
+}
+
+// GetConditionalOrders lists untriggered algo orders
+func (e *OKExExchange) GetConditionalOrders(ctx context.Context, req commontypes.GetConditionalOrdersRequest) ([]*commontypes.ConditionalOrder, error) {
+	return e.restAPI.Trade().GetConditionalOrders(ctx, req)
+}
+
 // ========== WebSocket Subscription Methods ==========
 
 // SubscribeTickers subscribes to ticker updates for specified symbols via WebSocket
@@ -188,6 +272,46 @@ func (e *OKExExchange) UnsubscribeCandles(interval string, symbols ...string) er
 	return e.wsAPI.UnsubscribeCandles(interval, symbols...)
 }
 
+// SubscribeOrderBook streams order books for specified symbols via WebSocket
+func (e *OKExExchange) SubscribeOrderBook(ch chan *commontypes.OrderBookUpdate, depth int, symbols ...string) error {
+	return e.wsAPI.SubscribeOrderBook(ch, depth, symbols...)
+}
+
+// UnsubscribeOrderBook unsubscribes from order book updates for specified symbols
+func (e *OKExExchange) UnsubscribeOrderBook(symbols ...string) error {
+	return e.wsAPI.UnsubscribeOrderBook(symbols...)
+}
+
+// SubscribeTrades subscribes to public trades via WebSocket
+func (e *OKExExchange) SubscribeTrades(ch chan *commontypes.TradeUpdate, symbols ...string) error {
+	return e.wsAPI.SubscribeTrades(ch, symbols...)
+}
+
+// UnsubscribeTrades unsubscribes from public trades for specified symbols
+func (e *OKExExchange) UnsubscribeTrades(symbols ...string) error {
+	return e.wsAPI.UnsubscribeTrades(symbols...)
+}
+
+// SubscribeFundingRate subscribes to funding rate updates for specified symbols
+func (e *OKExExchange) SubscribeFundingRate(ch chan *commontypes.FundingRateUpdate, symbols ...string) error {
+	return e.wsAPI.SubscribeFundingRate(ch, symbols...)
+}
+
+// UnsubscribeFundingRate unsubscribes from funding rate updates for specified symbols
+func (e *OKExExchange) UnsubscribeFundingRate(symbols ...string) error {
+	return e.wsAPI.UnsubscribeFundingRate(symbols...)
+}
+
+// SubscribeMarkPrice subscribes to mark price updates for specified symbols
+func (e *OKExExchange) SubscribeMarkPrice(ch chan *commontypes.MarkPriceUpdate, symbols ...string) error {
+	return e.wsAPI.SubscribeMarkPrice(ch, symbols...)
+}
+
+// UnsubscribeMarkPrice unsubscribes from mark price updates for specified symbols
+func (e *OKExExchange) UnsubscribeMarkPrice(symbols ...string) error {
+	return e.wsAPI.UnsubscribeMarkPrice(symbols...)
+}
+
 // SubscribeBalanceAndPosition subscribes to balance and position updates via WebSocket
 func (e *OKExExchange) SubscribeBalanceAndPosition(ch chan *commontypes.BalanceAndPositionUpdate) error {
 	// Create internal channel for native OKEx events
@@ -29,7 +29,7 @@ type (
 		PosMode constants.PosModeType `json:"posMode"`
 	}
 	SetLeverage struct {
-		Lever   int64              `json:"lever,string"`
+		Lever   string             `json:"lever"`
 		InstID  string             `json:"instId,omitempty"`
 		Ccy     string             `json:"ccy,omitempty"`
 		MgnMode constants.MarginMode   `json:"mgnMode"`
@@ -12,13 +12,23 @@ type (
 		ClOrdID    string             `json:"clOrdId,omitempty"`
 		Tag        string             `json:"tag,omitempty"`
 		ReduceOnly bool               `json:"reduceOnly,omitempty"`
-		Sz         float64            `json:"sz,string"`
-		Px         float64            `json:"px,omitempty,string"`
+		Sz         string             `json:"sz"`
+		Px         string             `json:"px,omitempty"`
 		TdMode     constants.TradeMode    `json:"tdMode"`
 		Side       constants.OrderSide    `json:"side"`
 		PosSide    constants.PositionSide `json:"posSide,omitempty"`
 		OrdType    constants.OrderType    `json:"ordType"`
 		TgtCcy     constants.QuantityType `json:"tgtCcy,omitempty"`
+		AttachAlgoOrds []AttachAlgoOrd `json:"attachAlgoOrds,omitempty"`
+	}
+	AttachAlgoOrd struct {
+		AttachAlgoClOrdID string  `json:"attachAlgoClOrdId,omitempty"`
+		TpTriggerPx       string `json:"tpTriggerPx,omitempty"`
+		TpTriggerPxType   string `json:"tpTriggerPxType,omitempty"`
+		TpOrdPx           string `json:"tpOrdPx,omitempty"`
+		SlTriggerPx       string `json:"slTriggerPx,omitempty"`
+		SlTriggerPxType   string `json:"slTriggerPxType,omitempty"`
+		SlOrdPx           string `json:"slOrdPx,omitempty"`
 	}
 	CancelOrder struct {
 		ID      string `json:"-"`
@@ -27,14 +37,14 @@ type (
 		ClOrdID string `json:"clOrdId,omitempty"`
 	}
 	AmendOrder struct {
-		ID        string  `json:"-"`
-		InstID    string  `json:"instId"`
-		OrdID     string  `json:"ordId,omitempty"`
-		ClOrdID   string  `json:"clOrdId,omitempty"`
-		ReqID     string  `json:"reqId,omitempty"`
-		NewSz     int64   `json:"newSz,omitempty,string"`
-		NewPx     float64 `json:"newPx,omitempty,string"`
-		CxlOnFail bool    `json:"cxlOnFail,omitempty"`
+		ID        string `json:"-"`
+		InstID    string `json:"instId"`
+		OrdID     string `json:"ordId,omitempty"`
+		ClOrdID   string `json:"clOrdId,omitempty"`
+		ReqID     string `json:"reqId,omitempty"`
+		NewSz     string `json:"newSz,omitempty"`
+		NewPx     string `json:"newPx,omitempty"`
+		CxlOnFail bool   `json:"cxlOnFail,omitempty"`
 	}
 	ClosePosition struct {
 		InstID  string             `json:"instId"`
@@ -51,8 +61,10 @@ type (
 	OrderList struct {
 		Uly      string               `json:"uly,omitempty"`
 		InstID   string               `json:"instId,omitempty"`
-		After    float64              `json:"after,omitempty,string"`
-		Before   float64              `json:"before,omitempty,string"`
+		After    string               `json:"after,omitempty"`
+		Before   string               `json:"before,omitempty"`
+		Begin    int64                `json:"begin,omitempty,string"`
+		End      int64                `json:"end,omitempty,string"`
 		Limit    float64              `json:"limit,omitempty,string"`
 		InstType constants.InstrumentType `json:"instType,omitempty"`
 		OrdType  constants.OrderType      `json:"ordType,omitempty"`
@@ -62,56 +74,69 @@ type (
 		Uly      string               `json:"uly,omitempty"`
 		InstID   string               `json:"instId,omitempty"`
 		OrdID    string               `json:"ordId,omitempty"`
-		After    float64              `json:"after,omitempty,string"`
-		Before   float64              `json:"before,omitempty,string"`
+		After    string               `json:"after,omitempty"`
+		Before   string               `json:"before,omitempty"`
+		Begin    int64                `json:"begin,omitempty,string"`
+		End      int64                `json:"end,omitempty,string"`
 		Limit    float64              `json:"limit,omitempty,string"`
 		InstType constants.InstrumentType `json:"instType,omitempty"`
 	}
 	PlaceAlgoOrder struct {
-		InstID     string              `json:"instId"`
-		TdMode     constants.TradeMode     `json:"tdMode"`
-		Ccy        string              `json:"ccy,omitempty"`
-		Side       constants.OrderSide     `json:"side"`
-		PosSide    constants.PositionSide  `json:"posSide,omitempty"`
-		OrdType    constants.AlgoOrderType `json:"ordType"`
-		Sz         int64               `json:"sz,string"`
-		ReduceOnly bool                `json:"reduceOnly,omitempty"`
-		TgtCcy     constants.QuantityType  `json:"tgtCcy,omitempty"`
+		InstID      string              `json:"instId"`
+		TdMode      constants.TradeMode     `json:"tdMode"`
+		Ccy         string              `json:"ccy,omitempty"`
+		Side        constants.OrderSide     `json:"side"`
+		PosSide     constants.PositionSide  `json:"posSide,omitempty"`
+		OrdType     constants.AlgoOrderType `json:"ordType"`
+		Sz          string              `json:"sz"`
+		ReduceOnly  bool                `json:"reduceOnly,omitempty"`
+		TgtCcy      constants.QuantityType  `json:"tgtCcy,omitempty"`
+		AlgoClOrdID string              `json:"algoClOrdId,omitempty"`
 		StopOrder
 		TriggerOrder
 		IcebergOrder
 		TWAPOrder
+		MoveStopOrder
 	}
 	StopOrder struct {
-		TpTriggerPx float64 `json:"tpTriggerPx,string,omitempty"`
-		TpOrdPx     float64 `json:"tpOrdPx,string,omitempty"`
-		SlTriggerPx float64 `json:"slTriggerPx,string,omitempty"`
-		SlOrdPx     float64 `json:"slOrdPx,string,omitempty"`
+		TpTriggerPx     string `json:"tpTriggerPx,omitempty"`
+		TpTriggerPxType string `json:"tpTriggerPxType,omitempty"`
+		TpOrdPx         string `json:"tpOrdPx,omitempty"`
+		SlTriggerPx     string `json:"slTriggerPx,omitempty"`
+		SlTriggerPxType string `json:"slTriggerPxType,omitempty"`
+		SlOrdPx         string `json:"slOrdPx,omitempty"`
 	}
 	TriggerOrder struct {
-		TriggerPx float64 `json:"triggerPx,string,omitempty"`
-		OrdPx     float64 `json:"ordPx,string,omitempty"`
+		TriggerPx     string `json:"triggerPx,omitempty"`
+		TriggerPxType string `json:"triggerPxType,omitempty"`
+		OrderPx       string `json:"orderPx,omitempty"`
 	}
 	IcebergOrder struct {
 		PxVar    float64 `json:"pxVar,string,omitempty"`
 		PxSpread float64 `json:"pxSpread,string,omitempty"`
-		SzLimit  int64   `json:"szLimit,string"`
-		PxLimit  float64 `json:"pxLimit,string"`
+		SzLimit  int64   `json:"szLimit,string,omitempty"`
+		PxLimit  float64 `json:"pxLimit,string,omitempty"`
 	}
 	TWAPOrder struct {
 		IcebergOrder
-		TimeInterval string `json:"timeInterval"`
+		TimeInterval string `json:"timeInterval,omitempty"`
+	}
+	MoveStopOrder struct {
+		CallbackRatio  string  `json:"callbackRatio,omitempty"`
+		CallbackSpread float64 `json:"callbackSpread,string,omitempty"`
+		ActivePx       string  `json:"activePx,omitempty"`
 	}
 	CancelAlgoOrder struct {
 		InstID string `json:"instId"`
-		AlgoID string `json:"AlgoId"`
+		AlgoID string `json:"algoId"`
 	}
 	AlgoOrderList struct {
 		InstType constants.InstrumentType `json:"instType,omitempty"`
 		Uly      string               `json:"uly,omitempty"`
 		InstID   string               `json:"instId,omitempty"`
-		After    float64              `json:"after,omitempty,string"`
-		Before   float64              `json:"before,omitempty,string"`
+		AlgoID   string               `json:"algoId,omitempty"`
+		After    string               `json:"after,omitempty"`
+		Before   string               `json:"before,omitempty"`
 		Limit    float64              `json:"limit,omitempty,string"`
 		OrdType  constants.AlgoOrderType  `json:"ordType,omitempty"`
 		State    constants.OrderState     `json:"state,omitempty"`
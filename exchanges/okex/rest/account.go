@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"strings"
@@ -26,13 +27,13 @@ func NewAccount(c *ClientRest) *Account {
 // Retrieve a list of assets (with non-zero balance), remaining balance, and available amount in the account.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-balance
-func (c *Account) GetBalance(req requests.GetBalance) (response responses.GetBalance, err error) {
+func (c *Account) GetBalance(ctx context.Context, req requests.GetBalance) (response responses.GetBalance, err error) {
 	p := "/api/v5/account/balance"
 	m := utils.S2M(req)
 	if len(req.Ccy) > 0 {
 		m["ccy"] = strings.Join(req.Ccy, ",")
 	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -47,7 +48,7 @@ func (c *Account) GetBalance(req requests.GetBalance) (response responses.GetBal
 // Retrieve information on your positions. When the account is in net mode, net positions will be displayed, and when the account is in long/short mode, long or short positions will be displayed.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-positions
-func (c *Account) GetPositions(req requests.GetPositions) (response responses.GetPositions, err error) {
+func (c *Account) GetPositions(ctx context.Context, req requests.GetPositions) (response responses.GetPositions, err error) {
 	p := "/api/v5/account/positions"
 	m := utils.S2M(req)
 	if len(req.InstID) > 0 {
@@ -56,7 +57,7 @@ func (c *Account) GetPositions(req requests.GetPositions) (response responses.Ge
 	if len(req.PosID) > 0 {
 		m["posId"] = strings.Join(req.PosID, ",")
 	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -71,10 +72,10 @@ func (c *Account) GetPositions(req requests.GetPositions) (response responses.Ge
 // Get account and position risk
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-account-and-position-risk
-func (c *Account) GetAccountAndPositionRisk(req requests.GetAccountAndPositionRisk) (response responses.GetAccountAndPositionRisk, err error) {
+func (c *Account) GetAccountAndPositionRisk(ctx context.Context, req requests.GetAccountAndPositionRisk) (response responses.GetAccountAndPositionRisk, err error) {
 	p := "/api/v5/account/positions"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -93,13 +94,13 @@ func (c *Account) GetAccountAndPositionRisk(req requests.GetAccountAndPositionRi
 // Retrieve the account’s bills. The bill refers to all transaction records that result in changing the balance of an account. Pagination is supported, and the response is sorted with most recent first. This endpoint can retrieve data from the last 3 months.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-bills-details-last-3-months
-func (c *Account) GetBills(req requests.GetBills, arc bool) (response responses.GetBills, err error) {
+func (c *Account) GetBills(ctx context.Context, req requests.GetBills, arc bool) (response responses.GetBills, err error) {
 	p := "/api/v5/account/bills"
 	if arc {
 		p = "/api/account/bills-archive"
 	}
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -114,9 +115,9 @@ func (c *Account) GetBills(req requests.GetBills, arc bool) (response responses.
 // Retrieve current account configuration.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-account-configuration
-func (c *Account) GetConfig() (response responses.GetConfig, err error) {
+func (c *Account) GetConfig(ctx context.Context) (response responses.GetConfig, err error) {
 	p := "/api/v5/account/config"
-	res, err := c.client.Do(http.MethodGet, p, true)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true)
 	if err != nil {
 		return
 	}
@@ -131,10 +132,10 @@ func (c *Account) GetConfig() (response responses.GetConfig, err error) {
 // FUTURES and SWAP support both long/short mode and net mode. In net mode, users can only have positions in one direction; In long/short mode, users can hold positions in long and short directions.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-set-position-mode
-func (c *Account) SetPositionMode(req requests.SetPositionMode) (response responses.SetPositionMode, err error) {
+func (c *Account) SetPositionMode(ctx context.Context, req requests.SetPositionMode) (response responses.SetPositionMode, err error) {
 	p := "/api/v5/account/set-position-mode"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodPost, p, true, m)
 	if err != nil {
 		return
 	}
@@ -156,10 +157,10 @@ func (c *Account) SetPositionMode(req requests.SetPositionMode) (response respon
 //
 // Set leverage for cross/isolated FUTURES/SWAP at underlying/contract level.
 // https://www.okex.com/docs-v5/en/#rest-api-account-set-leverage
-func (c *Account) SetLeverage(req requests.SetLeverage) (response responses.Leverage, err error) {
+func (c *Account) SetLeverage(ctx context.Context, req requests.SetLeverage) (response responses.Leverage, err error) {
 	p := "/api/v5/account/set-leverage"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodPost, p, true, m)
 	if err != nil {
 		return
 	}
@@ -173,13 +174,13 @@ func (c *Account) SetLeverage(req requests.SetLeverage) (response responses.Leve
 // GetMaxBuySellAmount
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-maximum-buy-sell-amount-or-open-amount
-func (c *Account) GetMaxBuySellAmount(req requests.GetMaxBuySellAmount) (response responses.GetMaxBuySellAmount, err error) {
+func (c *Account) GetMaxBuySellAmount(ctx context.Context, req requests.GetMaxBuySellAmount) (response responses.GetMaxBuySellAmount, err error) {
 	p := "/api/v5/account/max-size"
 	m := utils.S2M(req)
 	if len(req.InstID) > 0 {
 		m["instId"] = strings.Join(req.InstID, ",")
 	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -193,10 +194,10 @@ func (c *Account) GetMaxBuySellAmount(req requests.GetMaxBuySellAmount) (respons
 // GetMaxAvailableTradeAmount
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-maximum-available-tradable-amount
-func (c *Account) GetMaxAvailableTradeAmount(req requests.GetMaxAvailableTradeAmount) (response responses.GetMaxAvailableTradeAmount, err error) {
+func (c *Account) GetMaxAvailableTradeAmount(ctx context.Context, req requests.GetMaxAvailableTradeAmount) (response responses.GetMaxAvailableTradeAmount, err error) {
 	p := "/api/v5/account/max-avail-size"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -211,10 +212,10 @@ func (c *Account) GetMaxAvailableTradeAmount(req requests.GetMaxAvailableTradeAm
 // Increase or decrease the margin of the isolated position.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-increase-decrease-margin
-func (c *Account) IncreaseDecreaseMargin(req requests.IncreaseDecreaseMargin) (response responses.IncreaseDecreaseMargin, err error) {
+func (c *Account) IncreaseDecreaseMargin(ctx context.Context, req requests.IncreaseDecreaseMargin) (response responses.IncreaseDecreaseMargin, err error) {
 	p := "/api/v5/account/position/margin-balance"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodPost, p, true, m)
 	if err != nil {
 		return
 	}
@@ -228,13 +229,13 @@ func (c *Account) IncreaseDecreaseMargin(req requests.IncreaseDecreaseMargin) (r
 // GetLeverage
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-leverage
-func (c *Account) GetLeverage(req requests.GetLeverage) (response responses.Leverage, err error) {
+func (c *Account) GetLeverage(ctx context.Context, req requests.GetLeverage) (response responses.Leverage, err error) {
 	p := "/api/v5/account/leverage-info"
 	m := utils.S2M(req)
 	if len(req.InstID) > 0 {
 		m["instId"] = strings.Join(req.InstID, ",")
 	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -248,10 +249,10 @@ func (c *Account) GetLeverage(req requests.GetLeverage) (response responses.Leve
 // GetMaxLoan
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-the-maximum-loan-of-instrument
-func (c *Account) GetMaxLoan(req requests.GetMaxLoan) (response responses.GetMaxLoan, err error) {
+func (c *Account) GetMaxLoan(ctx context.Context, req requests.GetMaxLoan) (response responses.GetMaxLoan, err error) {
 	p := "/api/v5/account/max-loan"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -265,10 +266,10 @@ func (c *Account) GetMaxLoan(req requests.GetMaxLoan) (response responses.GetMax
 // GetFeeRates
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-fee-rates
-func (c *Account) GetFeeRates(req requests.GetFeeRates) (response responses.GetFeeRates, err error) {
+func (c *Account) GetFeeRates(ctx context.Context, req requests.GetFeeRates) (response responses.GetFeeRates, err error) {
 	p := "/api/v5/account/trade-fee"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -282,10 +283,10 @@ func (c *Account) GetFeeRates(req requests.GetFeeRates) (response responses.GetF
 // GetInterestAccrued
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-interest-accrued
-func (c *Account) GetInterestAccrued(req requests.GetInterestAccrued) (response responses.GetInterestAccrued, err error) {
+func (c *Account) GetInterestAccrued(ctx context.Context, req requests.GetInterestAccrued) (response responses.GetInterestAccrued, err error) {
 	p := "/api/v5/account/interest-accrued"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -300,13 +301,13 @@ func (c *Account) GetInterestAccrued(req requests.GetInterestAccrued) (response
 // Get the user's current leveraged currency borrowing interest rate
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-interest-rate
-func (c *Account) GetInterestRates(req requests.GetBalance) (response responses.GetInterestRates, err error) {
+func (c *Account) GetInterestRates(ctx context.Context, req requests.GetBalance) (response responses.GetInterestRates, err error) {
 	p := "/api/v5/account/interest-rate"
 	m := utils.S2M(req)
 	if len(req.Ccy) > 0 {
 		m["ccy"] = strings.Join(req.Ccy, ",")
 	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -321,10 +322,10 @@ func (c *Account) GetInterestRates(req requests.GetBalance) (response responses.
 // Set the display type of Greeks.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-set-greeks-m-bs
-func (c *Account) SetGreeks(req requests.SetGreeks) (response responses.SetGreeks, err error) {
+func (c *Account) SetGreeks(ctx context.Context, req requests.SetGreeks) (response responses.SetGreeks, err error) {
 	p := "/api/v5/account/set-greeks"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodPost, p, true, m)
 	if err != nil {
 		return
 	}
@@ -338,13 +339,13 @@ func (c *Account) SetGreeks(req requests.SetGreeks) (response responses.SetGreek
 // GetMaxWithdrawals
 //
 // https://www.okex.com/docs-v5/en/#rest-api-account-get-maximum-withdrawals
-func (c *Account) GetMaxWithdrawals(req requests.GetBalance) (response responses.GetMaxWithdrawals, err error) {
+func (c *Account) GetMaxWithdrawals(ctx context.Context, req requests.GetBalance) (response responses.GetMaxWithdrawals, err error) {
 	p := "/api/v5/account/max-withdrawal"
 	m := utils.S2M(req)
 	if len(req.Ccy) > 0 {
 		m["ccy"] = strings.Join(req.Ccy, ",")
 	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -358,10 +359,10 @@ func (c *Account) GetMaxWithdrawals(req requests.GetBalance) (response responses
 // SetAccountLevel
 //
 // https://www.okx.com/docs-v5/zh/#trading-account-rest-api-set-account-mode
-func (c *Account) SetAccountLevel(req requests.SetAccountLevel) (response responses.SetAccountLevel, err error) {
+func (c *Account) SetAccountLevel(ctx context.Context, req requests.SetAccountLevel) (response responses.SetAccountLevel, err error) {
 	p := "/api/v5/account/set-account-level"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -2,19 +2,26 @@ package rest
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"strings"
-	"time"
 
 	requests "github.com/djpken/go-exc/exchanges/okex/requests/rest/public"
 	responses "github.com/djpken/go-exc/exchanges/okex/responses/public_data"
+	"github.com/djpken/go-exc/clock"
 	"github.com/djpken/go-exc/exchanges/okex/constants"
 	"github.com/djpken/go-exc/exchanges/okex/utils"
+	"github.com/djpken/go-exc/metrics"
+	"github.com/djpken/go-exc/middleware"
+	"github.com/djpken/go-exc/ratelimit"
+	"github.com/djpken/go-exc/retry"
 )
 
 // ClientRest is the rest api client
@@ -32,8 +39,18 @@ type ClientRest struct {
 	destination constants.Destination
 	baseURL     constants.BaseURL
 	client      *http.Client
+	userAgent   string
+	limiter     *ratelimit.Limiter
+	retry       retry.Policy
+	clock       *clock.Clock
+	logger      *slog.Logger
+	middleware  []middleware.Middleware
+	metrics     metrics.Recorder
 }
 
+// exchangeName is the exchange label of middleware requests and metrics
+const exchangeName = "okx"
+
 // NewClient returns a pointer to a fresh ClientRest
 func NewClient(apiKey, secretKey, passphrase string, baseURL constants.BaseURL, destination constants.Destination) *ClientRest {
 	c := &ClientRest{
@@ -43,6 +60,10 @@ func NewClient(apiKey, secretKey, passphrase string, baseURL constants.BaseURL,
 		baseURL:     baseURL,
 		destination: destination,
 		client:      http.DefaultClient,
+		limiter:     ratelimit.New(RateLimits),
+		retry:       retry.DefaultPolicy,
+		logger:      slog.New(slog.DiscardHandler),
+		metrics:     metrics.Nop{},
 	}
 	c.Account = NewAccount(c)
 	c.SubAccount = NewSubAccount(c)
@@ -54,51 +75,142 @@ func NewClient(apiKey, secretKey, passphrase string, baseURL constants.BaseURL,
 	return c
 }
 
-// Do the http request to the server
-func (c *ClientRest) Do(method, path string, private bool, params ...map[string]string) (*http.Response, error) {
-	u := fmt.Sprintf("%s%s", c.baseURL, path)
-	var (
-		r    *http.Request
-		err  error
-		j    []byte
-		body string
-	)
-	if method == http.MethodGet {
-		r, err = http.NewRequest(http.MethodGet, u, nil)
-		if err != nil {
-			return nil, err
-		}
+// SetHTTPClient sets the HTTP client used for all requests
+func (c *ClientRest) SetHTTPClient(client *http.Client) {
+	c.client = client
+}
+
+// SetUserAgent sets the User-Agent header sent with every request
+func (c *ClientRest) SetUserAgent(userAgent string) {
+	c.userAgent = userAgent
+}
+
+// SetClock sets the clock used to timestamp signed requests. A nil clock
+// signs with the local time.
+func (c *ClientRest) SetClock(clk *clock.Clock) {
+	c.clock = clk
+}
+
+// Clock returns the clock used to timestamp signed requests, e.g. to monitor
+// its offset and latency
+func (c *ClientRest) Clock() *clock.Clock {
+	return c.clock
+}
+
+// SetLogger sets the logger receiving retries and rate-limit rejections.
+// A nil logger discards them.
+func (c *ClientRest) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	c.logger = logger
+}
+
+// SetMiddleware sets the middleware run around every request, the first
+// being the outermost
+func (c *ClientRest) SetMiddleware(middlewares ...middleware.Middleware) {
+	c.middleware = middlewares
+}
+
+// SetMetrics sets the recorder of rate-limit waits. A nil recorder records nothing.
+func (c *ClientRest) SetMetrics(recorder metrics.Recorder) {
+	c.metrics = metrics.OrNop(recorder)
+}
+
+// SetRetryPolicy sets the policy used to retry transient failures
+func (c *ClientRest) SetRetryPolicy(policy retry.Policy) {
+	c.retry = policy
+}
+
+// Do the http request to the server. GET requests are retried on transient
+// failures according to the retry policy.
+func (c *ClientRest) Do(ctx context.Context, method, path string, private bool, params ...map[string]string) (*http.Response, error) {
+	if method != http.MethodGet {
+		return c.DoJSON(ctx, method, path, private, params[0])
+	}
 
-		if len(params) > 0 {
-			q := r.URL.Query()
-			for k, v := range params[0] {
-				q.Add(k, strings.ReplaceAll(v, "\"", ""))
-			}
-			r.URL.RawQuery = q.Encode()
-			if len(params[0]) > 0 {
-				path += "?" + r.URL.RawQuery
-			}
+	for attempt := 1; ; attempt++ {
+		res, err := c.get(ctx, path, private, params...)
+		if !c.retry.Retry(attempt) || !retry.Transient(res, err) {
+			return res, err
 		}
-	} else {
-		j, err = json.Marshal(params[0])
-		if err != nil {
+		c.logRetry(path, attempt, res, err)
+		if res != nil {
+			res.Body.Close()
+		}
+		if err := c.retry.Sleep(ctx, attempt); err != nil {
 			return nil, err
 		}
-		body = string(j)
-		if body == "{}" {
-			body = ""
+	}
+}
+
+// logRetry logs a transient failure that is about to be retried
+func (c *ClientRest) logRetry(path string, attempt int, res *http.Response, err error) {
+	if res != nil {
+		c.logger.Warn("retrying request", "path", path, "attempt", attempt, "status", res.StatusCode)
+		return
+	}
+	c.logger.Warn("retrying request", "path", path, "attempt", attempt, "error", err)
+}
+
+// get sends a single GET request
+func (c *ClientRest) get(ctx context.Context, path string, private bool, params ...map[string]string) (*http.Response, error) {
+	key := rateLimitKey(http.MethodGet, path)
+	if err := c.wait(ctx, key, 1); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s%s", c.baseURL, path)
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params) > 0 {
+		q := r.URL.Query()
+		for k, v := range params[0] {
+			q.Add(k, strings.ReplaceAll(v, "\"", ""))
 		}
-		r, err = http.NewRequest(method, u, bytes.NewBuffer(j))
-		if err != nil {
-			return nil, err
+		r.URL.RawQuery = q.Encode()
+		if len(params[0]) > 0 {
+			path += "?" + r.URL.RawQuery
 		}
-		r.Header.Add("Content-Type", "application/json")
 	}
+	return c.send(r, key, http.MethodGet, path, "", private)
+}
+
+// DoJSON sends payload as the JSON request body. Unlike Do it accepts any
+// marshallable value, so batch endpoints can be sent an array of requests.
+func (c *ClientRest) DoJSON(ctx context.Context, method, path string, private bool, payload interface{}) (*http.Response, error) {
+	key := rateLimitKey(method, path)
+	if err := c.wait(ctx, key, rateLimitWeight(payload)); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s%s", c.baseURL, path)
+	j, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
+	body := string(j)
+	if body == "{}" {
+		body = ""
+	}
+	r, err := http.NewRequestWithContext(ctx, method, u, bytes.NewBuffer(j))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Add("Content-Type", "application/json")
+	return c.send(r, key, method, path, body, private)
+}
+
+// send signs the request if needed and executes it
+func (c *ClientRest) send(r *http.Request, key, method, path, body string, private bool) (*http.Response, error) {
 	if private {
-		timestamp, sign := c.sign(method, path, body)
+		timestamp, sign, err := c.sign(method, path, body)
+		if err != nil {
+			return nil, err
+		}
 		r.Header.Add("OK-ACCESS-KEY", c.apiKey)
 		r.Header.Add("OK-ACCESS-PASSPHRASE", c.passphrase)
 		r.Header.Add("OK-ACCESS-SIGN", sign)
@@ -107,17 +219,59 @@ func (c *ClientRest) Do(method, path string, private bool, params ...map[string]
 	if c.destination == constants.DemoServer {
 		r.Header.Add("x-simulated-trading", "1")
 	}
-	return c.client.Do(r)
+	if c.userAgent != "" {
+		r.Header.Set("User-Agent", c.userAgent)
+	}
+	req := &middleware.Request{
+		Exchange: exchangeName,
+		Method:   method,
+		Endpoint: r.URL.Path,
+		Private:  private,
+		Params:   queryParams(r),
+		Body:     requestBody(r),
+		Header:   r.Header,
+	}
+	resp, err := middleware.Chain(middleware.Send(c.client, r), c.middleware...)(r.Context(), req)
+	if err != nil {
+		return nil, err
+	}
+	res := resp.HTTPResponse(r)
+	c.observeRateLimit(key, res)
+	return res, nil
+}
+
+// queryParams returns the query parameters of r
+func queryParams(r *http.Request) map[string]string {
+	q := r.URL.Query()
+	params := make(map[string]string, len(q))
+	for k := range q {
+		params[k] = q.Get(k)
+	}
+	return params
+}
+
+// requestBody returns a copy of the body of r
+func requestBody(r *http.Request) []byte {
+	if r.GetBody == nil {
+		return nil
+	}
+	body, err := r.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	b, _ := io.ReadAll(body)
+	return b
 }
 
 // Status
 // Get event status of system upgrade
 //
 // https://www.okex.com/docs-v5/en/#rest-api-status
-func (c *ClientRest) Status(req requests.Status) (response responses.Status, err error) {
+func (c *ClientRest) Status(ctx context.Context, req requests.Status) (response responses.Status, err error) {
 	p := "/api/v5/system/status"
 	m := utils.S2M(req)
-	res, err := c.Do(http.MethodGet, p, false, m)
+	res, err := c.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -127,13 +281,17 @@ func (c *ClientRest) Status(req requests.Status) (response responses.Status, err
 	return
 }
 
-func (c *ClientRest) sign(method, path, body string) (string, string) {
+func (c *ClientRest) sign(method, path, body string) (string, string, error) {
+	now, err := c.clock.SignTime()
+	if err != nil {
+		return "", "", err
+	}
 	format := "2006-01-02T15:04:05.999Z07:00"
-	t := time.Now().UTC().Format(format)
+	t := now.UTC().Format(format)
 	ts := fmt.Sprint(t)
 	s := ts + method + path + body
 	p := []byte(s)
 	h := hmac.New(sha256.New, c.secretKey)
 	h.Write(p)
-	return ts, base64.StdEncoding.EncodeToString(h.Sum(nil))
+	return ts, base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
 }
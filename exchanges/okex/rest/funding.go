@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"strings"
@@ -26,10 +27,10 @@ func NewFunding(c *ClientRest) *Funding {
 // Retrieve a list of all currencies. Not all currencies can be traded. Currencies that have not been defined in ISO 4217 may use a custom symbol.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-funding-get-currencies
-func (c *Funding) GetCurrencies() (response responses.GetCurrencies, err error) {
+func (c *Funding) GetCurrencies(ctx context.Context) (response responses.GetCurrencies, err error) {
 	p := "/api/v5/asset/currencies"
 
-	res, err := c.client.Do(http.MethodGet, p, true)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true)
 	if err != nil {
 		return
 	}
@@ -45,13 +46,13 @@ func (c *Funding) GetCurrencies() (response responses.GetCurrencies, err error)
 // Retrieve the balances of all the assets, and the amount that is available or on hold.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-funding-get-balance
-func (c *Funding) GetBalance(req requests.GetBalance) (response responses.GetBalance, err error) {
+func (c *Funding) GetBalance(ctx context.Context, req requests.GetBalance) (response responses.GetBalance, err error) {
 	p := "/api/v5/asset/balances"
 	m := utils.S2M(req)
 	if len(req.Ccy) > 0 {
 		m["ccy"] = strings.Join(req.Ccy, ",")
 	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -65,10 +66,10 @@ func (c *Funding) GetBalance(req requests.GetBalance) (response responses.GetBal
 // This endpoint supports the transfer of funds between your funding account and trading account, and from the master account to sub-accounts. Direct transfers between sub-accounts are not allowed.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-funding-funds-transfer
-func (c *Funding) FundsTransfer(req requests.FundsTransfer) (response responses.FundsTransfer, err error) {
+func (c *Funding) FundsTransfer(ctx context.Context, req requests.FundsTransfer) (response responses.FundsTransfer, err error) {
 	p := "/api/v5/asset/transfer"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodPost, p, true, m)
 	if err != nil {
 		return
 	}
@@ -82,10 +83,10 @@ func (c *Funding) FundsTransfer(req requests.FundsTransfer) (response responses.
 // Query the billing record, you can get the latest 1 month historical data.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-funding-asset-bills-details
-func (c *Funding) AssetBillsDetails(req requests.AssetBillsDetails) (response responses.AssetBillsDetails, err error) {
+func (c *Funding) AssetBillsDetails(ctx context.Context, req requests.AssetBillsDetails) (response responses.AssetBillsDetails, err error) {
 	p := "/api/v5/asset/bills"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -99,10 +100,10 @@ func (c *Funding) AssetBillsDetails(req requests.AssetBillsDetails) (response re
 // Retrieve the deposit addresses of currencies, including previously-used addresses.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-funding-get-deposit-address
-func (c *Funding) GetDepositAddress(req requests.GetDepositAddress) (response responses.GetDepositAddress, err error) {
+func (c *Funding) GetDepositAddress(ctx context.Context, req requests.GetDepositAddress) (response responses.GetDepositAddress, err error) {
 	p := "/api/v5/asset/deposit-address"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -116,10 +117,10 @@ func (c *Funding) GetDepositAddress(req requests.GetDepositAddress) (response re
 // Retrieve the deposit history of all currencies, up to 100 recent records in a year.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-funding-get-deposit-history
-func (c *Funding) GetDepositHistory(req requests.GetDepositHistory) (response responses.GetDepositHistory, err error) {
+func (c *Funding) GetDepositHistory(ctx context.Context, req requests.GetDepositHistory) (response responses.GetDepositHistory, err error) {
 	p := "/api/v5/asset/deposit-history"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -133,10 +134,10 @@ func (c *Funding) GetDepositHistory(req requests.GetDepositHistory) (response re
 // Withdrawal of tokens.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-funding-withdrawal
-func (c *Funding) Withdrawal(req requests.Withdrawal) (response responses.Withdrawal, err error) {
+func (c *Funding) Withdrawal(ctx context.Context, req requests.Withdrawal) (response responses.Withdrawal, err error) {
 	p := "/api/v5/asset/withdrawal"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodPost, p, true, m)
 	if err != nil {
 		return
 	}
@@ -150,10 +151,10 @@ func (c *Funding) Withdrawal(req requests.Withdrawal) (response responses.Withdr
 // Retrieve the withdrawal records according to the currency, withdrawal status, and time range in reverse chronological order. The 100 most recent records are returned by default.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-funding-get-withdrawal-history
-func (c *Funding) GetWithdrawalHistory(req requests.GetWithdrawalHistory) (response responses.GetWithdrawalHistory, err error) {
+func (c *Funding) GetWithdrawalHistory(ctx context.Context, req requests.GetWithdrawalHistory) (response responses.GetWithdrawalHistory, err error) {
 	p := "/api/v5/asset/withdrawal-history"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -166,10 +167,10 @@ func (c *Funding) GetWithdrawalHistory(req requests.GetWithdrawalHistory) (respo
 // PiggyBankPurchaseRedemption
 //
 // https://www.okex.com/docs-v5/en/#rest-api-funding-piggybank-purchase-redemption
-func (c *Funding) PiggyBankPurchaseRedemption(req requests.PiggyBankPurchaseRedemption) (response responses.PiggyBankPurchaseRedemption, err error) {
+func (c *Funding) PiggyBankPurchaseRedemption(ctx context.Context, req requests.PiggyBankPurchaseRedemption) (response responses.PiggyBankPurchaseRedemption, err error) {
 	p := "/api/v5/asset/purchase_redempt"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodPost, p, true, m)
 	if err != nil {
 		return
 	}
@@ -182,10 +183,10 @@ func (c *Funding) PiggyBankPurchaseRedemption(req requests.PiggyBankPurchaseRede
 // GetPiggyBankBalance
 //
 // https://www.okex.com/docs-v5/en/#rest-api-funding-get-piggybank-balance
-func (c *Funding) GetPiggyBankBalance(req requests.GetPiggyBankBalance) (response responses.GetPiggyBankBalance, err error) {
+func (c *Funding) GetPiggyBankBalance(ctx context.Context, req requests.GetPiggyBankBalance) (response responses.GetPiggyBankBalance, err error) {
 	p := "/api/v5/asset/piggy-balance"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
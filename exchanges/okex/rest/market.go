@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -25,10 +26,10 @@ func NewMarket(c *ClientRest) *Market {
 // Retrieve the latest price snapshot, best bid/ask price, and trading volume in the last 24 hours.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-market-data-get-tickers
-func (c *Market) GetTickers(req requests.GetTickers) (response responses.Ticker, err error) {
+func (c *Market) GetTickers(ctx context.Context, req requests.GetTickers) (response responses.Ticker, err error) {
 	p := "/api/v5/market/tickers"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -42,10 +43,10 @@ func (c *Market) GetTickers(req requests.GetTickers) (response responses.Ticker,
 // Retrieve the latest price snapshot, best bid/ask price, and trading volume in the last 24 hours.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-market-data-get-ticker
-func (c *Market) GetTicker(req requests.GetTickers) (response responses.Ticker, err error) {
+func (c *Market) GetTicker(ctx context.Context, req requests.GetTickers) (response responses.Ticker, err error) {
 	p := "/api/v5/market/ticker"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -59,10 +60,10 @@ func (c *Market) GetTicker(req requests.GetTickers) (response responses.Ticker,
 // Retrieve index tickers.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-market-data-get-index-tickers
-func (c *Market) GetIndexTickers(req requests.GetIndexTickers) (response responses.Ticker, err error) {
-	p := "/api/v5/market/ticker"
+func (c *Market) GetIndexTickers(ctx context.Context, req requests.GetIndexTickers) (response responses.IndexTicker, err error) {
+	p := "/api/v5/market/index-tickers"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -76,10 +77,10 @@ func (c *Market) GetIndexTickers(req requests.GetIndexTickers) (response respons
 // Retrieve a instrument is order book.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-market-data-get-order-book
-func (c *Market) GetOrderBook(req requests.GetOrderBook) (response responses.OrderBook, err error) {
+func (c *Market) GetOrderBook(ctx context.Context, req requests.GetOrderBook) (response responses.OrderBook, err error) {
 	p := "/api/v5/market/books"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -93,10 +94,10 @@ func (c *Market) GetOrderBook(req requests.GetOrderBook) (response responses.Ord
 // Retrieve the candlestick charts. This endpoint can retrieve the latest 1,440 data entries. Charts are returned in groups based on the requested bar.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-market-data-get-candlesticks
-func (c *Market) GetCandlesticks(req requests.GetCandlesticks) (response responses.Candle, err error) {
+func (c *Market) GetCandlesticks(ctx context.Context, req requests.GetCandlesticks) (response responses.Candle, err error) {
 	p := "/api/v5/market/candles"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -110,10 +111,10 @@ func (c *Market) GetCandlesticks(req requests.GetCandlesticks) (response respons
 // Retrieve history candlestick charts from recent years.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-market-data-get-candlesticks
-func (c *Market) GetCandlesticksHistory(req requests.GetCandlesticks) (response responses.Candle, err error) {
+func (c *Market) GetCandlesticksHistory(ctx context.Context, req requests.GetCandlesticks) (response responses.Candle, err error) {
 	p := "/api/v5/market/history-candles"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -127,10 +128,10 @@ func (c *Market) GetCandlesticksHistory(req requests.GetCandlesticks) (response
 // Retrieve the candlestick charts of the index. This endpoint can retrieve the latest 1,440 data entries. Charts are returned in groups based on the requested bar.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-market-data-get-index-candlesticks
-func (c *Market) GetIndexCandlesticks(req requests.GetCandlesticks) (response responses.IndexCandle, err error) {
+func (c *Market) GetIndexCandlesticks(ctx context.Context, req requests.GetCandlesticks) (response responses.IndexCandle, err error) {
 	p := "/api/v5/market/index-candles"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -144,10 +145,10 @@ func (c *Market) GetIndexCandlesticks(req requests.GetCandlesticks) (response re
 // Retrieve the candlestick charts of mark price. This endpoint can retrieve the latest 1,440 data entries. Charts are returned in groups based on the requested bar.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-market-data-get-mark-price-candlesticks
-func (c *Market) GetMarkPriceCandlesticks(req requests.GetCandlesticks) (response responses.CandleMarket, err error) {
+func (c *Market) GetMarkPriceCandlesticks(ctx context.Context, req requests.GetCandlesticks) (response responses.CandleMarket, err error) {
 	p := "/api/v5/market/mark-price-candles"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -161,10 +162,10 @@ func (c *Market) GetMarkPriceCandlesticks(req requests.GetCandlesticks) (respons
 // Retrieve the recent transactions of an instrument.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-market-data-get-trades
-func (c *Market) GetTrades(req requests.GetTrades) (response responses.Trade, err error) {
+func (c *Market) GetTrades(ctx context.Context, req requests.GetTrades) (response responses.Trade, err error) {
 	p := "/api/v5/market/trades"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -178,9 +179,9 @@ func (c *Market) GetTrades(req requests.GetTrades) (response responses.Trade, er
 // The 24-hour trading volume is calculated on a rolling basis, using USD as the pricing unit.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-market-data-get-24h-total-volume
-func (c *Market) Get24HTotalVolume() (response responses.TotalVolume24H, err error) {
+func (c *Market) Get24HTotalVolume(ctx context.Context) (response responses.TotalVolume24H, err error) {
 	p := "/api/v5/market/platform-24-volume"
-	res, err := c.client.Do(http.MethodGet, p, false)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false)
 	if err != nil {
 		return
 	}
@@ -194,10 +195,10 @@ func (c *Market) Get24HTotalVolume() (response responses.TotalVolume24H, err err
 // Get the index component information data on the market
 //
 // https://www.okex.com/docs-v5/en/#rest-api-market-data-get-index-components
-func (c *Market) GetIndexComponents(req requests.GetIndexComponents) (response responses.IndexComponent, err error) {
+func (c *Market) GetIndexComponents(ctx context.Context, req requests.GetIndexComponents) (response responses.IndexComponent, err error) {
 	p := "/api/v5/market/index-components"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
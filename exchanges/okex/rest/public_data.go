@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -25,10 +26,10 @@ func NewPublicData(c *ClientRest) *PublicData {
 // Retrieve a list of instruments with open contracts.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-instruments
-func (c *PublicData) GetInstruments(req requests.GetInstruments) (response responses.GetInstruments, err error) {
+func (c *PublicData) GetInstruments(ctx context.Context, req requests.GetInstruments) (response responses.GetInstruments, err error) {
 	p := "/api/v5/public/instruments"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -42,10 +43,10 @@ func (c *PublicData) GetInstruments(req requests.GetInstruments) (response respo
 // Retrieve the estimated delivery price, which will only have a return value one hour before the delivery/exercise.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-instruments
-func (c *PublicData) GetDeliveryExerciseHistory(req requests.GetDeliveryExerciseHistory) (response responses.GetDeliveryExerciseHistory, err error) {
+func (c *PublicData) GetDeliveryExerciseHistory(ctx context.Context, req requests.GetDeliveryExerciseHistory) (response responses.GetDeliveryExerciseHistory, err error) {
 	p := "/api/v5/public/delivery-exercise-history"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -59,10 +60,44 @@ func (c *PublicData) GetDeliveryExerciseHistory(req requests.GetDeliveryExercise
 // Retrieve the total open interest for contracts on OKEx.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-open-interest
-func (c *PublicData) GetOpenInterest(req requests.GetOpenInterest) (response responses.GetOpenInterest, err error) {
+func (c *PublicData) GetOpenInterest(ctx context.Context, req requests.GetOpenInterest) (response responses.GetOpenInterest, err error) {
 	p := "/api/v5/public/open-interest"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+	return
+}
+
+// GetFundingRate
+// Retrieve funding rate.
+//
+// https://www.okex.com/docs-v5/en/#rest-api-public-data-get-funding-rate
+func (c *PublicData) GetFundingRate(ctx context.Context, req requests.GetFundingRate) (response responses.GetFundingRate, err error) {
+	p := "/api/v5/public/funding-rate"
+	m := utils.S2M(req)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+	return
+}
+
+// GetFundingRateHistory
+// Retrieve funding rate history. This endpoint can retrieve data from the last 3 months.
+//
+// https://www.okex.com/docs-v5/en/#rest-api-public-data-get-funding-rate-history
+func (c *PublicData) GetFundingRateHistory(ctx context.Context, req requests.GetFundingRateHistory) (response responses.GetFundingRateHistory, err error) {
+	p := "/api/v5/public/funding-rate-history"
+	m := utils.S2M(req)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -76,10 +111,10 @@ func (c *PublicData) GetOpenInterest(req requests.GetOpenInterest) (response res
 // Retrieve the highest buy limit and lowest sell limit of the instrument.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-limit-price
-func (c *PublicData) GetLimitPrice(req requests.GetLimitPrice) (response responses.GetLimitPrice, err error) {
+func (c *PublicData) GetLimitPrice(ctx context.Context, req requests.GetLimitPrice) (response responses.GetLimitPrice, err error) {
 	p := "/api/v5/public/price-limit"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -93,10 +128,10 @@ func (c *PublicData) GetLimitPrice(req requests.GetLimitPrice) (response respons
 // Retrieve option market data.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-option-market-data
-func (c *PublicData) GetOptionMarketData(req requests.GetOptionMarketData) (response responses.GetOptionMarketData, err error) {
+func (c *PublicData) GetOptionMarketData(ctx context.Context, req requests.GetOptionMarketData) (response responses.GetOptionMarketData, err error) {
 	p := "/api/v5/public/opt-summary"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -110,10 +145,10 @@ func (c *PublicData) GetOptionMarketData(req requests.GetOptionMarketData) (resp
 // Retrieve the estimated delivery price which will only have a return value one hour before the delivery/exercise.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-estimated-delivery-Exercise-price
-func (c *PublicData) GetEstimatedDeliveryExercisePrice(req requests.GetEstimatedDeliveryExercisePrice) (response responses.GetEstimatedDeliveryExercisePrice, err error) {
+func (c *PublicData) GetEstimatedDeliveryExercisePrice(ctx context.Context, req requests.GetEstimatedDeliveryExercisePrice) (response responses.GetEstimatedDeliveryExercisePrice, err error) {
 	p := "/api/v5/public/estimated-price"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -127,10 +162,10 @@ func (c *PublicData) GetEstimatedDeliveryExercisePrice(req requests.GetEstimated
 // Retrieve discount rate level and interest-free quota.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-discount-rate-and-interest-free-quota
-func (c *PublicData) GetDiscountRateAndInterestFreeQuota(req requests.GetDiscountRateAndInterestFreeQuota) (response responses.GetDiscountRateAndInterestFreeQuota, err error) {
+func (c *PublicData) GetDiscountRateAndInterestFreeQuota(ctx context.Context, req requests.GetDiscountRateAndInterestFreeQuota) (response responses.GetDiscountRateAndInterestFreeQuota, err error) {
 	p := "/api/v5/public/discount-rate-interest-free-quota"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -144,9 +179,9 @@ func (c *PublicData) GetDiscountRateAndInterestFreeQuota(req requests.GetDiscoun
 // Retrieve API server time.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-system-time
-func (c *PublicData) GetSystemTime() (response responses.GetSystemTime, err error) {
+func (c *PublicData) GetSystemTime(ctx context.Context) (response responses.GetSystemTime, err error) {
 	p := "/api/v5/public/time"
-	res, err := c.client.Do(http.MethodGet, p, false)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false)
 	if err != nil {
 		return
 	}
@@ -160,10 +195,10 @@ func (c *PublicData) GetSystemTime() (response responses.GetSystemTime, err erro
 // Retrieve information on liquidation orders in the last 7 days.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-liquidation-orders
-func (c *PublicData) GetLiquidationOrders(req requests.GetLiquidationOrders) (response responses.GetLiquidationOrders, err error) {
+func (c *PublicData) GetLiquidationOrders(ctx context.Context, req requests.GetLiquidationOrders) (response responses.GetLiquidationOrders, err error) {
 	p := "/api/v5/public/liquidation-orders"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -179,10 +214,10 @@ func (c *PublicData) GetLiquidationOrders(req requests.GetLiquidationOrders) (re
 // We set the mark price based on the SPOT index and at a reasonable basis to prevent individual users from manipulating the market and causing the contract price to fluctuate.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-mark-price
-func (c *PublicData) GetMarkPrice(req requests.GetMarkPrice) (response responses.GetMarkPrice, err error) {
+func (c *PublicData) GetMarkPrice(ctx context.Context, req requests.GetMarkPrice) (response responses.GetMarkPrice, err error) {
 	p := "/api/v5/public/mark-price"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -196,10 +231,10 @@ func (c *PublicData) GetMarkPrice(req requests.GetMarkPrice) (response responses
 // Position information，Maximum leverage depends on your borrowings and margin ratio.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-position-tiers
-func (c *PublicData) GetPositionTiers(req requests.GetPositionTiers) (response responses.GetPositionTiers, err error) {
+func (c *PublicData) GetPositionTiers(ctx context.Context, req requests.GetPositionTiers) (response responses.GetPositionTiers, err error) {
 	p := "/api/v5/public/position-tiers"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -213,9 +248,9 @@ func (c *PublicData) GetPositionTiers(req requests.GetPositionTiers) (response r
 // Get margin interest rate
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-position-tiers
-func (c *PublicData) GetInterestRateAndLoanQuota() (response responses.GetInterestRateAndLoanQuota, err error) {
+func (c *PublicData) GetInterestRateAndLoanQuota(ctx context.Context) (response responses.GetInterestRateAndLoanQuota, err error) {
 	p := "/api/v5/public/interest-rate-loan-quota"
-	res, err := c.client.Do(http.MethodGet, p, false)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false)
 	if err != nil {
 		return
 	}
@@ -228,10 +263,10 @@ func (c *PublicData) GetInterestRateAndLoanQuota() (response responses.GetIntere
 // GetUnderlying
 //
 // https://www.okex.com/docs-v5/en/#rest-api-public-data-get-underlying
-func (c *PublicData) GetUnderlying(req requests.GetUnderlying) (response responses.GetUnderlying, err error) {
+func (c *PublicData) GetUnderlying(ctx context.Context, req requests.GetUnderlying) (response responses.GetUnderlying, err error) {
 	p := "/api/v5/public/underlying"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,114 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/djpken/go-exc/ratelimit"
+)
+
+// RateLimits are the OKX per-endpoint rate limits, keyed by "METHOD path".
+// Trading and account endpoints are limited per user ID, market and public
+// data endpoints per IP. Batch endpoints count one unit per order.
+//
+// https://www.okx.com/docs-v5/en/#overview-rate-limits
+var RateLimits = map[string]ratelimit.Rule{
+	// Trade
+	"POST /api/v5/trade/order":                 {Limit: 60, Window: 2 * time.Second},
+	"POST /api/v5/trade/batch-orders":          {Limit: 300, Window: 2 * time.Second},
+	"POST /api/v5/trade/cancel-order":          {Limit: 60, Window: 2 * time.Second},
+	"POST /api/v5/trade/cancel-batch-orders":   {Limit: 300, Window: 2 * time.Second},
+	"POST /api/v5/trade/amend-order":           {Limit: 60, Window: 2 * time.Second},
+	"POST /api/v5/trade/amend-batch-orders":    {Limit: 300, Window: 2 * time.Second},
+	"POST /api/v5/trade/close-position":        {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/trade/order":                  {Limit: 60, Window: 2 * time.Second},
+	"GET /api/v5/trade/orders-pending":         {Limit: 60, Window: 2 * time.Second},
+	"GET /api/v5/trade/orders-history":         {Limit: 40, Window: 2 * time.Second},
+	"GET /api/v5/trade/orders-history-archive": {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/trade/fills":                  {Limit: 60, Window: 2 * time.Second},
+	"GET /api/v5/trade/fills-history":          {Limit: 10, Window: 2 * time.Second},
+	"POST /api/v5/trade/order-algo":            {Limit: 20, Window: 2 * time.Second},
+	"POST /api/v5/trade/cancel-algos":          {Limit: 20, Window: 2 * time.Second},
+	"POST /api/v5/trade/cancel-advance-algos":  {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/trade/orders-algo-pending":    {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/trade/orders-algo-history":    {Limit: 20, Window: 2 * time.Second},
+	"POST /api/v5/trade/order-precheck":        {Limit: 5, Window: 2 * time.Second},
+
+	// Account
+	"POST /api/v5/account/set-leverage":            {Limit: 20, Window: 2 * time.Second},
+	"POST /api/v5/account/set-position-mode":       {Limit: 5, Window: 2 * time.Second},
+	"POST /api/v5/account/position/margin-balance": {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/account/balance":                  {Limit: 10, Window: 2 * time.Second},
+	"GET /api/v5/account/positions":                {Limit: 10, Window: 2 * time.Second},
+	"GET /api/v5/account/config":                   {Limit: 5, Window: 2 * time.Second},
+	"GET /api/v5/account/leverage-info":            {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/account/bills":                    {Limit: 5, Window: time.Second},
+	"GET /api/v5/account/max-size":                 {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/account/max-avail-size":           {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/account/trade-fee":                {Limit: 5, Window: 2 * time.Second},
+	"GET /api/v5/asset/balances":                   {Limit: 6, Window: time.Second},
+	"POST /api/v5/asset/transfer":                  {Limit: 1, Window: time.Second},
+	"POST /api/v5/asset/withdrawal":                {Limit: 6, Window: time.Second},
+	"GET /api/v5/asset/deposit-address":            {Limit: 6, Window: time.Second},
+	"GET /api/v5/asset/withdrawal-history":         {Limit: 6, Window: time.Second},
+
+	// Market and public data
+	"GET /api/v5/market/ticker":               {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/market/tickers":              {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/market/books":                {Limit: 40, Window: 2 * time.Second},
+	"GET /api/v5/market/candles":              {Limit: 40, Window: 2 * time.Second},
+	"GET /api/v5/market/history-candles":      {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/market/trades":               {Limit: 100, Window: 2 * time.Second},
+	"GET /api/v5/market/index-tickers":        {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/public/instruments":          {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/public/mark-price":           {Limit: 10, Window: 2 * time.Second},
+	"GET /api/v5/public/funding-rate":         {Limit: 20, Window: 2 * time.Second},
+	"GET /api/v5/public/funding-rate-history": {Limit: 10, Window: 2 * time.Second},
+	"GET /api/v5/public/time":                 {Limit: 10, Window: 2 * time.Second},
+	"GET /api/v5/system/status":               {Limit: 1, Window: 5 * time.Second},
+}
+
+// SetRateLimiter replaces the rate limiter. A limiter created with
+// ratelimit.New(nil) disables limiting.
+func (c *ClientRest) SetRateLimiter(limiter *ratelimit.Limiter) {
+	c.limiter = limiter
+}
+
+// RateLimiter returns the rate limiter, e.g. to monitor its headroom
+func (c *ClientRest) RateLimiter() *ratelimit.Limiter {
+	return c.limiter
+}
+
+// wait blocks until weight n can be sent on key, recording any wait
+func (c *ClientRest) wait(ctx context.Context, key string, n int) error {
+	d, err := c.limiter.WaitTimed(ctx, key, n)
+	if d > 0 {
+		c.metrics.RateLimitWait(exchangeName, key, d)
+	}
+	return err
+}
+
+// rateLimitKey returns the RateLimits key of an endpoint
+func rateLimitKey(method, path string) string {
+	return method + " " + path
+}
+
+// rateLimitWeight returns the weight of a request: the number of orders for
+// batch payloads, one otherwise
+func rateLimitWeight(payload interface{}) int {
+	if v := reflect.ValueOf(payload); v.Kind() == reflect.Slice && v.Len() > 0 {
+		return v.Len()
+	}
+	return 1
+}
+
+// observeRateLimit blocks the endpoint after OKX rejected a request for
+// exceeding its limit (HTTP 429, code 50011)
+func (c *ClientRest) observeRateLimit(key string, res *http.Response) {
+	if res.StatusCode == http.StatusTooManyRequests {
+		c.logger.Warn("rate limit exceeded", "endpoint", key)
+		c.limiter.Sync(key, 0, 0)
+	}
+}
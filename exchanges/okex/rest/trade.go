@@ -1,14 +1,23 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 
+	"github.com/djpken/go-exc/exchanges/okex/constants"
+	models "github.com/djpken/go-exc/exchanges/okex/models/trade"
 	requests "github.com/djpken/go-exc/exchanges/okex/requests/rest/trade"
 	responses "github.com/djpken/go-exc/exchanges/okex/responses/trade"
 	"github.com/djpken/go-exc/exchanges/okex/utils"
+	"github.com/djpken/go-exc/retry"
 )
 
+// codeOrderNotExist is the OKX error code for an unknown order
+const codeOrderNotExist = 51603
+
 // Trade
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade
@@ -23,36 +32,82 @@ func NewTrade(c *ClientRest) *Trade {
 
 // PlaceOrder
 // You can place an order only if you have sufficient funds.
+// A single order with a ClOrdID is retried on transient failures once
+// GetOrderDetail confirms the failed attempt did not place it.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-get-positions
-func (c *Trade) PlaceOrder(req []requests.PlaceOrder) (response responses.PlaceOrder, err error) {
+func (c *Trade) PlaceOrder(ctx context.Context, req []requests.PlaceOrder) (response responses.PlaceOrder, err error) {
 	p := "/api/v5/trade/order"
+	// The order is sent as JSON directly so that nested attachAlgoOrds survive
 	var tmp interface{}
 	tmp = req[0]
 	if len(req) > 1 {
 		tmp = req
-		p = "/api/trade/batch-orders"
+		p = "/api/v5/trade/batch-orders"
 	}
-	m := utils.S2M(tmp)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
-	if err != nil {
+	for attempt := 1; ; attempt++ {
+		var res *http.Response
+		res, err = c.client.DoJSON(ctx, http.MethodPost, p, true, tmp)
+		if len(req) == 1 && req[0].ClOrdID != "" && c.client.retry.Retry(attempt) && retry.Transient(res, err) {
+			if res != nil {
+				res.Body.Close()
+				err = fmt.Errorf("okex: place order %s: http %d", req[0].ClOrdID, res.StatusCode)
+			}
+			c.client.logger.Warn("order failed, confirming before retry", "symbol", req[0].InstID, "clOrdId", req[0].ClOrdID, "attempt", attempt, "error", err)
+			// Only retry once GetOrderDetail confirms the order was not placed
+			if sleepErr := c.client.retry.Sleep(ctx, attempt); sleepErr != nil {
+				return response, err
+			}
+			placed, absent := c.confirmOrder(ctx, req[0])
+			if placed != nil {
+				return *placed, nil
+			}
+			if !absent {
+				return response, err
+			}
+			continue
+		}
+		if err != nil {
+			return
+		}
+		defer res.Body.Close()
+		d := json.NewDecoder(res.Body)
+		err = d.Decode(&response)
 		return
 	}
-	defer res.Body.Close()
-	d := json.NewDecoder(res.Body)
-	err = d.Decode(&response)
+}
 
-	return
+// confirmOrder looks up a single order by its client order ID after a failed
+// placement. It returns the placement result when the order exists, and
+// absent when OKX reports it does not exist.
+func (c *Trade) confirmOrder(ctx context.Context, req requests.PlaceOrder) (placed *responses.PlaceOrder, absent bool) {
+	detail, err := c.GetOrderDetail(ctx, requests.OrderDetails{InstID: req.InstID, ClOrdID: req.ClOrdID})
+	if err != nil {
+		return nil, false
+	}
+	if detail.Code == codeOrderNotExist {
+		return nil, true
+	}
+	if detail.Code != 0 || len(detail.Orders) == 0 {
+		return nil, false
+	}
+	ordID, _ := strconv.ParseFloat(detail.Orders[0].OrdID, 64)
+	return &responses.PlaceOrder{
+		PlaceOrders: []*models.PlaceOrder{{
+			ClOrdID: detail.Orders[0].ClOrdID,
+			Tag:     detail.Orders[0].Tag,
+			OrdID:   constants.JSONFloat64(ordID),
+		}},
+	}, false
 }
 
 // PlaceMultipleOrders
 // Cancel an incomplete order.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-place-multiple-orders
-func (c *Trade) PlaceMultipleOrders(req []requests.PlaceOrder) (response responses.PlaceOrder, err error) {
-	p := "/api/v5/trade/batch-order"
-	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+func (c *Trade) PlaceMultipleOrders(ctx context.Context, req []requests.PlaceOrder) (response responses.PlaceOrder, err error) {
+	p := "/api/v5/trade/batch-orders"
+	res, err := c.client.DoJSON(ctx, http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -71,16 +126,16 @@ func (c *Trade) PlaceMultipleOrders(req []requests.PlaceOrder) (response respons
 // Cancel incomplete orders in batches. Maximum 20 orders can be canceled at a time. Request parameters should be passed in the form of an array.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-cancel-multiple-orders
-func (c *Trade) CandleOrder(req []requests.CancelOrder) (response responses.PlaceOrder, err error) {
+func (c *Trade) CandleOrder(ctx context.Context, req []requests.CancelOrder) (response responses.PlaceOrder, err error) {
 	p := "/api/v5/trade/cancel-order"
 	var tmp interface{}
-	tmp = req[0]
+	tmp = utils.S2M(req[0])
 	if len(req) > 1 {
+		// Batch endpoints take a JSON array, which S2M cannot represent
 		tmp = req
-		p = "/api/trade/cancel-batch-orders"
+		p = "/api/v5/trade/cancel-batch-orders"
 	}
-	m := utils.S2M(tmp)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.DoJSON(ctx, http.MethodPost, p, true, tmp)
 	if err != nil {
 		return
 	}
@@ -98,16 +153,16 @@ func (c *Trade) CandleOrder(req []requests.CancelOrder) (response responses.Plac
 // Amend incomplete orders in batches. Maximum 20 orders can be amended at a time. Request parameters should be passed in the form of an array.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-amend-multiple-orders
-func (c *Trade) AmendOrder(req []requests.OrderList) (response responses.AmendOrder, err error) {
+func (c *Trade) AmendOrder(ctx context.Context, req []requests.AmendOrder) (response responses.AmendOrder, err error) {
 	p := "/api/v5/trade/amend-order"
+	// The order is sent as JSON directly so that the boolean cxlOnFail survives
 	var tmp interface{}
 	tmp = req[0]
 	if len(req) > 1 {
 		tmp = req
-		p = "/api/trade/amend-batch-orders"
+		p = "/api/v5/trade/amend-batch-orders"
 	}
-	m := utils.S2M(tmp)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.DoJSON(ctx, http.MethodPost, p, true, tmp)
 	if err != nil {
 		return
 	}
@@ -121,10 +176,10 @@ func (c *Trade) AmendOrder(req []requests.OrderList) (response responses.AmendOr
 // Close all positions of an instrument via a market order.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-close-positions
-func (c *Trade) ClosePosition(req requests.ClosePosition) (response responses.ClosePosition, err error) {
+func (c *Trade) ClosePosition(ctx context.Context, req requests.ClosePosition) (response responses.ClosePosition, err error) {
 	p := "/api/v5/trade/close-position"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodPost, p, true, m)
 	if err != nil {
 		return
 	}
@@ -138,10 +193,10 @@ func (c *Trade) ClosePosition(req requests.ClosePosition) (response responses.Cl
 // Retrieve order details.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-get-order-details
-func (c *Trade) GetOrderDetail(req requests.OrderDetails) (response responses.OrderList, err error) {
+func (c *Trade) GetOrderDetail(ctx context.Context, req requests.OrderDetails) (response responses.OrderList, err error) {
 	p := "/api/v5/trade/order"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -155,10 +210,10 @@ func (c *Trade) GetOrderDetail(req requests.OrderDetails) (response responses.Or
 // Retrieve all incomplete orders under the current account.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-get-order-list
-func (c *Trade) GetOrderList(req requests.OrderList) (response responses.OrderList, err error) {
+func (c *Trade) GetOrderList(ctx context.Context, req requests.OrderList) (response responses.OrderList, err error) {
 	p := "/api/v5/trade/orders-pending"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -175,13 +230,13 @@ func (c *Trade) GetOrderList(req requests.OrderList) (response responses.OrderLi
 //
 // Retrieve the completed order data of the last 3 months, and the incomplete orders that have been canceled are only reserved for 2 hours.
 // https://www.okex.com/docs-v5/en/#rest-api-trade-get-order-history-last-3-months
-func (c *Trade) GetOrderHistory(req requests.OrderList, arch bool) (response responses.OrderList, err error) {
+func (c *Trade) GetOrderHistory(ctx context.Context, req requests.OrderList, arch bool) (response responses.OrderList, err error) {
 	p := "/api/v5/trade/orders-history"
 	if arch {
-		p = "/api/trade/orders-history-archive"
+		p = "/api/v5/trade/orders-history-archive"
 	}
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -199,13 +254,13 @@ func (c *Trade) GetOrderHistory(req requests.OrderList, arch bool) (response res
 // Retrieve recently-filled transaction details in the last 3 months.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-get-transaction-details-last-3-months
-func (c *Trade) GetTransactionDetails(req requests.TransactionDetails, arch bool) (response responses.TransactionDetail, err error) {
+func (c *Trade) GetTransactionDetails(ctx context.Context, req requests.TransactionDetails, arch bool) (response responses.TransactionDetail, err error) {
 	p := "/api/v5/trade/fills"
 	if arch {
-		p = "/api/trade/fills-history"
+		p = "/api/v5/trade/fills-history"
 	}
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -221,10 +276,10 @@ func (c *Trade) GetTransactionDetails(req requests.TransactionDetails, arch bool
 // `iceberg` order and `twap` order just supported on demo trading
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-place-algo-order
-func (c *Trade) PlaceAlgoOrder(req requests.PlaceAlgoOrder) (response responses.PlaceAlgoOrder, err error) {
+func (c *Trade) PlaceAlgoOrder(ctx context.Context, req requests.PlaceAlgoOrder) (response responses.PlaceAlgoOrder, err error) {
 	p := "/api/v5/trade/order-algo"
-	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	// Sent as JSON directly so that boolean fields such as reduceOnly survive
+	res, err := c.client.DoJSON(ctx, http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -239,10 +294,9 @@ func (c *Trade) PlaceAlgoOrder(req requests.PlaceAlgoOrder) (response responses.
 // Cancel unfilled algo orders(trigger order, oco order, conditional order). A maximum of 10 orders can be canceled at a time. Request parameters should be passed in the form of an array.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-cancel-algo-order
-func (c *Trade) CancelAlgoOrder(req requests.CancelAlgoOrder) (response responses.CancelAlgoOrder, err error) {
+func (c *Trade) CancelAlgoOrder(ctx context.Context, req []requests.CancelAlgoOrder) (response responses.CancelAlgoOrder, err error) {
 	p := "/api/v5/trade/cancel-algos"
-	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.DoJSON(ctx, http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -259,10 +313,9 @@ func (c *Trade) CancelAlgoOrder(req requests.CancelAlgoOrder) (response response
 // # Only released on demo trading
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-cancel-advance-algo-order
-func (c *Trade) CancelAdvanceAlgoOrder(req requests.CancelAlgoOrder) (response responses.CancelAlgoOrder, err error) {
+func (c *Trade) CancelAdvanceAlgoOrder(ctx context.Context, req []requests.CancelAlgoOrder) (response responses.CancelAlgoOrder, err error) {
 	p := "/api/v5/trade/cancel-advance-algos"
-	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.DoJSON(ctx, http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -285,13 +338,13 @@ func (c *Trade) CancelAdvanceAlgoOrder(req requests.CancelAlgoOrder) (response r
 // `iceberg` order and `twap` order just supported on demo trading
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trade-get-algo-order-history
-func (c *Trade) GetAlgoOrderList(req requests.AlgoOrderList, arch bool) (response responses.AlgoOrderList, err error) {
+func (c *Trade) GetAlgoOrderList(ctx context.Context, req requests.AlgoOrderList, arch bool) (response responses.AlgoOrderList, err error) {
 	p := "/api/v5/trade/orders-algo-pending"
 	if arch {
-		p = "/api/trade/orders-algo-history"
+		p = "/api/v5/trade/orders-algo-history"
 	}
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, true, m)
 	if err != nil {
 		return
 	}
@@ -301,10 +354,10 @@ func (c *Trade) GetAlgoOrderList(req requests.AlgoOrderList, arch bool) (respons
 
 	return
 }
-func (c *Trade) OrderPreCheck(req requests.OrderPreCheck) (response responses.OrderPreCheck, err error) {
+func (c *Trade) OrderPreCheck(ctx context.Context, req requests.OrderPreCheck) (response responses.OrderPreCheck, err error) {
 	p := "/api/v5/trade/order-precheck"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(ctx, http.MethodPost, p, true, m)
 	if err != nil {
 		return
 	}
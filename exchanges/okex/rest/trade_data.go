@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -25,9 +26,9 @@ func NewTradeData(c *ClientRest) *TradeData {
 // Get the currency supported by the transaction big data interface
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trading-data-get-support-coin
-func (c *TradeData) GetSupportCoin() (response responses.GetSupportCoin, err error) {
+func (c *TradeData) GetSupportCoin(ctx context.Context) (response responses.GetSupportCoin, err error) {
 	p := "/api/v5/rubik/stat/trading-data/support-coin"
-	res, err := c.client.Do(http.MethodGet, p, false)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false)
 	if err != nil {
 		return
 	}
@@ -41,10 +42,10 @@ func (c *TradeData) GetSupportCoin() (response responses.GetSupportCoin, err err
 // This is the taker volume for both buyers and sellers. This shows the influx and exit of funds in and out of {coin}.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trading-data-get-support-coin
-func (c *TradeData) GetTakerVolume(req requests.GetTakerVolume) (response responses.GetTakerVolume, err error) {
+func (c *TradeData) GetTakerVolume(ctx context.Context, req requests.GetTakerVolume) (response responses.GetTakerVolume, err error) {
 	p := "/api/v5/rubik/stat/taker-volume"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -58,10 +59,10 @@ func (c *TradeData) GetTakerVolume(req requests.GetTakerVolume) (response respon
 // This indicator shows the ratio of cumulative data value between currency pair leverage quote currency and underlying asset over a given period of time.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trading-data-get-margin-lending-ratio
-func (c *TradeData) GetMarginLendingRatio(req requests.GetRatio) (response responses.GetRatio, err error) {
+func (c *TradeData) GetMarginLendingRatio(ctx context.Context, req requests.GetRatio) (response responses.GetRatio, err error) {
 	p := "/api/v5/rubik/stat/margin/loan-ratio"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -75,10 +76,10 @@ func (c *TradeData) GetMarginLendingRatio(req requests.GetRatio) (response respo
 // This is the ratio of users with net long vs short positions. It includes data from futures and perpetual swaps.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trading-data-get-long-short-ratio
-func (c *TradeData) GetLongShortRatio(req requests.GetRatio) (response responses.GetRatio, err error) {
+func (c *TradeData) GetLongShortRatio(ctx context.Context, req requests.GetRatio) (response responses.GetRatio, err error) {
 	p := "/api/v5/rubik/stat/contracts/long-short-account-ratio"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -92,10 +93,10 @@ func (c *TradeData) GetLongShortRatio(req requests.GetRatio) (response responses
 // Open interest is the sum of all long and short futures and perpetual swap positions.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trading-data-get-contracts-open-interest-and-volume
-func (c *TradeData) GetContractsOpenInterestAndVolume(req requests.GetRatio) (response responses.GetOpenInterestAndVolume, err error) {
+func (c *TradeData) GetContractsOpenInterestAndVolume(ctx context.Context, req requests.GetRatio) (response responses.GetOpenInterestAndVolume, err error) {
 	p := "/api/v5/rubik/stat/contracts/open-interest-volume"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -109,10 +110,10 @@ func (c *TradeData) GetContractsOpenInterestAndVolume(req requests.GetRatio) (re
 // This shows the sum of all open positions and how much total trading volume has taken place.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trading-data-get-options-open-interest-and-volume
-func (c *TradeData) GetOptionsOpenInterestAndVolume(req requests.GetRatio) (response responses.GetOpenInterestAndVolume, err error) {
+func (c *TradeData) GetOptionsOpenInterestAndVolume(ctx context.Context, req requests.GetRatio) (response responses.GetOpenInterestAndVolume, err error) {
 	p := "/api/v5/rubik/stat/option/open-interest-volume"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -126,10 +127,10 @@ func (c *TradeData) GetOptionsOpenInterestAndVolume(req requests.GetRatio) (resp
 // This shows the relative buy/sell volume for calls and puts. It shows whether traders are bullish or bearish on price and volatility.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trading-data-get-put-call-ratio
-func (c *TradeData) GetPutCallRatio(req requests.GetRatio) (response responses.GetPutCallRatio, err error) {
+func (c *TradeData) GetPutCallRatio(ctx context.Context, req requests.GetRatio) (response responses.GetPutCallRatio, err error) {
 	p := "/api/v5/rubik/stat/option/open-interest-volume-ratio"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -143,10 +144,10 @@ func (c *TradeData) GetPutCallRatio(req requests.GetRatio) (response responses.G
 // This shows the volume and open interest for each upcoming expiration. You can use this to see which expirations are currently the most popular to trade.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trading-data-get-open-interest-and-volume-expiry
-func (c *TradeData) GetOpenInterestAndVolumeExpiry(req requests.GetRatio) (response responses.GetOpenInterestAndVolumeExpiry, err error) {
+func (c *TradeData) GetOpenInterestAndVolumeExpiry(ctx context.Context, req requests.GetRatio) (response responses.GetOpenInterestAndVolumeExpiry, err error) {
 	p := "/api/v5/rubik/stat/option/open-interest-volume-expiry"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -160,10 +161,10 @@ func (c *TradeData) GetOpenInterestAndVolumeExpiry(req requests.GetRatio) (respo
 // This shows what option strikes are the most popular for each expiration.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trading-data-get-open-interest-and-volume-strike
-func (c *TradeData) GetOpenInterestAndVolumeStrike(req requests.GetOpenInterestAndVolumeStrike) (response responses.GetOpenInterestAndVolumeStrike, err error) {
+func (c *TradeData) GetOpenInterestAndVolumeStrike(ctx context.Context, req requests.GetOpenInterestAndVolumeStrike) (response responses.GetOpenInterestAndVolumeStrike, err error) {
 	p := "/api/v5/rubik/stat/option/open-interest-volume-strike"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
@@ -177,10 +178,10 @@ func (c *TradeData) GetOpenInterestAndVolumeStrike(req requests.GetOpenInterestA
 // This shows the relative buy/sell volume for calls and puts. It shows whether traders are bullish or bearish on price and volatility.
 //
 // https://www.okex.com/docs-v5/en/#rest-api-trading-data-get-taker-flow
-func (c *TradeData) GetTakerFlow(req requests.GetRatio) (response responses.GetTakerFlow, err error) {
+func (c *TradeData) GetTakerFlow(ctx context.Context, req requests.GetRatio) (response responses.GetTakerFlow, err error) {
 	p := "/api/v5/rubik/stat/option/taker-block-volume"
 	m := utils.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(ctx, http.MethodGet, p, false, m)
 	if err != nil {
 		return
 	}
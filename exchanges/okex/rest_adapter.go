@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	okexconstants "github.com/djpken/go-exc/exchanges/okex/constants"
+	"github.com/djpken/go-exc/exchanges/okex/models/account"
 	"github.com/djpken/go-exc/exchanges/okex/models/market"
 	accountreq "github.com/djpken/go-exc/exchanges/okex/requests/rest/account"
 	marketreq "github.com/djpken/go-exc/exchanges/okex/requests/rest/market"
@@ -18,14 +20,11 @@ import (
 
 // checkAPIError checks if the API response contains an error.
 // OKEx returns Code > 0 for errors. Returns *commontypes.APIError so callers
-// can use errors.As to inspect the Code field.
+// can use errors.As to inspect the Code field and errors.Is to match the
+// common error it maps to.
 func checkAPIError(basic responses.Basic) error {
 	if basic.Code != 0 && basic.Code != 1 && basic.Code != 2 {
-		return &commontypes.APIError{
-			Exchange: "OKEx",
-			Code:     basic.Code,
-			Message:  basic.Msg,
-		}
+		return newAPIError(basic.Code, basic.Msg)
 	}
 	return nil
 }
@@ -82,37 +81,75 @@ type TradeAPIAdapter struct {
 	converter *Converter
 }
 
-// PlaceOrder places a new order
-func (a *TradeAPIAdapter) PlaceOrder(ctx context.Context, placeOrderRequest commontypes.PlaceOrderRequest) (*commontypes.Order, error) {
-	extra := placeOrderRequest.Extra
-	if extra == nil {
-		extra = make(map[string]interface{})
-	}
-	// Build OKEx order request
+// toPlaceOrder builds the OKEx order request from a common PlaceOrderRequest
+// TakeProfit and StopLoss legs are sent as a single attachAlgoOrds entry.
+// Reduce-only is only honored for margin and derivatives orders.
+func (a *TradeAPIAdapter) toPlaceOrder(placeOrderRequest commontypes.PlaceOrderRequest) (tradereq.PlaceOrder, error) {
 	req := tradereq.PlaceOrder{
-		InstID:  placeOrderRequest.Symbol,
-		TdMode:  okexconstants.TradeMode(placeOrderRequest.TdMode),
-		Side:    okexconstants.OrderSide(placeOrderRequest.Side),
-		PosSide: okexconstants.PositionSide(placeOrderRequest.PosSide),
-		OrdType: okexconstants.OrderType(placeOrderRequest.Type),
-		Sz:      placeOrderRequest.Quantity,
-		ClOrdID: placeOrderRequest.ClientOrderID,
+		InstID:     placeOrderRequest.Symbol,
+		TdMode:     okexconstants.TradeMode(placeOrderRequest.TdMode),
+		Side:       okexconstants.OrderSide(placeOrderRequest.Side),
+		PosSide:    okexconstants.PositionSide(placeOrderRequest.PosSide),
+		Sz:         placeOrderRequest.Quantity.String(),
+		ClOrdID:    placeOrderRequest.ClientOrderID,
+		ReduceOnly: placeOrderRequest.ReduceOnly,
+	}
+
+	// Time in force and post-only are expressed through ordType
+	tif, err := commontypes.OrderTimeInForce(placeOrderRequest)
+	if err != nil {
+		return req, fmt.Errorf("okex: %w", err)
+	}
+	req.OrdType, err = a.converter.constantsConverter.ToOKExOrdType(commontypes.OrderType(placeOrderRequest.Type), tif)
+	if err != nil {
+		return req, err
+	}
+	if req.ReduceOnly && req.TdMode == okexconstants.TradeCashMode {
+		return req, fmt.Errorf("okex: reduce-only spot order: %w", commontypes.ErrNotSupported)
 	}
 
 	price := placeOrderRequest.Price
-	if price > 0 {
-		req.Px = price
+	if price.IsPositive() {
+		req.Px = price.String()
 	}
 
 	// Apply extra parameters
-	if extra != nil {
-		if tag, ok := extra["tag"].(string); ok {
-			req.Tag = tag
+	if tag, ok := placeOrderRequest.Extra["tag"].(string); ok {
+		req.Tag = tag
+	}
+
+	if tp, sl := placeOrderRequest.TakeProfit, placeOrderRequest.StopLoss; tp != nil || sl != nil {
+		var attach tradereq.AttachAlgoOrd
+		if tp != nil {
+			if !tp.TriggerPrice.IsPositive() {
+				return req, fmt.Errorf("okex: take-profit leg requires a trigger price")
+			}
+			attach.TpTriggerPx = tp.TriggerPrice.String()
+			attach.TpTriggerPxType = string(tp.TriggerPriceType)
+			attach.TpOrdPx = algoOrderPx(tp.OrderPrice)
+		}
+		if sl != nil {
+			if !sl.TriggerPrice.IsPositive() {
+				return req, fmt.Errorf("okex: stop-loss leg requires a trigger price")
+			}
+			attach.SlTriggerPx = sl.TriggerPrice.String()
+			attach.SlTriggerPxType = string(sl.TriggerPriceType)
+			attach.SlOrdPx = algoOrderPx(sl.OrderPrice)
 		}
+		req.AttachAlgoOrds = []tradereq.AttachAlgoOrd{attach}
+	}
+	return req, nil
+}
+
+// PlaceOrder places a new order
+func (a *TradeAPIAdapter) PlaceOrder(ctx context.Context, placeOrderRequest commontypes.PlaceOrderRequest) (*commontypes.Order, error) {
+	req, err := a.toPlaceOrder(placeOrderRequest)
+	if err != nil {
+		return nil, err
 	}
 
 	// Place order (requires slice)
-	resp, err := a.client.Trade.PlaceOrder([]tradereq.PlaceOrder{req})
+	resp, err := a.client.Trade.PlaceOrder(ctx, []tradereq.PlaceOrder{req})
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +165,7 @@ func (a *TradeAPIAdapter) PlaceOrder(ctx context.Context, placeOrderRequest comm
 
 	// Check if the order placement was successful
 	if resp.PlaceOrders[0].SCode != 0 {
-		return nil, fmt.Errorf("order placement failed: code=%d, msg=%s", resp.PlaceOrders[0].SCode, resp.PlaceOrders[0].SMsg)
+		return nil, fmt.Errorf("order placement failed: %w", newAPIError(int(resp.PlaceOrders[0].SCode), resp.PlaceOrders[0].SMsg))
 	}
 
 	// Convert to common order type
@@ -153,35 +190,13 @@ func (a *TradeAPIAdapter) PlaceOrder(ctx context.Context, placeOrderRequest comm
 // for a consistent response shape with PlaceMultiOrder.
 // Only resp.PlaceOrders[0] is inspected for per-order errors.
 func (a *TradeAPIAdapter) PlaceSingleOrder(ctx context.Context, placeOrderRequest commontypes.PlaceOrderRequest) (*commontypes.PlaceOrderResult, error) {
-	extra := placeOrderRequest.Extra
-	if extra == nil {
-		extra = make(map[string]interface{})
-	}
-	// Build OKEx order request
-	req := tradereq.PlaceOrder{
-		InstID:  placeOrderRequest.Symbol,
-		TdMode:  okexconstants.TradeMode(placeOrderRequest.TdMode),
-		Side:    okexconstants.OrderSide(placeOrderRequest.Side),
-		PosSide: okexconstants.PositionSide(placeOrderRequest.PosSide),
-		OrdType: okexconstants.OrderType(placeOrderRequest.Type),
-		Sz:      placeOrderRequest.Quantity,
-		ClOrdID: placeOrderRequest.ClientOrderID,
-	}
-
-	price := placeOrderRequest.Price
-	if price > 0 {
-		req.Px = price
-	}
-
-	// Apply extra parameters
-	if extra != nil {
-		if tag, ok := extra["tag"].(string); ok {
-			req.Tag = tag
-		}
+	req, err := a.toPlaceOrder(placeOrderRequest)
+	if err != nil {
+		return &commontypes.PlaceOrderResult{Error: err}, nil
 	}
 
 	// Place order (requires slice)
-	resp, err := a.client.Trade.PlaceOrder([]tradereq.PlaceOrder{req})
+	resp, err := a.client.Trade.PlaceOrder(ctx, []tradereq.PlaceOrder{req})
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +212,7 @@ func (a *TradeAPIAdapter) PlaceSingleOrder(ctx context.Context, placeOrderReques
 
 	// Check if the order placement was successful
 	if resp.PlaceOrders[0].SCode != 0 {
-		err := fmt.Errorf("order placement failed: code=%d, msg=%s", resp.PlaceOrders[0].SCode, resp.PlaceOrders[0].SMsg)
+		err := fmt.Errorf("order placement failed: %w", newAPIError(int(resp.PlaceOrders[0].SCode), resp.PlaceOrders[0].SMsg))
 		return &commontypes.PlaceOrderResult{
 			Error: err,
 		}, nil
@@ -234,29 +249,14 @@ func (a *TradeAPIAdapter) PlaceMultiOrder(ctx context.Context, reqs []commontype
 
 	okexReqs := make([]tradereq.PlaceOrder, len(reqs))
 	for i, r := range reqs {
-		extra := r.Extra
-		if extra == nil {
-			extra = make(map[string]interface{})
-		}
-		or := tradereq.PlaceOrder{
-			InstID:  r.Symbol,
-			TdMode:  okexconstants.TradeMode(r.TdMode),
-			Side:    okexconstants.OrderSide(r.Side),
-			PosSide: okexconstants.PositionSide(r.PosSide),
-			OrdType: okexconstants.OrderType(r.Type),
-			Sz:      r.Quantity,
-			ClOrdID: r.ClientOrderID,
-		}
-		if r.Price > 0 {
-			or.Px = r.Price
-		}
-		if tag, ok := extra["tag"].(string); ok {
-			or.Tag = tag
+		or, err := a.toPlaceOrder(r)
+		if err != nil {
+			return nil, fmt.Errorf("okex: order %d: %w", i, err)
 		}
 		okexReqs[i] = or
 	}
 
-	resp, err := a.client.Trade.PlaceMultipleOrders(okexReqs)
+	resp, err := a.client.Trade.PlaceMultipleOrders(ctx, okexReqs)
 	if err != nil {
 		return nil, err
 	}
@@ -275,7 +275,7 @@ func (a *TradeAPIAdapter) PlaceMultiOrder(ctx context.Context, reqs []commontype
 		p := resp.PlaceOrders[i]
 		if p.SCode != 0 {
 			results[i] = &commontypes.PlaceOrderResult{
-				Error: fmt.Errorf("okex: order %d rejected: code=%d, msg=%s", i, p.SCode, p.SMsg),
+				Error: fmt.Errorf("okex: order %d rejected: %w", i, newAPIError(int(p.SCode), p.SMsg)),
 			}
 		} else {
 			ordID := strconv.FormatFloat(float64(p.OrdID), 'f', 0, 64)
@@ -313,7 +313,7 @@ func (a *TradeAPIAdapter) CancelOrder(ctx context.Context, symbol, orderId strin
 
 	// Note: CandleOrder is a typo in the original SDK, should be CancelOrder
 	// It returns PlaceOrder response type which is reused for cancel responses
-	resp, err := a.client.Trade.CandleOrder([]tradereq.CancelOrder{req})
+	resp, err := a.client.Trade.CandleOrder(ctx, []tradereq.CancelOrder{req})
 	if err != nil {
 		return err
 	}
@@ -326,12 +326,161 @@ func (a *TradeAPIAdapter) CancelOrder(ctx context.Context, symbol, orderId strin
 	// Check if the cancellation was successful
 	// The response reuses PlaceOrders field name
 	if len(resp.PlaceOrders) > 0 && resp.PlaceOrders[0].SCode != 0 {
-		return fmt.Errorf("order cancellation failed: code=%d, msg=%s", resp.PlaceOrders[0].SCode, resp.PlaceOrders[0].SMsg)
+		return fmt.Errorf("order cancellation failed: %w", newAPIError(int(resp.PlaceOrders[0].SCode), resp.PlaceOrders[0].SMsg))
 	}
 
 	return nil
 }
 
+// CancelOrders cancels orders via the batch cancel endpoint, 20 orders per request.
+// A failed request marks every order of that chunk as failed and the remaining
+// chunks are still sent, so one bad chunk does not leave the rest working.
+// The client order ID is taken from Extra["clOrdID"], as in CancelOrder.
+func (a *TradeAPIAdapter) CancelOrders(ctx context.Context, reqs []commontypes.CancelOrderRequest) ([]*commontypes.CancelOrderResult, error) {
+	const batchSize = 20
+
+	results := make([]*commontypes.CancelOrderResult, len(reqs))
+	for start := 0; start < len(reqs); start += batchSize {
+		end := min(start+batchSize, len(reqs))
+
+		okexReqs := make([]tradereq.CancelOrder, 0, end-start)
+		for i := start; i < end; i++ {
+			r := tradereq.CancelOrder{
+				InstID: reqs[i].Symbol,
+				OrdID:  reqs[i].OrderID,
+			}
+			if clOrdID, ok := reqs[i].Extra["clOrdID"].(string); ok {
+				r.ClOrdID = clOrdID
+			}
+			okexReqs = append(okexReqs, r)
+			results[i] = &commontypes.CancelOrderResult{
+				Symbol:        r.InstID,
+				OrderID:       r.OrdID,
+				ClientOrderID: r.ClOrdID,
+			}
+		}
+
+		resp, err := a.client.Trade.CandleOrder(ctx, okexReqs)
+		if err == nil {
+			err = checkAPIError(resp.Basic)
+		}
+		if err != nil {
+			for i := start; i < end; i++ {
+				results[i].Error = err
+			}
+			continue
+		}
+
+		// OKEx returns one entry per request, in request order
+		for j, data := range resp.PlaceOrders {
+			if start+j >= end {
+				break
+			}
+			if data.SCode != 0 {
+				results[start+j].Error = fmt.Errorf("order cancellation failed: %w", newAPIError(int(data.SCode), data.SMsg))
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// CancelAllOrders cancels all incomplete orders, optionally filtered by symbol and instrument type.
+// OKEx has no cancel-all endpoint for regular orders, so pending orders are
+// listed page by page and cancelled through CancelOrders.
+func (a *TradeAPIAdapter) CancelAllOrders(ctx context.Context, symbol string, instType commontypes.InstrumentType) ([]*commontypes.CancelOrderResult, error) {
+	const pageSize = 100
+
+	var reqs []commontypes.CancelOrderRequest
+	listReq := commontypes.GetOpenOrdersRequest{Symbol: symbol, InstType: instType, Limit: pageSize}
+	for {
+		orders, err := a.GetOpenOrders(ctx, listReq)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range orders {
+			reqs = append(reqs, commontypes.CancelOrderRequest{Symbol: o.Symbol, OrderID: o.ID})
+		}
+		if len(orders) < pageSize {
+			break
+		}
+		listReq.After = orders[len(orders)-1].ID
+	}
+
+	return a.CancelOrders(ctx, reqs)
+}
+
+// AmendOrder amends the size and/or price of an incomplete order in place
+func (a *TradeAPIAdapter) AmendOrder(ctx context.Context, commonReq commontypes.AmendOrderRequest) (*commontypes.AmendOrderResult, error) {
+	if !commonReq.NewQuantity.IsPositive() && !commonReq.NewPrice.IsPositive() {
+		return nil, fmt.Errorf("okex: amend order requires a new quantity or price")
+	}
+
+	req := tradereq.AmendOrder{
+		InstID:  commonReq.Symbol,
+		OrdID:   commonReq.OrderID,
+		ClOrdID: commonReq.ClientOrderID,
+	}
+	if commonReq.NewQuantity.IsPositive() {
+		req.NewSz = commonReq.NewQuantity.String()
+	}
+	if commonReq.NewPrice.IsPositive() {
+		req.NewPx = commonReq.NewPrice.String()
+	}
+
+	if extra := commonReq.Extra; extra != nil {
+		if reqID, ok := extra["reqId"].(string); ok {
+			req.ReqID = reqID
+		}
+		if cxlOnFail, ok := extra["cxlOnFail"].(bool); ok {
+			req.CxlOnFail = cxlOnFail
+		}
+	}
+
+	resp, err := a.client.Trade.AmendOrder(ctx, []tradereq.AmendOrder{req})
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for API errors
+	if err := checkAPIError(resp.Basic); err != nil {
+		return nil, err
+	}
+
+	if len(resp.AmendOrders) == 0 {
+		return nil, fmt.Errorf("no amend data returned")
+	}
+
+	amended := resp.AmendOrders[0]
+	if amended.SCode != 0 {
+		return nil, fmt.Errorf("order amendment failed: %w", newAPIError(int(amended.SCode), amended.SMsg))
+	}
+
+	order := &commontypes.Order{
+		ID:            amended.OrdID,
+		ClientOrderID: amended.ClOrdID,
+		Symbol:        commonReq.Symbol,
+		Extra: map[string]interface{}{
+			"clOrdID": amended.ClOrdID,
+			"reqId":   amended.ReqID,
+			"sCode":   amended.SCode,
+			"sMsg":    amended.SMsg,
+		},
+	}
+	if commonReq.NewQuantity.IsPositive() {
+		order.Quantity = commonReq.NewQuantity
+	}
+	if commonReq.NewPrice.IsPositive() {
+		order.Price = commonReq.NewPrice
+	}
+
+	return &commontypes.AmendOrderResult{
+		Order:           order,
+		Replaced:        false,
+		OriginalOrderID: amended.OrdID,
+	}, nil
+}
+
 // GetOrderDetail gets order details
 func (a *TradeAPIAdapter) GetOrderDetail(ctx context.Context, commonReq commontypes.GetOrderRequest) (*commontypes.Order, error) {
 	req := tradereq.OrderDetails{
@@ -340,7 +489,7 @@ func (a *TradeAPIAdapter) GetOrderDetail(ctx context.Context, commonReq commonty
 		ClOrdID: commonReq.ClientOrderID,
 	}
 
-	resp, err := a.client.Trade.GetOrderDetail(req)
+	resp, err := a.client.Trade.GetOrderDetail(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -357,6 +506,463 @@ func (a *TradeAPIAdapter) GetOrderDetail(ctx context.Context, commonReq commonty
 	return a.converter.ConvertOrder(resp.Orders[0]), nil
 }
 
+// GetOpenOrders lists incomplete orders via the orders-pending endpoint
+func (a *TradeAPIAdapter) GetOpenOrders(ctx context.Context, commonReq commontypes.GetOpenOrdersRequest) ([]*commontypes.Order, error) {
+	req := tradereq.OrderList{
+		InstID: commonReq.Symbol,
+		After:  commonReq.After,
+		Limit:  float64(commonReq.Limit),
+	}
+	if commonReq.InstType != "" && commonReq.InstType != commontypes.InstrumentAny {
+		req.InstType = a.converter.ConvertInstrumentType(commonReq.InstType)
+	}
+
+	resp, err := a.client.Trade.GetOrderList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for API errors
+	if err := checkAPIError(resp.Basic); err != nil {
+		return nil, err
+	}
+
+	orders := make([]*commontypes.Order, 0, len(resp.Orders))
+	for _, o := range resp.Orders {
+		orders = append(orders, a.converter.ConvertOrder(o))
+	}
+	return orders, nil
+}
+
+// GetOrderHistory lists completed orders
+// The last-7-days endpoint is used unless StartTime is older than 7 days or
+// Extra["archive"] is true, in which case the 3-month archive is queried.
+// OKEx requires instType; when not given it is inferred from Symbol.
+func (a *TradeAPIAdapter) GetOrderHistory(ctx context.Context, commonReq commontypes.GetOrderHistoryRequest) ([]*commontypes.Order, error) {
+	req := tradereq.OrderList{
+		InstID: commonReq.Symbol,
+		After:  commonReq.After,
+		Limit:  float64(commonReq.Limit),
+	}
+
+	switch {
+	case commonReq.InstType != "" && commonReq.InstType != commontypes.InstrumentAny:
+		req.InstType = a.converter.ConvertInstrumentType(commonReq.InstType)
+	case commonReq.Symbol != "":
+		req.InstType = a.converter.constantsConverter.InstrumentTypeFromInstID(commonReq.Symbol)
+	default:
+		return nil, fmt.Errorf("okex: order history requires an instrument type or symbol")
+	}
+
+	if !commonReq.StartTime.IsZero() {
+		req.Begin = commonReq.StartTime.UnixMilli()
+	}
+	if !commonReq.EndTime.IsZero() {
+		req.End = commonReq.EndTime.UnixMilli()
+	}
+
+	archive := !commonReq.StartTime.IsZero() && time.Since(commonReq.StartTime) > 7*24*time.Hour
+	if arch, ok := commonReq.Extra["archive"].(bool); ok {
+		archive = arch
+	}
+
+	resp, err := a.client.Trade.GetOrderHistory(ctx, req, archive)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for API errors
+	if err := checkAPIError(resp.Basic); err != nil {
+		return nil, err
+	}
+
+	orders := make([]*commontypes.Order, 0, len(resp.Orders))
+	for _, o := range resp.Orders {
+		orders = append(orders, a.converter.ConvertOrder(o))
+	}
+	return orders, nil
+}
+
+// GetFills lists own trade executions, following the billId cursor until the
+// time window is exhausted or Limit fills have been collected.
+// The last-3-days endpoint is used unless StartTime is older than 3 days or
+// Extra["archive"] is true, in which case the 3-month archive is queried.
+func (a *TradeAPIAdapter) GetFills(ctx context.Context, commonReq commontypes.GetFillsRequest) ([]*commontypes.Trade, error) {
+	const pageSize = 100
+
+	req := tradereq.TransactionDetails{
+		InstID: commonReq.Symbol,
+		OrdID:  commonReq.OrderID,
+		Limit:  pageSize,
+	}
+	if commonReq.InstType != "" && commonReq.InstType != commontypes.InstrumentAny {
+		req.InstType = a.converter.ConvertInstrumentType(commonReq.InstType)
+	}
+	if !commonReq.StartTime.IsZero() {
+		req.Begin = commonReq.StartTime.UnixMilli()
+	}
+	if !commonReq.EndTime.IsZero() {
+		req.End = commonReq.EndTime.UnixMilli()
+	}
+
+	archive := !commonReq.StartTime.IsZero() && time.Since(commonReq.StartTime) > 3*24*time.Hour
+	if arch, ok := commonReq.Extra["archive"].(bool); ok {
+		archive = arch
+	}
+	if archive && req.InstType == "" {
+		// The archive endpoint requires instType
+		if commonReq.Symbol == "" {
+			return nil, fmt.Errorf("okex: archived fills require an instrument type or symbol")
+		}
+		req.InstType = a.converter.constantsConverter.InstrumentTypeFromInstID(commonReq.Symbol)
+	}
+
+	var fills []*commontypes.Trade
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		resp, err := a.client.Trade.GetTransactionDetails(ctx, req, archive)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check for API errors
+		if err := checkAPIError(resp.Basic); err != nil {
+			return nil, err
+		}
+
+		for _, f := range resp.TransactionDetails {
+			fills = append(fills, a.converter.ConvertFill(f))
+			if commonReq.Limit > 0 && len(fills) >= commonReq.Limit {
+				return fills, nil
+			}
+		}
+
+		if len(resp.TransactionDetails) < pageSize {
+			return fills, nil
+		}
+		req.After = resp.TransactionDetails[len(resp.TransactionDetails)-1].BillID
+	}
+}
+
+// PlaceConditionalOrder places an algo order
+// Trigger orders map to "trigger", trailing stops to "move_order_stop" and
+// TP/SL orders to "conditional", or "oco" when both legs are given.
+func (a *TradeAPIAdapter) PlaceConditionalOrder(ctx context.Context, commonReq commontypes.ConditionalOrderRequest) (*commontypes.ConditionalOrder, error) {
+	hasTP := commonReq.TakeProfitTriggerPrice.IsPositive()
+	hasSL := commonReq.StopLossTriggerPrice.IsPositive()
+	pxType := string(commonReq.TriggerPriceType)
+
+	req := tradereq.PlaceAlgoOrder{
+		InstID:      commonReq.Symbol,
+		TdMode:      okexconstants.TradeMode(commonReq.TdMode),
+		Side:        okexconstants.OrderSide(commonReq.Side),
+		PosSide:     okexconstants.PositionSide(commonReq.PosSide),
+		OrdType:     a.converter.constantsConverter.ToOKExAlgoOrderType(commonReq.Type, hasTP, hasSL),
+		Sz:          commonReq.Quantity.String(),
+		ReduceOnly:  commonReq.ReduceOnly,
+		AlgoClOrdID: commonReq.ClientOrderID,
+	}
+
+	switch commonReq.Type {
+	case commontypes.ConditionalOrderTypeTrigger:
+		if !commonReq.TriggerPrice.IsPositive() {
+			return nil, fmt.Errorf("okex: trigger order requires a trigger price")
+		}
+		req.TriggerPx = commonReq.TriggerPrice.String()
+		req.TriggerPxType = pxType
+		req.OrderPx = algoOrderPx(commonReq.OrderPrice)
+	case commontypes.ConditionalOrderTypeTPSL:
+		if !hasTP && !hasSL {
+			return nil, fmt.Errorf("okex: tpsl order requires a take-profit or stop-loss trigger price")
+		}
+		if hasTP {
+			req.TpTriggerPx = commonReq.TakeProfitTriggerPrice.String()
+			req.TpTriggerPxType = pxType
+			req.TpOrdPx = algoOrderPx(commonReq.TakeProfitPrice)
+		}
+		if hasSL {
+			req.SlTriggerPx = commonReq.StopLossTriggerPrice.String()
+			req.SlTriggerPxType = pxType
+			req.SlOrdPx = algoOrderPx(commonReq.StopLossPrice)
+		}
+	case commontypes.ConditionalOrderTypeTrailing:
+		if !commonReq.CallbackRate.IsPositive() {
+			return nil, fmt.Errorf("okex: trailing order requires a callback rate")
+		}
+		req.CallbackRatio = commonReq.CallbackRate.String()
+		if commonReq.ActivationPrice.IsPositive() {
+			req.ActivePx = commonReq.ActivationPrice.String()
+		}
+	default:
+		return nil, fmt.Errorf("okex: unsupported conditional order type %q", commonReq.Type)
+	}
+
+	resp, err := a.client.Trade.PlaceAlgoOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for API errors
+	if err := checkAPIError(resp.Basic); err != nil {
+		return nil, err
+	}
+
+	if len(resp.PlaceAlgoOrders) == 0 {
+		return nil, fmt.Errorf("no algo order data returned")
+	}
+	if resp.PlaceAlgoOrders[0].SCode != 0 {
+		return nil, fmt.Errorf("algo order placement failed: %w", newAPIError(int(resp.PlaceAlgoOrders[0].SCode), resp.PlaceAlgoOrders[0].SMsg))
+	}
+
+	order := commontypes.ConditionalOrderFromRequest(commonReq, resp.PlaceAlgoOrders[0].AlgoID)
+	order.Extra["ordType"] = req.OrdType
+	return order, nil
+}
+
+// algoOrderPx returns the OKEx algo order price, where -1 means market execution
+func algoOrderPx(px commontypes.Decimal) string {
+	if !px.IsPositive() {
+		return "-1"
+	}
+	return px.String()
+}
+
+// CancelConditionalOrder cancels an untriggered algo order
+func (a *TradeAPIAdapter) CancelConditionalOrder(ctx context.Context, commonReq commontypes.CancelConditionalOrderRequest) error {
+	resp, err := a.client.Trade.CancelAlgoOrder(ctx, []tradereq.CancelAlgoOrder{{
+		InstID: commonReq.Symbol,
+		AlgoID: commonReq.OrderID,
+	}})
+	if err != nil {
+		return err
+	}
+
+	// Check for API errors
+	if err := checkAPIError(resp.Basic); err != nil {
+		return err
+	}
+
+	if len(resp.CancelAlgoOrders) > 0 && resp.CancelAlgoOrders[0].SCode != 0 {
+		return fmt.Errorf("algo order cancellation failed: %w", newAPIError(int(resp.CancelAlgoOrders[0].SCode), resp.CancelAlgoOrders[0].SMsg))
+	}
+	return nil
+}
+
+// GetConditionalOrders lists untriggered algo orders
+// OKEx requires an ordType per request, so every algo type matching
+// commonReq.Type is queried in turn; each type is paged by algoId.
+func (a *TradeAPIAdapter) GetConditionalOrders(ctx context.Context, commonReq commontypes.GetConditionalOrdersRequest) ([]*commontypes.ConditionalOrder, error) {
+	const pageSize = 100
+
+	var ordTypes []okexconstants.AlgoOrderType
+	switch commonReq.Type {
+	case commontypes.ConditionalOrderTypeTrigger:
+		ordTypes = []okexconstants.AlgoOrderType{okexconstants.AlgoOrderTrigger}
+	case commontypes.ConditionalOrderTypeTPSL:
+		ordTypes = []okexconstants.AlgoOrderType{okexconstants.AlgoOrderConditional + "," + okexconstants.AlgoOrderOCO}
+	case commontypes.ConditionalOrderTypeTrailing:
+		ordTypes = []okexconstants.AlgoOrderType{okexconstants.AlgoOrderMoveStop}
+	case "":
+		ordTypes = []okexconstants.AlgoOrderType{
+			okexconstants.AlgoOrderConditional + "," + okexconstants.AlgoOrderOCO,
+			okexconstants.AlgoOrderTrigger,
+			okexconstants.AlgoOrderMoveStop,
+		}
+	default:
+		return nil, fmt.Errorf("okex: unsupported conditional order type %q", commonReq.Type)
+	}
+
+	var orders []*commontypes.ConditionalOrder
+	for _, ordType := range ordTypes {
+		req := tradereq.AlgoOrderList{
+			InstID:  commonReq.Symbol,
+			OrdType: ordType,
+			Limit:   pageSize,
+		}
+		if commonReq.InstType != "" && commonReq.InstType != commontypes.InstrumentAny {
+			req.InstType = a.converter.ConvertInstrumentType(commonReq.InstType)
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			resp, err := a.client.Trade.GetAlgoOrderList(ctx, req, false)
+			if err != nil {
+				return nil, err
+			}
+
+			// Check for API errors
+			if err := checkAPIError(resp.Basic); err != nil {
+				return nil, err
+			}
+
+			for _, o := range resp.AlgoOrders {
+				orders = append(orders, a.converter.ConvertAlgoOrder(o))
+			}
+
+			if len(resp.AlgoOrders) < pageSize {
+				break
+			}
+			req.After = resp.AlgoOrders[len(resp.AlgoOrders)-1].AlgoID
+		}
+	}
+	return orders, nil
+}
+
+// ClosePosition closes a position
+// A full close uses the native close-position endpoint. A partial close sends a
+// reduce-only market order on the opposite side of the position.
+func (a *TradeAPIAdapter) ClosePosition(ctx context.Context, commonReq commontypes.ClosePositionRequest) (*commontypes.ClosePositionResult, error) {
+	pos, err := a.findPosition(ctx, commonReq)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &commontypes.ClosePositionResult{
+		Symbol:  pos.InstID,
+		PosSide: a.converter.constantsConverter.FromOKExPositionSide(pos.PosSide),
+	}
+
+	if !commonReq.Quantity.IsPositive() {
+		if err := a.closePosition(ctx, pos); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	req := tradereq.PlaceOrder{
+		InstID:  pos.InstID,
+		TdMode:  okexconstants.TradeMode(pos.MgnMode),
+		PosSide: pos.PosSide,
+		OrdType: okexconstants.OrderMarket,
+		Sz:      commonReq.Quantity.String(),
+	}
+	switch {
+	case pos.PosSide == okexconstants.PositionLongSide:
+		req.Side = okexconstants.OrderSell
+	case pos.PosSide == okexconstants.PositionShortSide:
+		req.Side = okexconstants.OrderBuy
+	case pos.Pos > 0:
+		// Net mode: the sign of pos is the direction of the position
+		req.Side = okexconstants.OrderSell
+		req.ReduceOnly = true
+	default:
+		req.Side = okexconstants.OrderBuy
+		req.ReduceOnly = true
+	}
+
+	resp, err := a.client.Trade.PlaceOrder(ctx, []tradereq.PlaceOrder{req})
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for API errors
+	if err := checkAPIError(resp.Basic); err != nil {
+		return nil, err
+	}
+
+	if len(resp.PlaceOrders) == 0 {
+		return nil, fmt.Errorf("no order data returned")
+	}
+	if resp.PlaceOrders[0].SCode != 0 {
+		return nil, fmt.Errorf("order placement failed: %w", newAPIError(int(resp.PlaceOrders[0].SCode), resp.PlaceOrders[0].SMsg))
+	}
+
+	result.Order = &commontypes.Order{
+		ID:       strconv.FormatFloat(float64(resp.PlaceOrders[0].OrdID), 'f', 0, 64),
+		Symbol:   pos.InstID,
+		Side:     string(a.converter.constantsConverter.FromOKExOrderSide(req.Side)),
+		Type:     string(commontypes.OrderTypeMarket),
+		Quantity: commonReq.Quantity,
+		Status:   commontypes.OrderStatusNew,
+		Extra: map[string]interface{}{
+			"sCode": resp.PlaceOrders[0].SCode,
+			"sMsg":  resp.PlaceOrders[0].SMsg,
+		},
+	}
+	return result, nil
+}
+
+// CloseAllPositions closes every open position with the native close-position endpoint
+func (a *TradeAPIAdapter) CloseAllPositions(ctx context.Context) ([]*commontypes.ClosePositionResult, error) {
+	resp, err := a.client.Account.GetPositions(ctx, accountreq.GetPositions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for API errors
+	if err := checkAPIError(resp.Basic); err != nil {
+		return nil, err
+	}
+
+	results := make([]*commontypes.ClosePositionResult, 0, len(resp.Positions))
+	for _, pos := range resp.Positions {
+		if pos.Pos == 0 {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		results = append(results, &commontypes.ClosePositionResult{
+			Symbol:  pos.InstID,
+			PosSide: a.converter.constantsConverter.FromOKExPositionSide(pos.PosSide),
+			Error:   a.closePosition(ctx, pos),
+		})
+	}
+	return results, nil
+}
+
+// findPosition returns the open position matching the close request
+// An empty PosSide matches a net-mode position.
+func (a *TradeAPIAdapter) findPosition(ctx context.Context, commonReq commontypes.ClosePositionRequest) (*account.Position, error) {
+	resp, err := a.client.Account.GetPositions(ctx, accountreq.GetPositions{InstID: []string{commonReq.Symbol}})
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for API errors
+	if err := checkAPIError(resp.Basic); err != nil {
+		return nil, err
+	}
+
+	posSide := okexconstants.PositionNetSide
+	if commonReq.PosSide != "" {
+		posSide = a.converter.constantsConverter.ToOKExPositionSide(commonReq.PosSide)
+	}
+	for _, pos := range resp.Positions {
+		if pos.Pos == 0 || pos.PosSide != posSide {
+			continue
+		}
+		if commonReq.MarginMode != "" && pos.MgnMode != a.converter.constantsConverter.ToOKExMarginMode(commonReq.MarginMode) {
+			continue
+		}
+		return pos, nil
+	}
+	return nil, fmt.Errorf("okex: no open %s position for %s", posSide, commonReq.Symbol)
+}
+
+// closePosition closes a whole position with the native close-position endpoint
+func (a *TradeAPIAdapter) closePosition(ctx context.Context, pos *account.Position) error {
+	req := tradereq.ClosePosition{
+		InstID:  pos.InstID,
+		MgnMode: pos.MgnMode,
+	}
+	if pos.PosSide != okexconstants.PositionNetSide {
+		req.PosSide = pos.PosSide
+	}
+
+	resp, err := a.client.Trade.ClosePosition(ctx, req)
+	if err != nil {
+		return err
+	}
+	return checkAPIError(resp.Basic)
+}
+
 // AccountAPIAdapter implements account operations
 type AccountAPIAdapter struct {
 	client    *rest.ClientRest
@@ -369,7 +975,7 @@ func (a *AccountAPIAdapter) GetBalance(ctx context.Context, currencies ...string
 		Ccy: currencies,
 	}
 
-	resp, err := a.client.Account.GetBalance(req)
+	resp, err := a.client.Account.GetBalance(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -395,7 +1001,7 @@ func (a *AccountAPIAdapter) GetPositions(ctx context.Context, symbols ...string)
 		InstID: symbols,
 	}
 
-	resp, err := a.client.Account.GetPositions(req)
+	resp, err := a.client.Account.GetPositions(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -420,7 +1026,7 @@ func (a *AccountAPIAdapter) GetLeverage(ctx context.Context, req commontypes.Get
 		MgnMode: okexconstants.MarginMode(req.MarginMode),
 	}
 
-	resp, err := a.client.Account.GetLeverage(okexReq)
+	resp, err := a.client.Account.GetLeverage(ctx, okexReq)
 	if err != nil {
 		return nil, err
 	}
@@ -447,7 +1053,7 @@ func (a *AccountAPIAdapter) GetLeverage(ctx context.Context, req commontypes.Get
 // SetLeverage sets leverage for a trading pair
 func (a *AccountAPIAdapter) SetLeverage(ctx context.Context, req commontypes.SetLeverageRequest) (*commontypes.Leverage, error) {
 	okexReq := accountreq.SetLeverage{
-		Lever:   int64(req.Leverage),
+		Lever:   req.Leverage.String(),
 		MgnMode: a.converter.toOKExMarginMode(req.MarginMode),
 	}
 
@@ -462,7 +1068,7 @@ func (a *AccountAPIAdapter) SetLeverage(ctx context.Context, req commontypes.Set
 		okexReq.PosSide = a.converter.toOKExPositionSide(req.PosSide)
 	}
 
-	resp, err := a.client.Account.SetLeverage(okexReq)
+	resp, err := a.client.Account.SetLeverage(ctx, okexReq)
 	if err != nil {
 		return nil, err
 	}
@@ -500,7 +1106,7 @@ func (a *MarketAPIAdapter) GetTicker(ctx context.Context, symbol string) (*commo
 	}
 
 	// Call OKEx API
-	resp, err := a.client.Market.GetTicker(req)
+	resp, err := a.client.Market.GetTicker(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -527,7 +1133,7 @@ func (a *MarketAPIAdapter) GetTickers(ctx context.Context, req commontypes.GetTi
 	}
 
 	// Call OKEx API
-	resp, err := a.client.Market.GetTickers(okexReq)
+	resp, err := a.client.Market.GetTickers(ctx, okexReq)
 	if err != nil {
 		return nil, err
 	}
@@ -554,11 +1160,11 @@ func (a *MarketAPIAdapter) GetInstruments(ctx context.Context, req commontypes.G
 	}
 
 	// Call OKEx API
-	resp, err := a.client.PublicData.GetInstruments(okexReq)
+	resp, err := a.client.PublicData.GetInstruments(ctx, okexReq)
 	if err != nil {
 		return nil, err
 	}
-	tickerResp, err := a.client.Market.GetTickers(marketreq.GetTickers{
+	tickerResp, err := a.client.Market.GetTickers(ctx, marketreq.GetTickers{
 		InstType: okexReq.InstType,
 	})
 	if err != nil {
@@ -591,7 +1197,7 @@ func (a *MarketAPIAdapter) GetOrderBook(ctx context.Context, symbol string, dept
 		Sz:     depth,
 	}
 
-	resp, err := a.client.Market.GetOrderBook(req)
+	resp, err := a.client.Market.GetOrderBook(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -607,6 +1213,116 @@ func (a *MarketAPIAdapter) GetOrderBook(ctx context.Context, symbol string, dept
 	return a.converter.ConvertOrderBook(resp.OrderBooks[0], symbol), nil
 }
 
+// GetRecentTrades gets the most recent public trades, newest first
+func (a *MarketAPIAdapter) GetRecentTrades(ctx context.Context, symbol string, limit int) ([]*commontypes.Trade, error) {
+	req := marketreq.GetTrades{
+		InstID: symbol,
+		Limit:  int64(limit),
+	}
+
+	resp, err := a.client.Market.GetTrades(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAPIError(resp.Basic); err != nil {
+		return nil, err
+	}
+
+	trades := make([]*commontypes.Trade, 0, len(resp.Trades))
+	for _, t := range resp.Trades {
+		if trade := a.converter.ConvertPublicTrade(t); trade != nil {
+			trades = append(trades, trade)
+		}
+	}
+	return trades, nil
+}
+
+// GetFundingRate gets the current funding rate of a perpetual swap
+func (a *MarketAPIAdapter) GetFundingRate(ctx context.Context, symbol string) (*commontypes.FundingRate, error) {
+	resp, err := a.client.PublicData.GetFundingRate(ctx, publicreq.GetFundingRate{InstID: symbol})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAPIError(resp.Basic); err != nil {
+		return nil, err
+	}
+
+	if len(resp.FundingRates) == 0 {
+		return nil, fmt.Errorf("no funding rate returned for symbol %s", symbol)
+	}
+	return a.converter.ConvertFundingRate(resp.FundingRates[0]), nil
+}
+
+// GetFundingRateHistory gets settled funding rates, newest first
+func (a *MarketAPIAdapter) GetFundingRateHistory(ctx context.Context, req commontypes.GetFundingRateHistoryRequest) ([]*commontypes.FundingRate, error) {
+	okexReq := publicreq.GetFundingRateHistory{
+		InstID: req.Symbol,
+		Limit:  int64(req.Limit),
+	}
+	// Before returns newer and After older records than the funding time given
+	if req.StartTime != nil {
+		okexReq.Before = req.StartTime.UnixMilli() - 1
+	}
+	if req.EndTime != nil {
+		okexReq.After = req.EndTime.UnixMilli() + 1
+	}
+
+	resp, err := a.client.PublicData.GetFundingRateHistory(ctx, okexReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAPIError(resp.Basic); err != nil {
+		return nil, err
+	}
+
+	rates := make([]*commontypes.FundingRate, 0, len(resp.FundingRates))
+	for _, r := range resp.FundingRates {
+		if rate := a.converter.ConvertFundingRateHistory(r); rate != nil {
+			rates = append(rates, rate)
+		}
+	}
+	return rates, nil
+}
+
+// GetMarkPrice gets the mark price of a contract and the index price of its underlying
+func (a *MarketAPIAdapter) GetMarkPrice(ctx context.Context, symbol string) (*commontypes.MarkPrice, error) {
+	resp, err := a.client.PublicData.GetMarkPrice(ctx, publicreq.GetMarkPrice{
+		InstID:   symbol,
+		InstType: a.converter.constantsConverter.InstrumentTypeFromInstID(symbol),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAPIError(resp.Basic); err != nil {
+		return nil, err
+	}
+
+	if len(resp.MarkPrices) == 0 {
+		return nil, fmt.Errorf("no mark price returned for symbol %s", symbol)
+	}
+
+	indexResp, err := a.client.Market.GetIndexTickers(ctx, marketreq.GetIndexTickers{
+		InstID: a.converter.constantsConverter.IndexInstID(symbol),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAPIError(indexResp.Basic); err != nil {
+		return nil, err
+	}
+
+	var indexPrice float64
+	if len(indexResp.IndexTickers) > 0 {
+		indexPrice = float64(indexResp.IndexTickers[0].IdxPx)
+	}
+	return a.converter.ConvertMarkPrice(resp.MarkPrices[0], indexPrice), nil
+}
+
 // GetCandles gets historical candlestick/kline data
 func (a *MarketAPIAdapter) GetCandles(ctx context.Context, req commontypes.GetCandlesRequest) ([]*commontypes.Candle, error) {
 	// Build OKEx request
@@ -634,7 +1350,7 @@ func (a *MarketAPIAdapter) GetCandles(ctx context.Context, req commontypes.GetCa
 	}
 
 	// Call OKEx API
-	resp, err := a.client.Market.GetCandlesticks(okexReq)
+	resp, err := a.client.Market.GetCandlesticks(ctx, okexReq)
 	if err != nil {
 		return nil, err
 	}
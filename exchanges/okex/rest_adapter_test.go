@@ -0,0 +1,112 @@
+package okex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/djpken/go-exc/options"
+	"github.com/djpken/go-exc/retry"
+	commontypes "github.com/djpken/go-exc/types"
+)
+
+// newTestExchange returns an OKX exchange whose REST client talks to an
+// httptest server serving handler, with clock synchronization disabled
+func newTestExchange(t *testing.T, handler http.HandlerFunc, opts ...options.Option) *OKExExchange {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	opts = append([]options.Option{options.WithBaseURL(srv.URL), options.WithHTTPClient(srv.Client()), options.WithClockSync(0)}, opts...)
+	client, err := NewOKExExchange(context.Background(), "", "", "", false, opts...)
+	if err != nil {
+		t.Fatalf("NewOKExExchange() error = %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestTradeAPIAdapter_PlaceOrderRetry(t *testing.T) {
+	var placeCalls, detailCalls int
+	policy := retry.Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	client := newTestExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v5/trade/order":
+			placeCalls++
+			if placeCalls == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"code":"0","msg":"","data":[{"ordId":"12345","clOrdId":"retry1","sCode":"0","sMsg":""}]}`))
+		case "GET /api/v5/trade/order":
+			detailCalls++
+			_, _ = w.Write([]byte(`{"code":"51603","msg":"Order does not exist","data":[]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}, options.WithRetryPolicy(policy))
+
+	ctx := context.Background()
+	req := commontypes.PlaceOrderRequest{
+		Symbol:   "BTC-USDT-SWAP",
+		Side:     commontypes.OrderSideBuy,
+		TdMode:   commontypes.MarginModeCross,
+		Type:     "limit",
+		Quantity: commontypes.NewDecimalFromFloat(1),
+		Price:    commontypes.NewDecimalFromFloat(65000),
+	}
+	if _, err := client.PlaceOrder(ctx, req); err == nil {
+		t.Error("PlaceOrder() without ClientOrderID error = nil, expected the 503 failure")
+	}
+	if placeCalls != 1 || detailCalls != 0 {
+		t.Errorf("PlaceOrder() without ClientOrderID sent %d orders and %d lookups, expected 1 and 0", placeCalls, detailCalls)
+	}
+
+	placeCalls = 0
+	req.ClientOrderID = "retry1"
+	order, err := client.PlaceOrder(ctx, req)
+	if err != nil {
+		t.Fatalf("PlaceOrder() error = %v", err)
+	}
+	if placeCalls != 2 || detailCalls != 1 {
+		t.Errorf("PlaceOrder() sent %d orders and %d lookups, expected 2 and 1", placeCalls, detailCalls)
+	}
+	if order.ID != "12345" {
+		t.Errorf("PlaceOrder() ID = %q, expected 12345", order.ID)
+	}
+}
+
+func TestTradeAPIAdapter_AmendOrderBody(t *testing.T) {
+	var body map[string]interface{}
+	client := newTestExchange(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method+" "+r.URL.Path != "POST /api/v5/trade/amend-order" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode amend body: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"code":"0","msg":"","data":[{"ordId":"12345","clOrdId":"","reqId":"","sCode":"0","sMsg":""}]}`))
+	})
+
+	_, err := client.AmendOrder(context.Background(), commontypes.AmendOrderRequest{
+		Symbol:   "BTC-USDT-SWAP",
+		OrderID:  "12345",
+		NewPrice: commontypes.NewDecimalFromFloat(65000),
+		Extra:    map[string]interface{}{"cxlOnFail": true},
+	})
+	if err != nil {
+		t.Fatalf("AmendOrder() error = %v", err)
+	}
+	if body["cxlOnFail"] != true {
+		t.Errorf("AmendOrder() cxlOnFail = %#v, expected true", body["cxlOnFail"])
+	}
+	if body["newPx"] != "65000" {
+		t.Errorf("AmendOrder() newPx = %#v, expected \"65000\"", body["newPx"])
+	}
+}
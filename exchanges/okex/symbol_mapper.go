@@ -0,0 +1,76 @@
+package okex
+
+import (
+	"fmt"
+	"strings"
+
+	commontypes "github.com/djpken/go-exc/types"
+)
+
+// SymbolMapper translates canonical symbols to OKEx instrument IDs:
+// BTC-USDT for spot and margin, BTC-USDT-SWAP for perpetual swaps and
+// BTC-USD-250328 for dated futures. Options are not supported.
+type SymbolMapper struct{}
+
+// NewSymbolMapper creates a new OKEx symbol mapper
+func NewSymbolMapper() *SymbolMapper {
+	return &SymbolMapper{}
+}
+
+// ToNative converts a canonical symbol to an OKEx instrument ID
+func (m *SymbolMapper) ToNative(sym commontypes.Symbol) (string, error) {
+	pair := sym.Base + "-" + sym.Quote
+	switch sym.InstType {
+	case commontypes.InstrumentSpot, commontypes.InstrumentMargin, "":
+		return pair, nil
+	case commontypes.InstrumentSwap, commontypes.InstrumentFutures:
+		// The settlement currency is implied by the quote currency
+		if settle := okexSettleCcy(sym.Base, sym.Quote); sym.Settle != settle {
+			return "", fmt.Errorf("okex: %s settled in %s: %w", sym, sym.Settle, commontypes.ErrNotSupported)
+		}
+		if sym.InstType == commontypes.InstrumentSwap {
+			return pair + "-SWAP", nil
+		}
+		return pair + "-" + sym.Expiry, nil
+	default:
+		return "", fmt.Errorf("okex: %s instrument symbols: %w", sym.InstType, commontypes.ErrNotSupported)
+	}
+}
+
+// FromNative converts an OKEx instrument ID to a canonical symbol
+// instType distinguishes margin from spot pairs.
+func (m *SymbolMapper) FromNative(native string, instType commontypes.InstrumentType) (commontypes.Symbol, error) {
+	parts := strings.Split(native, "-")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return commontypes.Symbol{}, fmt.Errorf("okex: unrecognised instrument ID %q", native)
+	}
+
+	sym := commontypes.Symbol{Base: parts[0], Quote: parts[1], InstType: commontypes.InstrumentSpot}
+	if len(parts) == 2 {
+		if instType == commontypes.InstrumentMargin {
+			sym.InstType = commontypes.InstrumentMargin
+		}
+		return sym, nil
+	}
+
+	sym.Settle = okexSettleCcy(sym.Base, sym.Quote)
+	if parts[2] == "SWAP" {
+		sym.InstType = commontypes.InstrumentSwap
+		return sym, nil
+	}
+	if len(parts[2]) != 6 || strings.Trim(parts[2], "0123456789") != "" {
+		return commontypes.Symbol{}, fmt.Errorf("okex: unrecognised instrument ID %q", native)
+	}
+	sym.InstType = commontypes.InstrumentFutures
+	sym.Expiry = parts[2]
+	return sym, nil
+}
+
+// okexSettleCcy returns the settlement currency OKEx uses for a derivative:
+// stablecoin-quoted contracts are linear, USD-quoted contracts are inverse
+func okexSettleCcy(base, quote string) string {
+	if quote == "USD" {
+		return base
+	}
+	return quote
+}
@@ -0,0 +1,135 @@
+package ws
+
+import (
+	"fmt"
+	"hash/crc32"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/djpken/go-exc/exchanges/okex/events/public"
+	"github.com/djpken/go-exc/exchanges/okex/models/market"
+	requests "github.com/djpken/go-exc/exchanges/okex/requests/ws/public"
+)
+
+// checksumDepth is the number of levels per side covered by order book checksums
+const checksumDepth = 25
+
+// rawBook is the local order book of one instrument kept to verify the
+// checksums of the books channels. Levels hold prices and sizes as sent,
+// which the checksums are computed over, best price first.
+type rawBook struct {
+	bids []*market.OrderBookEntity
+	asks []*market.OrderBookEntity
+}
+
+// apply applies a snapshot or an incremental update, in which a zero size
+// removes a level
+func (b *rawBook) apply(data *market.OrderBookWs, snapshot bool) {
+	if snapshot {
+		b.bids, b.asks = nil, nil
+	}
+	for _, l := range data.Bids {
+		b.bids = setLevel(b.bids, l, true)
+	}
+	for _, l := range data.Asks {
+		b.asks = setLevel(b.asks, l, false)
+	}
+}
+
+// setLevel updates the level at l.DepthPrice of a side sorted best price first
+func setLevel(levels []*market.OrderBookEntity, l *market.OrderBookEntity, desc bool) []*market.OrderBookEntity {
+	i := sort.Search(len(levels), func(i int) bool {
+		if desc {
+			return levels[i].DepthPrice <= l.DepthPrice
+		}
+		return levels[i].DepthPrice >= l.DepthPrice
+	})
+	found := i < len(levels) && levels[i].DepthPrice == l.DepthPrice
+	switch {
+	case l.Size == 0:
+		if found {
+			levels = slices.Delete(levels, i, i+1)
+		}
+	case found:
+		levels[i] = l
+	default:
+		levels = slices.Insert(levels, i, l)
+	}
+	return levels
+}
+
+// checksum returns the OKX checksum of the book: the signed CRC32 of the
+// best 25 bid and ask prices and sizes, interleaved as
+// "bid1Px:bid1Sz:ask1Px:ask1Sz:bid2Px:...", a side running out first being
+// skipped.
+func (b *rawBook) checksum() int32 {
+	fields := make([]string, 0, 4*checksumDepth)
+	for i := 0; i < checksumDepth; i++ {
+		if i < len(b.bids) {
+			fields = append(fields, b.bids[i].RawDepthPrice, b.bids[i].RawSize)
+		}
+		if i < len(b.asks) {
+			fields = append(fields, b.asks[i].RawDepthPrice, b.asks[i].RawSize)
+		}
+	}
+	return int32(crc32.ChecksumIEEE([]byte(strings.Join(fields, ":"))))
+}
+
+// rawBooks holds the local order books by "channel:instId"
+type rawBooks struct {
+	mu    sync.Mutex
+	books map[string]*rawBook
+}
+
+func (r *rawBooks) delete(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.books, key)
+}
+
+// verifyOrderBook applies ev to the local book of instID and checks it against
+// the checksums sent. On a mismatch the book is dropped, the instrument
+// resubscribed to get a new snapshot and false returned; updates are rejected
+// until that snapshot arrives.
+func (c *Public) verifyOrderBook(channel, instID string, ev *public.OrderBook) bool {
+	if ev.Action == "" {
+		return true // books5 and bbo-tbt push full books without checksum
+	}
+	key := channel + ":" + instID
+	c.books.mu.Lock()
+	defer c.books.mu.Unlock()
+	for _, data := range ev.Books {
+		book, ok := c.books.books[key]
+		if ev.Action == "snapshot" {
+			book = &rawBook{}
+			c.books.books[key] = book
+		} else if !ok {
+			return false
+		}
+		book.apply(data, ev.Action == "snapshot")
+		if sum := book.checksum(); sum != int32(data.Checksum) {
+			delete(c.books.books, key)
+			c.metrics.ChecksumMismatch(exchangeName, channel)
+			c.sendSystemError("checksum", fmt.Errorf("order book checksum mismatch on %s %s: local %d, received %d, resyncing",
+				channel, instID, sum, int32(data.Checksum)), false)
+			go c.resubscribeOrderBook(channel, instID)
+			return false
+		}
+	}
+	return true
+}
+
+// resubscribeOrderBook resubscribes to the order book of instID, keeping its
+// channel, so that OKX pushes a new snapshot
+func (c *Public) resubscribeOrderBook(channel, instID string) {
+	req := requests.OrderBook{InstID: instID, Channel: channel}
+	if err := c.UOrderBook(req); err != nil {
+		c.sendSystemError("subscription", fmt.Errorf("failed to unsubscribe %s %s: %w", channel, instID, err), false)
+		return
+	}
+	if err := c.OrderBook([]requests.OrderBook{req}); err != nil {
+		c.sendSystemError("subscription", fmt.Errorf("failed to re-subscribe %s %s: %w", channel, instID, err), false)
+	}
+}
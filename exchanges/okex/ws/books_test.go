@@ -0,0 +1,176 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/djpken/go-exc/exchanges/okex/constants"
+	"github.com/djpken/go-exc/exchanges/okex/events/public"
+	"github.com/djpken/go-exc/exchanges/okex/models/market"
+	requests "github.com/djpken/go-exc/exchanges/okex/requests/ws/public"
+	"github.com/gorilla/websocket"
+)
+
+// docsBook is the worked example of the OKX order book checksum documentation,
+// whose checksum string is "3366.1:7:3366.8:9:3366:6:3368:8"
+const docsBook = `{
+	"bids": [["3366.1","7","0","3"], ["3366","6","3","4"]],
+	"asks": [["3366.8","9","10","3"], ["3368","8","3","4"]]
+}`
+
+// docsChecksum is the checksum the OKX documentation gives for docsBook
+const docsChecksum = -1881014294
+
+func TestRawBook_Checksum(t *testing.T) {
+	var snapshot, update market.OrderBookWs
+	if err := json.Unmarshal([]byte(docsBook), &snapshot); err != nil {
+		t.Fatal(err)
+	}
+	// Levels as sent: "3365.50" keeps its trailing zero in the checksum string
+	// "3366.1:7:3366.8:9:3365.50:2:3367:1:3368:8", the shorter bid side skipped
+	if err := json.Unmarshal([]byte(`{
+		"bids": [["3366","0","0","0"], ["3365.50","2","0","1"]],
+		"asks": [["3367","1","0","1"]]
+	}`), &update); err != nil {
+		t.Fatal(err)
+	}
+
+	b := &rawBook{}
+	b.apply(&snapshot, true)
+	if got := b.checksum(); got != docsChecksum {
+		t.Errorf("snapshot checksum = %d, expected %d", got, docsChecksum)
+	}
+
+	b.apply(&update, false)
+	if got, expected := b.checksum(), int32(-1294766782); got != expected {
+		t.Errorf("update checksum = %d, expected %d", got, expected)
+	}
+}
+
+// newTestClient returns a client connected to a websocket server that sends
+// each argument of the operations it receives to ops as "op channel:instId"
+func newTestClient(t *testing.T, ops chan string) *ClientWs {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("upgrade: %v", err)
+			return
+		}
+		defer conn.Close()
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var msg struct {
+				Op   string              `json:"op"`
+				Args []map[string]string `json:"args"`
+			}
+			if json.Unmarshal(data, &msg) != nil {
+				continue // ping
+			}
+			for _, arg := range msg.Args {
+				ops <- msg.Op + " " + arg["channel"] + ":" + arg["instId"]
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	url := constants.BaseURL("ws" + strings.TrimPrefix(srv.URL, "http"))
+	c := NewClient(context.Background(), "", "", "", map[bool]constants.BaseURL{false: url})
+	t.Cleanup(c.Cancel)
+	return c
+}
+
+func TestPublic_VerifyOrderBookMismatch(t *testing.T) {
+	// The server records the operations sent by the client
+	ops := make(chan string, 10)
+	c := newTestClient(t, ops)
+	c.SystemErrChan = make(chan *SystemError, 1)
+
+	var books []*market.OrderBookWs
+	if err := json.Unmarshal([]byte("["+docsBook+"]"), &books); err != nil {
+		t.Fatal(err)
+	}
+	books[0].Checksum = docsChecksum + 1
+	ev := &public.OrderBook{Action: "snapshot", Books: books}
+
+	if c.Public.verifyOrderBook("books", "BTC-USDT", ev) {
+		t.Fatal("verifyOrderBook() = true, expected false on a checksum mismatch")
+	}
+	if sysErr := <-c.SystemErrChan; sysErr.Type != "checksum" {
+		t.Errorf("system error type = %q, expected checksum", sysErr.Type)
+	}
+	c.Public.books.mu.Lock()
+	_, ok := c.Public.books.books["books:BTC-USDT"]
+	c.Public.books.mu.Unlock()
+	if ok {
+		t.Error("book kept after a checksum mismatch, expected it dropped")
+	}
+
+	// The instrument is resubscribed to get a new snapshot
+	for _, expected := range []string{"unsubscribe books:BTC-USDT", "subscribe books:BTC-USDT"} {
+		select {
+		case op := <-ops:
+			if op != expected {
+				t.Errorf("sent %q, expected %q", op, expected)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("%q not sent", expected)
+		}
+	}
+
+	// Updates are rejected until the new snapshot arrives
+	books[0].Checksum = docsChecksum
+	if c.Public.verifyOrderBook("books", "BTC-USDT", &public.OrderBook{Action: "update", Books: books}) {
+		t.Error("verifyOrderBook() = true for an update before the new snapshot")
+	}
+	if !c.Public.verifyOrderBook("books", "BTC-USDT", &public.OrderBook{Action: "snapshot", Books: books}) {
+		t.Error("verifyOrderBook() = false for the new snapshot")
+	}
+}
+
+func TestPublic_ResubscribeOrderBook(t *testing.T) {
+	ops := make(chan string, 100)
+	c := newTestClient(t, ops)
+
+	books := []requests.OrderBook{{InstID: "BTC-USDT", Channel: "books"}, {InstID: "ETH-USDT", Channel: "books"}}
+	if err := c.Public.OrderBook(books); err != nil {
+		t.Fatalf("OrderBook() error = %v", err)
+	}
+	c.Public.resubscribeOrderBook("books", "BTC-USDT")
+
+	// The resubscribed book is saved once for reconnects
+	subs := c.Subscriptions(false)
+	if len(subs) != 2 {
+		t.Fatalf("Subscriptions() = %v after a resubscription, expected the 2 books", subs)
+	}
+
+	// Unsubscribing a book keeps the other
+	if err := c.Public.UOrderBook(books[0]); err != nil {
+		t.Fatalf("UOrderBook() error = %v", err)
+	}
+	subs = c.Subscriptions(false)
+	if len(subs) != 1 || subs[0]["channel"] != "books" || subs[0]["instId"] != "ETH-USDT" {
+		t.Errorf("Subscriptions() = %v after unsubscribing BTC-USDT, expected books ETH-USDT", subs)
+	}
+
+	for _, expected := range []string{"subscribe books:BTC-USDT", "subscribe books:ETH-USDT",
+		"unsubscribe books:BTC-USDT", "subscribe books:BTC-USDT", "unsubscribe books:BTC-USDT"} {
+		select {
+		case op := <-ops:
+			if op != expected {
+				t.Errorf("sent %q, expected %q", op, expected)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("%q not sent", expected)
+		}
+	}
+}
@@ -8,12 +8,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
+	"maps"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/djpken/go-exc/clock"
 	"github.com/djpken/go-exc/exchanges/okex/events"
 	"github.com/djpken/go-exc/exchanges/okex/constants"
+	"github.com/djpken/go-exc/metrics"
 	"github.com/gorilla/websocket"
 )
 
@@ -51,10 +55,29 @@ var DefaultRetryConfig = RetryConfig{
 
 // subscription represents a channel subscription
 type subscription struct {
-	channels []constants.ChannelName
-	args     []map[string]string
+	args []map[string]string // as sent, each naming its channel
 }
 
+// count returns the number of channel subscriptions sent for s
+func (s subscription) count() int {
+	return len(s.args)
+}
+
+// matchesArg reports whether the subscription argument sub is covered by the
+// unsubscribe argument unsub, i.e. it has the same channel, instId and any
+// other key unsub sets
+func matchesArg(sub, unsub map[string]string) bool {
+	for k, v := range unsub {
+		if sub[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// exchangeName is the exchange label of metrics
+const exchangeName = "okx"
+
 // ClientWs is the websocket api client
 //
 // https://www.okex.com/docs-v5/en/#websocket-api
@@ -74,6 +97,10 @@ type ClientWs struct {
 	url                 map[bool]constants.BaseURL
 	conn                map[bool]*websocket.Conn
 	dialer              *websocket.Dialer
+	header              http.Header
+	clock               *clock.Clock
+	logger              *slog.Logger
+	metrics             metrics.Recorder
 	apiKey              string
 	secretKey           []byte
 	passphrase          string
@@ -115,6 +142,8 @@ func NewClient(ctx context.Context, apiKey, secretKey, passphrase string, url ma
 		DoneChan:        make(chan interface{}),
 		conn:            make(map[bool]*websocket.Conn),
 		dialer:          websocket.DefaultDialer,
+		logger:          slog.New(slog.DiscardHandler),
+		metrics:         metrics.Nop{},
 		lastTransmit:    make(map[bool]*time.Time),
 		mu:              map[bool]*sync.RWMutex{true: {}, false: {}},
 		retryConfig:     DefaultRetryConfig,
@@ -187,7 +216,10 @@ func (c *ClientWs) Login() error {
 	c.AuthRequested = &now
 	method := http.MethodGet
 	path := "/users/self/verify"
-	ts, sign := c.sign(method, path)
+	ts, sign, err := c.sign(method, path)
+	if err != nil {
+		return err
+	}
 	args := []map[string]string{
 		{
 			"apiKey":     c.apiKey,
@@ -226,13 +258,19 @@ func (c *ClientWs) Subscribe(p bool, ch []constants.ChannelName, args ...map[str
 		return err
 	}
 
-	// Save subscription for re-subscription on reconnect
+	// Save subscription for re-subscription on reconnect, once per argument
 	c.subscriptionsMu[p].Lock()
-	c.subscriptions[p] = append(c.subscriptions[p], subscription{
-		channels: ch,
-		args:     args,
-	})
+	sub := subscription{}
+	for _, arg := range tmpArgs {
+		if !c.tracked(p, arg) {
+			sub.args = append(sub.args, arg)
+		}
+	}
+	if sub.count() > 0 {
+		c.subscriptions[p] = append(c.subscriptions[p], sub)
+	}
 	c.subscriptionsMu[p].Unlock()
+	c.metrics.Subscription(exchangeName, p, sub.count())
 
 	return nil
 }
@@ -254,33 +292,65 @@ func (c *ClientWs) Unsubscribe(p bool, ch []constants.ChannelName, args map[stri
 		return err
 	}
 
-	// Remove subscription from tracker
+	// Remove the matching arguments from the tracker, keeping the others of
+	// the same subscription
 	c.subscriptionsMu[p].Lock()
-	filtered := make([]subscription, 0)
+	filtered := make([]subscription, 0, len(c.subscriptions[p]))
+	removed := 0
 	for _, sub := range c.subscriptions[p] {
-		// Check if this subscription matches the unsubscribe request
-		matches := false
-		for _, subCh := range sub.channels {
-			for _, unsubCh := range ch {
-				if subCh == unsubCh {
+		kept := make([]map[string]string, 0, len(sub.args))
+		for _, arg := range sub.args {
+			matches := false
+			for _, unsub := range tmpArgs {
+				if matchesArg(arg, unsub) {
 					matches = true
 					break
 				}
 			}
 			if matches {
-				break
+				removed++
+			} else {
+				kept = append(kept, arg)
 			}
 		}
-		if !matches {
-			filtered = append(filtered, sub)
+		if len(kept) > 0 {
+			filtered = append(filtered, subscription{args: kept})
 		}
 	}
 	c.subscriptions[p] = filtered
 	c.subscriptionsMu[p].Unlock()
+	c.metrics.Subscription(exchangeName, p, -removed)
 
 	return nil
 }
 
+// tracked reports whether arg is already saved for re-subscription. The
+// caller holds subscriptionsMu[p].
+func (c *ClientWs) tracked(p bool, arg map[string]string) bool {
+	for _, sub := range c.subscriptions[p] {
+		for _, saved := range sub.args {
+			if maps.Equal(saved, arg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Subscriptions returns the arguments of the subscriptions on either connection
+// that are sent again on reconnect, each naming its channel
+func (c *ClientWs) Subscriptions(p bool) []map[string]string {
+	c.subscriptionsMu[p].RLock()
+	defer c.subscriptionsMu[p].RUnlock()
+	var args []map[string]string
+	for _, sub := range c.subscriptions[p] {
+		for _, arg := range sub.args {
+			args = append(args, maps.Clone(arg))
+		}
+	}
+	return args
+}
+
 // Send message through either connections
 func (c *ClientWs) Send(p bool, op constants.Operation, args []map[string]string, extras ...map[string]string) error {
 	if op != constants.LoginOperation {
@@ -328,6 +398,17 @@ func (c *ClientWs) SetDialer(dialer *websocket.Dialer) {
 	c.dialer = dialer
 }
 
+// SetUserAgent sets the User-Agent header sent with the WebSocket handshake.
+func (c *ClientWs) SetUserAgent(userAgent string) {
+	c.header = http.Header{"User-Agent": []string{userAgent}}
+}
+
+// SetClock sets the clock used to timestamp the login. A nil clock uses the
+// local time.
+func (c *ClientWs) SetClock(clk *clock.Clock) {
+	c.clock = clk
+}
+
 // SetRetryConfig sets a custom retry configuration for the WebSocket connection.
 func (c *ClientWs) SetRetryConfig(config RetryConfig) {
 	c.retryConfig = config
@@ -339,7 +420,34 @@ func (c *ClientWs) SetSystemChannels(msgCh chan *SystemMessage, errCh chan *Syst
 	c.SystemErrChan = errCh
 }
 
-// sendSystemMessage sends a system message to the SystemMsgChan if it's set (non-blocking)
+// SetLogger sets the logger receiving the system messages and errors that
+// SystemMsgChan and SystemErrChan do not take. A nil logger discards them.
+func (c *ClientWs) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	c.logger = logger
+}
+
+// Logger returns the logger of the client
+func (c *ClientWs) Logger() *slog.Logger {
+	return c.logger
+}
+
+// SetMetrics sets the recorder of connections, reconnects, messages,
+// subscriptions and dropped updates. A nil recorder records nothing.
+func (c *ClientWs) SetMetrics(recorder metrics.Recorder) {
+	c.metrics = metrics.OrNop(recorder)
+}
+
+// Dropped reports an update of channel dropped because its consumer channel was full
+func (c *ClientWs) Dropped(private bool, channel, symbol string) {
+	c.logger.Warn("channel full, dropping update", "private", private, "channel", channel, "symbol", symbol)
+	c.metrics.Dropped(exchangeName, channel)
+}
+
+// sendSystemMessage sends a system message to the SystemMsgChan if it's set (non-blocking),
+// logging it otherwise
 func (c *ClientWs) sendSystemMessage(msgType, message string, private bool) {
 	if c.SystemMsgChan != nil {
 		msg := &SystemMessage{
@@ -350,17 +458,16 @@ func (c *ClientWs) sendSystemMessage(msgType, message string, private bool) {
 		}
 		select {
 		case c.SystemMsgChan <- msg:
+			return
 		default:
-			// Channel is full or no receiver, print to console as fallback
-			fmt.Printf("[%s] %s (private=%v)\n", msgType, message, private)
+			// Channel is full or no receiver, log as fallback
 		}
-	} else {
-		// No channel set, print to console
-		fmt.Printf("[%s] %s (private=%v)\n", msgType, message, private)
 	}
+	c.logger.Info(message, "type", msgType, "private", private)
 }
 
-// sendSystemError sends a system error to the SystemErrChan if it's set (non-blocking)
+// sendSystemError sends a system error to the SystemErrChan if it's set (non-blocking),
+// logging it otherwise
 func (c *ClientWs) sendSystemError(errType string, err error, private bool) {
 	if c.SystemErrChan != nil {
 		sysErr := &SystemError{
@@ -371,14 +478,12 @@ func (c *ClientWs) sendSystemError(errType string, err error, private bool) {
 		}
 		select {
 		case c.SystemErrChan <- sysErr:
+			return
 		default:
-			// Channel is full or no receiver, print to console as fallback
-			fmt.Printf("[%s ERROR] %v (private=%v)\n", errType, err, private)
+			// Channel is full or no receiver, log as fallback
 		}
-	} else {
-		// No channel set, print to console
-		fmt.Printf("[%s ERROR] %v (private=%v)\n", errType, err, private)
 	}
+	c.logger.Error("websocket error", "type", errType, "private", private, "error", err)
 }
 
 func (c *ClientWs) SetEventChannels(structuredEventCh chan interface{}, rawEventCh chan *events.Basic) {
@@ -406,7 +511,7 @@ func (c *ClientWs) WaitForAuthorization() error {
 
 func (c *ClientWs) dial(p bool) error {
 	c.mu[p].Lock()
-	conn, res, err := c.dialer.Dial(string(c.url[p]), nil)
+	conn, res, err := c.dialer.Dial(string(c.url[p]), c.header)
 	if err != nil {
 		var statusCode int
 		if res != nil {
@@ -416,6 +521,7 @@ func (c *ClientWs) dial(p bool) error {
 		return fmt.Errorf("error %d: %w", statusCode, err)
 	}
 	c.conn[p] = conn
+	c.metrics.Connection(exchangeName, p, true)
 
 	// Create connection-specific context
 	connCtx, connCancel := context.WithCancel(c.ctx)
@@ -468,6 +574,7 @@ func (c *ClientWs) reconnect(p bool) {
 	if c.conn[p] != nil {
 		_ = c.conn[p].Close()
 		c.conn[p] = nil
+		c.metrics.Connection(exchangeName, p, false)
 	}
 
 	// Close old sendChan and create a new one
@@ -494,6 +601,7 @@ func (c *ClientWs) reconnect(p bool) {
 
 	// Attempt to reconnect
 	c.sendSystemMessage("reconnection", "attempting to reconnect...", p)
+	c.metrics.Reconnect(exchangeName, p)
 	err := c.Connect(p)
 
 	c.reconnectMu[p].Lock()
@@ -531,10 +639,13 @@ func (c *ClientWs) resubscribe(p bool) {
 	c.subscriptionsMu[p].Lock()
 	c.subscriptions[p] = []subscription{}
 	c.subscriptionsMu[p].Unlock()
+	for _, sub := range subs {
+		c.metrics.Subscription(exchangeName, p, -sub.count())
+	}
 
 	// Re-subscribe to each saved subscription
 	for _, sub := range subs {
-		err := c.Subscribe(p, sub.channels, sub.args...)
+		err := c.Subscribe(p, nil, sub.args...)
 		if err != nil {
 			c.sendSystemError("subscription", fmt.Errorf("failed to re-subscribe: %w", err), p)
 		}
@@ -644,6 +755,11 @@ func (c *ClientWs) receiver(p bool) error {
 				if err := json.Unmarshal(data, &e); err != nil {
 					return err
 				}
+				if e.Event == "" && e.Arg != nil {
+					if ch, ok := e.Arg.Get("channel"); ok {
+						c.metrics.Message(exchangeName, fmt.Sprint(ch))
+					}
+				}
 				go func() {
 					c.process(data, e)
 				}()
@@ -652,14 +768,18 @@ func (c *ClientWs) receiver(p bool) error {
 	}
 }
 
-func (c *ClientWs) sign(method, path string) (string, string) {
-	t := time.Now().UTC().Unix()
+func (c *ClientWs) sign(method, path string) (string, string, error) {
+	now, err := c.clock.SignTime()
+	if err != nil {
+		return "", "", err
+	}
+	t := now.UTC().Unix()
 	ts := fmt.Sprint(t)
 	s := ts + method + path
 	p := []byte(s)
 	h := hmac.New(sha256.New, c.secretKey)
 	h.Write(p)
-	return ts, base64.StdEncoding.EncodeToString(h.Sum(nil))
+	return ts, base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
 }
 
 func (c *ClientWs) handleCancel(msg string) error {
@@ -21,16 +21,17 @@ type Public struct {
 	tChs   map[string]chan *public.Tickers                 // instId -> chan
 	oiCh   chan *public.OpenInterest
 	cChs   map[string]chan *public.Candlesticks             // "channel:instId" -> chan
-	trCh   chan *public.Trades
+	trChs  map[string]chan *public.Trades                   // instId -> chan
 	edepCh chan *public.EstimatedDeliveryExercisePrice
-	mpCh   chan *public.MarkPrice
+	mpChs  map[string]chan *public.MarkPrice                // instId -> chan
 	mpcChs map[string]chan *public.MarkPriceCandlesticks    // "channel:instId" -> chan
 	plCh   chan *public.PriceLimit
-	obCh   chan *public.OrderBook
+	obChs  map[string]chan *public.OrderBook                // "channel:instId" -> chan
+	books  rawBooks                                         // checksum-verified order books
 	osCh   chan *public.OPTIONSummary
-	frCh   chan *public.FundingRate
+	frChs  map[string]chan *public.FundingRate              // instId -> chan
 	icChs  map[string]chan *public.IndexCandlesticks        // "channel:instId" -> chan
-	itCh   chan *public.IndexTickers
+	itChs  map[string]chan *public.IndexTickers             // instId -> chan
 }
 
 // NewPublic returns a pointer to a fresh Public
@@ -38,9 +39,15 @@ func NewPublic(c *ClientWs) *Public {
 	return &Public{
 		ClientWs: c,
 		tChs:     make(map[string]chan *public.Tickers),
+		trChs:    make(map[string]chan *public.Trades),
 		cChs:     make(map[string]chan *public.Candlesticks),
+		mpChs:    make(map[string]chan *public.MarkPrice),
 		mpcChs:   make(map[string]chan *public.MarkPriceCandlesticks),
+		obChs:    make(map[string]chan *public.OrderBook),
+		books:    rawBooks{books: make(map[string]*rawBook)},
+		frChs:    make(map[string]chan *public.FundingRate),
 		icChs:    make(map[string]chan *public.IndexCandlesticks),
+		itChs:    make(map[string]chan *public.IndexTickers),
 	}
 }
 
@@ -145,7 +152,7 @@ func (c *Public) UCandlesticks(req requests.Candlesticks, rCh ...bool) error {
 func (c *Public) Trades(req requests.Trades, ch ...chan *public.Trades) error {
 	m := utils.S2M(req)
 	if len(ch) > 0 {
-		c.trCh = ch[0]
+		c.trChs[req.InstID] = ch[0]
 	}
 	return c.Subscribe(false, []constants.ChannelName{"trades"}, m)
 }
@@ -156,7 +163,7 @@ func (c *Public) Trades(req requests.Trades, ch ...chan *public.Trades) error {
 func (c *Public) UTrades(req requests.Trades, rCh ...bool) error {
 	m := utils.S2M(req)
 	if len(rCh) > 0 && rCh[0] {
-		c.trCh = nil
+		delete(c.trChs, req.InstID)
 	}
 	return c.Unsubscribe(false, []constants.ChannelName{"trades"}, m)
 }
@@ -193,7 +200,7 @@ func (c *Public) UEstimatedDeliveryExercisePrice(req requests.EstimatedDeliveryE
 func (c *Public) MarkPrice(req requests.MarkPrice, ch ...chan *public.MarkPrice) error {
 	m := utils.S2M(req)
 	if len(ch) > 0 {
-		c.mpCh = ch[0]
+		c.mpChs[req.InstID] = ch[0]
 	}
 	return c.Subscribe(false, []constants.ChannelName{"mark-price"}, m)
 }
@@ -204,7 +211,7 @@ func (c *Public) MarkPrice(req requests.MarkPrice, ch ...chan *public.MarkPrice)
 func (c *Public) UMarkPrice(req requests.MarkPrice, rCh ...bool) error {
 	m := utils.S2M(req)
 	if len(rCh) > 0 && rCh[0] {
-		c.mpCh = nil
+		delete(c.mpChs, req.InstID)
 	}
 	return c.Unsubscribe(false, []constants.ChannelName{"mark-price"}, m)
 }
@@ -264,13 +271,17 @@ func (c *Public) UPriceLimit(req requests.PriceLimit, rCh ...bool) error {
 //
 // Use books for 400 depth levels, book5 for 5 depth levels, books50-l2-tbt tick-by-tick 50 depth levels, and books-l2-tbt for tick-by-tick 400 depth levels.
 //
+// Incremental books are maintained locally and verified against the checksums
+// sent. On a mismatch a "checksum" system error is sent, the update withheld
+// and the instrument resubscribed to receive a new snapshot.
+//
 // https://www.okex.com/docs-v5/en/#websocket-api-public-channels-order-book-channel
 func (c *Public) OrderBook(reqs []requests.OrderBook, ch ...chan *public.OrderBook) error {
-	if len(ch) > 0 {
-		c.obCh = ch[0]
-	}
 	var subscriptions []map[string]string
 	for _, req := range reqs {
+		if len(ch) > 0 {
+			c.obChs[req.Channel+":"+req.InstID] = ch[0]
+		}
 		m := utils.S2M(req)
 		subscriptions = append(subscriptions, m)
 	}
@@ -283,8 +294,9 @@ func (c *Public) OrderBook(reqs []requests.OrderBook, ch ...chan *public.OrderBo
 func (c *Public) UOrderBook(req requests.OrderBook, rCh ...bool) error {
 	m := utils.S2M(req)
 	if len(rCh) > 0 && rCh[0] {
-		c.obCh = nil
+		delete(c.obChs, req.Channel+":"+req.InstID)
 	}
+	c.books.delete(req.Channel + ":" + req.InstID)
 	return c.Unsubscribe(false, []constants.ChannelName{constants.ChannelName(req.Channel)}, m)
 }
 
@@ -318,7 +330,7 @@ func (c *Public) UOPTIONSummary(req requests.OPTIONSummary, rCh ...bool) error {
 func (c *Public) FundingRate(req requests.FundingRate, ch ...chan *public.FundingRate) error {
 	m := utils.S2M(req)
 	if len(ch) > 0 {
-		c.frCh = ch[0]
+		c.frChs[req.InstID] = ch[0]
 	}
 	return c.Subscribe(false, []constants.ChannelName{"funding-rate"}, m)
 }
@@ -329,7 +341,7 @@ func (c *Public) FundingRate(req requests.FundingRate, ch ...chan *public.Fundin
 func (c *Public) UFundingRate(req requests.FundingRate, rCh ...bool) error {
 	m := utils.S2M(req)
 	if len(rCh) > 0 && rCh[0] {
-		c.frCh = nil
+		delete(c.frChs, req.InstID)
 	}
 	return c.Unsubscribe(false, []constants.ChannelName{"funding-rate"}, m)
 }
@@ -368,7 +380,7 @@ func (c *Public) UIndexCandlesticks(req requests.IndexCandlesticks, rCh ...bool)
 func (c *Public) IndexTickers(req requests.IndexTickers, ch ...chan *public.IndexTickers) error {
 	m := utils.S2M(req)
 	if len(ch) > 0 {
-		c.itCh = ch[0]
+		c.itChs[req.InstID] = ch[0]
 	}
 	return c.Subscribe(false, []constants.ChannelName{"index-tickers"}, m)
 }
@@ -379,7 +391,7 @@ func (c *Public) IndexTickers(req requests.IndexTickers, ch ...chan *public.Inde
 func (c *Public) UIndexTickers(req requests.IndexTickers, rCh ...bool) error {
 	m := utils.S2M(req)
 	if len(rCh) > 0 && rCh[0] {
-		c.itCh = nil
+		delete(c.itChs, req.InstID)
 	}
 	return c.Unsubscribe(false, []constants.ChannelName{"index-tickers"}, m)
 }
@@ -435,8 +447,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			if err := json.Unmarshal(data, &ev); err != nil {
 				return false
 			}
-			if c.trCh != nil {
-				c.trCh <- &ev
+			if instIdRaw, ok := e.Arg.Get("instId"); ok {
+				if trCh, exists := c.trChs[fmt.Sprint(instIdRaw)]; exists && trCh != nil {
+					trCh <- &ev
+				}
 			}
 			if c.StructuredEventChan != nil {
 				c.StructuredEventChan <- ev
@@ -459,8 +473,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			if err := json.Unmarshal(data, &ev); err != nil {
 				return false
 			}
-			if c.mpCh != nil {
-				c.mpCh <- &ev
+			if instIdRaw, ok := e.Arg.Get("instId"); ok {
+				if mpCh, exists := c.mpChs[fmt.Sprint(instIdRaw)]; exists && mpCh != nil {
+					mpCh <- &ev
+				}
 			}
 			if c.StructuredEventChan != nil {
 				c.StructuredEventChan <- ev
@@ -495,8 +511,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			if err := json.Unmarshal(data, &ev); err != nil {
 				return false
 			}
-			if c.frCh != nil {
-				c.frCh <- &ev
+			if instIdRaw, ok := e.Arg.Get("instId"); ok {
+				if frCh, exists := c.frChs[fmt.Sprint(instIdRaw)]; exists && frCh != nil {
+					frCh <- &ev
+				}
 			}
 			if c.StructuredEventChan != nil {
 				c.StructuredEventChan <- ev
@@ -507,8 +525,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			if err := json.Unmarshal(data, &ev); err != nil {
 				return false
 			}
-			if c.itCh != nil {
-				c.itCh <- &ev
+			if instIdRaw, ok := e.Arg.Get("instId"); ok {
+				if itCh, exists := c.itChs[fmt.Sprint(instIdRaw)]; exists && itCh != nil {
+					itCh <- &ev
+				}
 			}
 			if c.StructuredEventChan != nil {
 				c.StructuredEventChan <- ev
@@ -569,8 +589,14 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 				if err := json.Unmarshal(data, &ev); err != nil {
 					return false
 				}
-				if c.obCh != nil {
-					c.obCh <- &ev
+				if instIdRaw, ok := e.Arg.Get("instId"); ok {
+					if !c.verifyOrderBook(chName, fmt.Sprint(instIdRaw), &ev) {
+						return true
+					}
+					key := chName + ":" + fmt.Sprint(instIdRaw)
+					if obCh, exists := c.obChs[key]; exists && obCh != nil {
+						obCh <- &ev
+					}
 				}
 				if c.StructuredEventChan != nil {
 					c.StructuredEventChan <- ev
@@ -1,30 +1,48 @@
 package okex
 
 import (
+	"errors"
 	"fmt"
 
 	okexconstants "github.com/djpken/go-exc/exchanges/okex/constants"
 	publicevents "github.com/djpken/go-exc/exchanges/okex/events/public"
 	publicrequests "github.com/djpken/go-exc/exchanges/okex/requests/ws/public"
 	"github.com/djpken/go-exc/exchanges/okex/ws"
+	"github.com/djpken/go-exc/orderbook"
 	commontypes "github.com/djpken/go-exc/types"
 )
 
+// orderBookChannel is the OKX order book channel streamed by SubscribeOrderBook:
+// a 400 level snapshot followed by incremental updates
+const orderBookChannel = "books"
+
 // WebSocketAdapter adapts OKEx WebSocket client to common interface
 type WebSocketAdapter struct {
-	client         *ws.ClientWs
-	converter      *Converter
-	tickerChannels map[string]chan *commontypes.TickerUpdate              // symbol -> channel
-	candleChannels map[string]map[string]chan *commontypes.CandleUpdate // interval -> symbol -> channel
+	client          *ws.ClientWs
+	converter       *Converter
+	tickerChannels  map[string]chan *commontypes.TickerUpdate            // symbol -> channel
+	candleChannels  map[string]map[string]chan *commontypes.CandleUpdate // interval -> symbol -> channel
+	bookChannels    map[string]chan *commontypes.OrderBookUpdate         // symbol -> channel
+	bookStops       map[string]chan struct{}                             // symbol -> forwarder stop
+	tradeChannels   map[string]chan *commontypes.TradeUpdate             // symbol -> channel
+	tradeStops      map[string]chan struct{}                             // symbol -> forwarder stop
+	fundingChannels map[string]chan *commontypes.FundingRateUpdate       // symbol -> channel
+	markChannels    map[string]chan *commontypes.MarkPriceUpdate         // symbol -> channel
 }
 
 // NewWebSocketAdapter creates a new WebSocket adapter
 func NewWebSocketAdapter(client *ws.ClientWs) *WebSocketAdapter {
 	return &WebSocketAdapter{
-		client:         client,
-		converter:      NewConverter(),
-		tickerChannels: make(map[string]chan *commontypes.TickerUpdate),
-		candleChannels: make(map[string]map[string]chan *commontypes.CandleUpdate),
+		client:          client,
+		converter:       NewConverter(),
+		tickerChannels:  make(map[string]chan *commontypes.TickerUpdate),
+		candleChannels:  make(map[string]map[string]chan *commontypes.CandleUpdate),
+		bookChannels:    make(map[string]chan *commontypes.OrderBookUpdate),
+		bookStops:       make(map[string]chan struct{}),
+		tradeChannels:   make(map[string]chan *commontypes.TradeUpdate),
+		tradeStops:      make(map[string]chan struct{}),
+		fundingChannels: make(map[string]chan *commontypes.FundingRateUpdate),
+		markChannels:    make(map[string]chan *commontypes.MarkPriceUpdate),
 	}
 }
 
@@ -120,7 +138,7 @@ func (a *WebSocketAdapter) forwardTickerEvents(symbol string, internalCh chan *p
 			case userCh <- update:
 			default:
 				// Channel full, drop message
-				fmt.Printf("Warning: ticker channel full for %s, dropping update\n", symbol)
+				a.client.Dropped(false, "tickers", symbol)
 			}
 		}
 	}
@@ -204,7 +222,7 @@ func (a *WebSocketAdapter) forwardCandleEvents(symbol, interval string, internal
 			case userCh <- update:
 			default:
 				// Channel full, drop message
-				fmt.Printf("Warning: candle channel full for %s %s, dropping update\n", symbol, interval)
+				a.client.Dropped(false, "candle"+interval, symbol)
 			}
 		}
 	}
@@ -237,3 +255,409 @@ func (a *WebSocketAdapter) UnsubscribeCandles(interval string, symbols ...string
 
 	return nil
 }
+
+// SubscribeOrderBook streams the top depth levels of a local order book per symbol,
+// kept from the books channel snapshot and its incremental updates. A sequence gap
+// resubscribes the symbol, making OKX push a new snapshot.
+func (a *WebSocketAdapter) SubscribeOrderBook(userCh chan *commontypes.OrderBookUpdate, depth int, symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		// Create internal channel for this symbol's order book events
+		internalCh := make(chan *publicevents.OrderBook, 100)
+
+		req := publicrequests.OrderBook{
+			InstID:  symbol,
+			Channel: orderBookChannel,
+		}
+		if err := a.client.Public.OrderBook([]publicrequests.OrderBook{req}, internalCh); err != nil {
+			return fmt.Errorf("failed to subscribe to %s order book: %w", symbol, err)
+		}
+
+		// Store the user channel
+		a.bookChannels[symbol] = userCh
+
+		// Replace the forwarder of an earlier subscription to the symbol
+		if stop, ok := a.bookStops[symbol]; ok {
+			close(stop)
+		}
+		stop := make(chan struct{})
+		a.bookStops[symbol] = stop
+
+		// Start goroutine to maintain the book and forward updates
+		go a.forwardOrderBookEvents(symbol, depth, internalCh, userCh, stop)
+	}
+
+	return nil
+}
+
+// forwardOrderBookEvents applies OKEx order book events to the local book of symbol
+// and forwards its top levels until stop is closed
+func (a *WebSocketAdapter) forwardOrderBookEvents(symbol string, depth int, internalCh chan *publicevents.OrderBook, userCh chan *commontypes.OrderBookUpdate, stop chan struct{}) {
+	book := orderbook.New()
+	for {
+		var event *publicevents.OrderBook
+		select {
+		case <-stop:
+			return
+		case e, ok := <-internalCh:
+			if !ok {
+				return
+			}
+			event = e
+		}
+		for _, data := range event.Books {
+			bids := a.converter.ConvertOrderBookLevels(data.Bids)
+			asks := a.converter.ConvertOrderBookLevels(data.Asks)
+
+			snapshot := event.Action == "snapshot"
+			var delta commontypes.OrderBookDelta
+			if snapshot {
+				delta = book.Snapshot(bids, asks, data.SeqID)
+			} else {
+				delta = commontypes.OrderBookDelta{Bids: bids, Asks: asks}
+				if err := book.Apply(delta, data.PrevSeqID, data.SeqID); err != nil {
+					// Updates received before the new snapshot are skipped
+					if errors.Is(err, orderbook.ErrGap) {
+						a.resyncOrderBook(symbol, data.PrevSeqID, book.Seq())
+					}
+					continue
+				}
+			}
+
+			topBids, topAsks := book.Top(depth)
+			update := &commontypes.OrderBookUpdate{
+				Symbol:    symbol,
+				Bids:      topBids,
+				Asks:      topAsks,
+				Delta:     delta,
+				Snapshot:  snapshot,
+				Sequence:  data.SeqID,
+				Timestamp: commontypes.Timestamp(data.TS),
+				Extra: map[string]interface{}{
+					"checksum": data.Checksum,
+				},
+			}
+
+			// Forward to user channel
+			select {
+			case userCh <- update:
+			default:
+				// Channel full, drop message
+				a.client.Dropped(false, orderBookChannel, symbol)
+			}
+		}
+	}
+}
+
+// resyncOrderBook resubscribes to the order book of symbol after a sequence gap
+func (a *WebSocketAdapter) resyncOrderBook(symbol string, prevSeq, lastSeq int64) {
+	a.client.Logger().Warn("order book sequence gap, resyncing", "channel", orderBookChannel, "symbol", symbol,
+		"prev_seq", prevSeq, "last_seq", lastSeq)
+
+	req := publicrequests.OrderBook{
+		InstID:  symbol,
+		Channel: orderBookChannel,
+	}
+	if err := a.client.Public.UOrderBook(req); err != nil {
+		a.client.Logger().Error("failed to unsubscribe order book", "symbol", symbol, "error", err)
+	}
+	if err := a.client.Public.OrderBook([]publicrequests.OrderBook{req}); err != nil {
+		a.client.Logger().Error("failed to resubscribe order book", "symbol", symbol, "error", err)
+	}
+}
+
+// UnsubscribeOrderBook unsubscribes from order book updates for specified symbols
+func (a *WebSocketAdapter) UnsubscribeOrderBook(symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		req := publicrequests.OrderBook{
+			InstID:  symbol,
+			Channel: orderBookChannel,
+		}
+		if err := a.client.Public.UOrderBook(req, true); err != nil {
+			return fmt.Errorf("failed to unsubscribe from %s order book: %w", symbol, err)
+		}
+
+		// Remove from tracking and stop the forwarder
+		delete(a.bookChannels, symbol)
+		if stop, ok := a.bookStops[symbol]; ok {
+			close(stop)
+			delete(a.bookStops, symbol)
+		}
+	}
+
+	return nil
+}
+
+// SubscribeTrades subscribes to public trades for specified symbols
+func (a *WebSocketAdapter) SubscribeTrades(userCh chan *commontypes.TradeUpdate, symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		internalCh := make(chan *publicevents.Trades, 100)
+
+		req := publicrequests.Trades{
+			InstID: symbol,
+		}
+		if err := a.client.Public.Trades(req, internalCh); err != nil {
+			return fmt.Errorf("failed to subscribe to %s trades: %w", symbol, err)
+		}
+
+		// Store the user channel
+		a.tradeChannels[symbol] = userCh
+
+		// Replace the forwarder of an earlier subscription to the symbol
+		if stop, ok := a.tradeStops[symbol]; ok {
+			close(stop)
+		}
+		stop := make(chan struct{})
+		a.tradeStops[symbol] = stop
+
+		go a.forwardTradeEvents(symbol, internalCh, userCh, stop)
+	}
+
+	return nil
+}
+
+// forwardTradeEvents converts OKEx trade events to common types and forwards them
+// until stop is closed. OKX trade IDs are sequential per instrument, a push
+// aggregating Count trades under the ID of the last one, so missed trades show
+// as a jump in IDs.
+func (a *WebSocketAdapter) forwardTradeEvents(symbol string, internalCh chan *publicevents.Trades, userCh chan *commontypes.TradeUpdate, stop chan struct{}) {
+	var lastID int64
+	for {
+		var event *publicevents.Trades
+		select {
+		case <-stop:
+			return
+		case e, ok := <-internalCh:
+			if !ok {
+				return
+			}
+			event = e
+		}
+		for _, t := range event.Trades {
+			trade := a.converter.ConvertPublicTrade(t)
+			if trade == nil {
+				continue
+			}
+			update := &commontypes.TradeUpdate{Trade: *trade}
+
+			id := int64(t.TradeID)
+			count := max(int64(t.Count), 1)
+			if lastID != 0 && id-count > lastID {
+				update.Missed = id - count - lastID
+			}
+			lastID = max(lastID, id)
+			update.Extra["count"] = count
+
+			select {
+			case userCh <- update:
+			default:
+				a.client.Dropped(false, "trades", symbol)
+			}
+		}
+	}
+}
+
+// UnsubscribeTrades unsubscribes from public trades for specified symbols
+func (a *WebSocketAdapter) UnsubscribeTrades(symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		req := publicrequests.Trades{
+			InstID: symbol,
+		}
+		if err := a.client.Public.UTrades(req, true); err != nil {
+			return fmt.Errorf("failed to unsubscribe from %s trades: %w", symbol, err)
+		}
+
+		// Remove from tracking and stop the forwarder
+		delete(a.tradeChannels, symbol)
+		if stop, ok := a.tradeStops[symbol]; ok {
+			close(stop)
+			delete(a.tradeStops, symbol)
+		}
+	}
+
+	return nil
+}
+
+// SubscribeFundingRate subscribes to funding rate updates of perpetual swaps
+func (a *WebSocketAdapter) SubscribeFundingRate(userCh chan *commontypes.FundingRateUpdate, symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		internalCh := make(chan *publicevents.FundingRate, 100)
+
+		req := publicrequests.FundingRate{
+			InstID: symbol,
+		}
+		if err := a.client.Public.FundingRate(req, internalCh); err != nil {
+			return fmt.Errorf("failed to subscribe to %s funding rate: %w", symbol, err)
+		}
+
+		// Store the user channel
+		a.fundingChannels[symbol] = userCh
+
+		go a.forwardFundingRateEvents(symbol, internalCh, userCh)
+	}
+
+	return nil
+}
+
+// forwardFundingRateEvents converts OKEx funding rate events to common types and forwards them
+func (a *WebSocketAdapter) forwardFundingRateEvents(symbol string, internalCh chan *publicevents.FundingRate, userCh chan *commontypes.FundingRateUpdate) {
+	for event := range internalCh {
+		for _, r := range event.Rates {
+			rate := a.converter.ConvertFundingRate(r)
+			if rate == nil {
+				continue
+			}
+
+			select {
+			case userCh <- &commontypes.FundingRateUpdate{FundingRate: *rate}:
+			default:
+				a.client.Dropped(false, "funding-rate", symbol)
+			}
+		}
+	}
+}
+
+// UnsubscribeFundingRate unsubscribes from funding rate updates for specified symbols
+func (a *WebSocketAdapter) UnsubscribeFundingRate(symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		req := publicrequests.FundingRate{
+			InstID: symbol,
+		}
+		if err := a.client.Public.UFundingRate(req, true); err != nil {
+			return fmt.Errorf("failed to unsubscribe from %s funding rate: %w", symbol, err)
+		}
+
+		// Remove from tracking
+		delete(a.fundingChannels, symbol)
+	}
+
+	return nil
+}
+
+// SubscribeMarkPrice subscribes to mark price updates, combining the mark-price
+// channel of each contract with the index-tickers channel of its underlying
+func (a *WebSocketAdapter) SubscribeMarkPrice(userCh chan *commontypes.MarkPriceUpdate, symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		markCh := make(chan *publicevents.MarkPrice, 100)
+		indexCh := make(chan *publicevents.IndexTickers, 100)
+
+		indexReq := publicrequests.IndexTickers{
+			InstID: a.converter.constantsConverter.IndexInstID(symbol),
+		}
+		if err := a.client.Public.IndexTickers(indexReq, indexCh); err != nil {
+			return fmt.Errorf("failed to subscribe to %s index tickers: %w", indexReq.InstID, err)
+		}
+		req := publicrequests.MarkPrice{
+			InstID: symbol,
+		}
+		if err := a.client.Public.MarkPrice(req, markCh); err != nil {
+			return fmt.Errorf("failed to subscribe to %s mark price: %w", symbol, err)
+		}
+
+		// Store the user channel
+		a.markChannels[symbol] = userCh
+
+		go a.forwardMarkPriceEvents(symbol, markCh, indexCh, userCh)
+	}
+
+	return nil
+}
+
+// forwardMarkPriceEvents converts OKEx mark price events to common types and forwards
+// them with the latest index price received
+func (a *WebSocketAdapter) forwardMarkPriceEvents(symbol string, markCh chan *publicevents.MarkPrice, indexCh chan *publicevents.IndexTickers, userCh chan *commontypes.MarkPriceUpdate) {
+	var indexPrice float64
+	for markCh != nil || indexCh != nil {
+		select {
+		case event, ok := <-indexCh:
+			if !ok {
+				indexCh = nil
+				continue
+			}
+			for _, t := range event.Tickers {
+				indexPrice = float64(t.IdxPx)
+			}
+		case event, ok := <-markCh:
+			if !ok {
+				markCh = nil
+				continue
+			}
+			for _, p := range event.Prices {
+				price := a.converter.ConvertMarkPrice(p, indexPrice)
+				if price == nil {
+					continue
+				}
+
+				select {
+				case userCh <- &commontypes.MarkPriceUpdate{MarkPrice: *price}:
+				default:
+					a.client.Dropped(false, "mark-price", symbol)
+				}
+			}
+		}
+	}
+}
+
+// UnsubscribeMarkPrice unsubscribes from mark price updates for specified symbols,
+// keeping index tickers still used by other subscribed contracts
+func (a *WebSocketAdapter) UnsubscribeMarkPrice(symbols ...string) error {
+	if len(symbols) == 0 {
+		return fmt.Errorf("no symbols specified")
+	}
+
+	for _, symbol := range symbols {
+		req := publicrequests.MarkPrice{
+			InstID: symbol,
+		}
+		if err := a.client.Public.UMarkPrice(req, true); err != nil {
+			return fmt.Errorf("failed to unsubscribe from %s mark price: %w", symbol, err)
+		}
+
+		// Remove from tracking
+		delete(a.markChannels, symbol)
+
+		index := a.converter.constantsConverter.IndexInstID(symbol)
+		shared := false
+		for other := range a.markChannels {
+			if a.converter.constantsConverter.IndexInstID(other) == index {
+				shared = true
+				break
+			}
+		}
+		if shared {
+			continue
+		}
+		if err := a.client.Public.UIndexTickers(publicrequests.IndexTickers{InstID: index}, true); err != nil {
+			return fmt.Errorf("failed to unsubscribe from %s index tickers: %w", index, err)
+		}
+	}
+
+	return nil
+}
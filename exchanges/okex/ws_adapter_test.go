@@ -0,0 +1,174 @@
+package okex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/djpken/go-exc/exchanges/okex/constants"
+	publicevents "github.com/djpken/go-exc/exchanges/okex/events/public"
+	"github.com/djpken/go-exc/exchanges/okex/ws"
+	commontypes "github.com/djpken/go-exc/types"
+	"github.com/gorilla/websocket"
+)
+
+// newTestWebSocketAdapter returns an adapter whose client is connected to a
+// websocket server that sends each operation argument it receives to ops as
+// "op channel:instId"
+func newTestWebSocketAdapter(t *testing.T, ops chan string) *WebSocketAdapter {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("upgrade: %v", err)
+			return
+		}
+		defer conn.Close()
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var msg struct {
+				Op   string              `json:"op"`
+				Args []map[string]string `json:"args"`
+			}
+			if json.Unmarshal(data, &msg) != nil {
+				continue // ping
+			}
+			for _, arg := range msg.Args {
+				ops <- msg.Op + " " + arg["channel"] + ":" + arg["instId"]
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	url := constants.BaseURL("ws" + strings.TrimPrefix(srv.URL, "http"))
+	client := ws.NewClient(context.Background(), "", "", "", map[bool]constants.BaseURL{false: url})
+	t.Cleanup(client.Cancel)
+	return NewWebSocketAdapter(client)
+}
+
+func TestWebSocketAdapter_ForwardTradeEvents(t *testing.T) {
+	adapter := NewWebSocketAdapter(nil)
+
+	internalCh := make(chan *publicevents.Trades, 10)
+	for _, data := range []string{
+		`[{"instId":"BTC-USDT-SWAP","tradeId":"100","px":"42000.1","sz":"2","side":"buy","ts":"1700000000000","count":"1"}]`,
+		`[{"instId":"BTC-USDT-SWAP","tradeId":"103","px":"42000","sz":"5","side":"sell","ts":"1700000000100","count":"3"}]`,
+		`[{"instId":"BTC-USDT-SWAP","tradeId":"106","px":"41999.5","sz":"1","side":"sell","ts":"1700000000200","count":"1"}]`,
+	} {
+		ev := &publicevents.Trades{}
+		if err := json.Unmarshal([]byte(data), &ev.Trades); err != nil {
+			t.Fatal(err)
+		}
+		internalCh <- ev
+	}
+	close(internalCh)
+
+	userCh := make(chan *commontypes.TradeUpdate, 10)
+	adapter.forwardTradeEvents("BTC-USDT-SWAP", internalCh, userCh, make(chan struct{}))
+	close(userCh)
+
+	var updates []*commontypes.TradeUpdate
+	for u := range userCh {
+		updates = append(updates, u)
+	}
+	if len(updates) != 3 {
+		t.Fatalf("got %d updates, expected 3", len(updates))
+	}
+
+	u := updates[0]
+	if u.ID != "100" || u.Side != "buy" || u.Price.String() != "42000.1" || u.Quantity.String() != "2" ||
+		u.Symbol != "BTC-USDT-SWAP" || u.Timestamp.UnixMilli() != 1700000000000 {
+		t.Errorf("trade = %+v", u.Trade)
+	}
+	if updates[1].Missed != 0 {
+		t.Errorf("aggregated trades Missed = %d, expected 0", updates[1].Missed)
+	}
+	if updates[2].Missed != 2 {
+		t.Errorf("Missed = %d, expected the 2 trades between IDs 103 and 106", updates[2].Missed)
+	}
+}
+
+func TestWebSocketAdapter_ForwardMarkPriceEvents(t *testing.T) {
+	adapter := NewWebSocketAdapter(nil)
+
+	markCh := make(chan *publicevents.MarkPrice)
+	indexCh := make(chan *publicevents.IndexTickers)
+	userCh := make(chan *commontypes.MarkPriceUpdate, 10)
+	done := make(chan struct{})
+	go func() {
+		adapter.forwardMarkPriceEvents("BTC-USDT-SWAP", markCh, indexCh, userCh)
+		close(done)
+	}()
+
+	index := &publicevents.IndexTickers{}
+	if err := json.Unmarshal([]byte(`[{"instId":"BTC-USDT","idxPx":"41990.5","ts":"1700000000000"}]`), &index.Tickers); err != nil {
+		t.Fatal(err)
+	}
+	mark := &publicevents.MarkPrice{}
+	if err := json.Unmarshal([]byte(`[{"instId":"BTC-USDT-SWAP","instType":"SWAP","markPx":"42000.2","ts":"1700000000100"}]`), &mark.Prices); err != nil {
+		t.Fatal(err)
+	}
+	indexCh <- index
+	markCh <- mark
+	close(indexCh)
+	close(markCh)
+	<-done
+	close(userCh)
+
+	var updates []*commontypes.MarkPriceUpdate
+	for u := range userCh {
+		updates = append(updates, u)
+	}
+	if len(updates) != 1 {
+		t.Fatalf("got %d updates, expected 1", len(updates))
+	}
+	u := updates[0]
+	if u.Symbol != "BTC-USDT-SWAP" || u.MarkPrice.MarkPrice.String() != "42000.2" || u.IndexPrice.String() != "41990.5" ||
+		u.Timestamp.UnixMilli() != 1700000000100 {
+		t.Errorf("mark price = %+v", u.MarkPrice)
+	}
+}
+
+func TestWebSocketAdapter_ResyncOrderBook(t *testing.T) {
+	ops := make(chan string, 10)
+	adapter := newTestWebSocketAdapter(t, ops)
+
+	userCh := make(chan *commontypes.OrderBookUpdate, 10)
+	if err := adapter.SubscribeOrderBook(userCh, 5, "BTC-USDT-SWAP"); err != nil {
+		t.Fatalf("SubscribeOrderBook() error = %v", err)
+	}
+	adapter.resyncOrderBook("BTC-USDT-SWAP", 10, 12)
+
+	// The resynced book is saved once for reconnects
+	subs := adapter.client.Subscriptions(false)
+	if len(subs) != 1 || subs[0]["channel"] != orderBookChannel || subs[0]["instId"] != "BTC-USDT-SWAP" {
+		t.Errorf("Subscriptions() = %v after a resync, expected books BTC-USDT-SWAP", subs)
+	}
+
+	if err := adapter.UnsubscribeOrderBook("BTC-USDT-SWAP"); err != nil {
+		t.Fatalf("UnsubscribeOrderBook() error = %v", err)
+	}
+	if subs := adapter.client.Subscriptions(false); len(subs) != 0 {
+		t.Errorf("Subscriptions() = %v after UnsubscribeOrderBook, expected none", subs)
+	}
+
+	for _, expected := range []string{"subscribe books:BTC-USDT-SWAP", "unsubscribe books:BTC-USDT-SWAP",
+		"subscribe books:BTC-USDT-SWAP", "unsubscribe books:BTC-USDT-SWAP"} {
+		select {
+		case op := <-ops:
+			if op != expected {
+				t.Errorf("sent %q, expected %q", op, expected)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("%q not sent", expected)
+		}
+	}
+}
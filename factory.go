@@ -9,45 +9,48 @@ import (
 )
 
 // NewExchange creates a new exchange instance based on the exchange type
-func NewExchange(ctx context.Context, exchangeType ExchangeType, cfg Config) (Exchange, error) {
+// opts configure the HTTP client, endpoints, WebSocket dialer and user agent.
+// The client is wrapped by WithCanonicalSymbols; Unwrap returns the
+// exchange-specific client, e.g. *okex.OKExExchange.
+func NewExchange(ctx context.Context, exchangeType ExchangeType, cfg Config, opts ...Option) (Exchange, error) {
 	switch exchangeType {
 	case OKX:
-		return newOKExExchange(ctx, cfg, false)
+		return newOKExExchange(ctx, cfg, false, opts)
 	case OKXTest:
-		return newOKExExchange(ctx, cfg, true)
+		return newOKExExchange(ctx, cfg, true, opts)
 	case Bitmart:
-		return newBitMartExchange(ctx, cfg, false)
+		return newBitMartExchange(ctx, cfg, false, opts)
 	case BitmartTest:
-		return newBitMartExchange(ctx, cfg, true)
+		return newBitMartExchange(ctx, cfg, true, opts)
 	case BingX:
-		return newBingXExchange(ctx, cfg, false)
+		return newBingXExchange(ctx, cfg, false, opts)
 	case BingXTest:
-		return newBingXExchange(ctx, cfg, true)
+		return newBingXExchange(ctx, cfg, true, opts)
 	default:
 		return nil, ErrInvalidExchange
 	}
 }
 
 // newOKExExchange creates a new OKEx exchange instance
-func newOKExExchange(ctx context.Context, cfg Config, testMode bool) (Exchange, error) {
-	client, err := okex.NewOKExExchange(ctx, cfg.APIKey, cfg.SecretKey, cfg.Passphrase, testMode)
+func newOKExExchange(ctx context.Context, cfg Config, testMode bool, opts []Option) (Exchange, error) {
+	client, err := okex.NewOKExExchange(ctx, cfg.APIKey, cfg.SecretKey, cfg.Passphrase, testMode, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return client, nil
+	return WithCanonicalSymbols(client, okex.NewSymbolMapper()), nil
 }
 
 // newBingXExchange creates a new BingX exchange instance
-func newBingXExchange(ctx context.Context, cfg Config, testMode bool) (Exchange, error) {
-	client, err := bingx.NewBingXExchange(ctx, cfg.APIKey, cfg.SecretKey, testMode)
+func newBingXExchange(ctx context.Context, cfg Config, testMode bool, opts []Option) (Exchange, error) {
+	client, err := bingx.NewBingXExchange(ctx, cfg.APIKey, cfg.SecretKey, testMode, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return client, nil
+	return WithCanonicalSymbols(client, bingx.NewSymbolMapper()), nil
 }
 
 // newBitMartExchange creates a new Bitmart exchange instance
-func newBitMartExchange(ctx context.Context, cfg Config, testMode bool) (Exchange, error) {
+func newBitMartExchange(ctx context.Context, cfg Config, testMode bool, opts []Option) (Exchange, error) {
 	// Bitmart requires memo which should be in Extra["memo"]
 	memo := ""
 	if cfg.Extra != nil {
@@ -56,9 +59,9 @@ func newBitMartExchange(ctx context.Context, cfg Config, testMode bool) (Exchang
 		}
 	}
 
-	client, err := bitmart.NewBitMartExchange(ctx, cfg.APIKey, cfg.SecretKey, memo, testMode)
+	client, err := bitmart.NewBitMartExchange(ctx, cfg.APIKey, cfg.SecretKey, memo, testMode, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return client, nil
+	return WithCanonicalSymbols(client, bitmart.NewSymbolMapper()), nil
 }
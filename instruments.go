@@ -0,0 +1,259 @@
+package exc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// InstrumentRegistry caches instrument metadata of one exchange and snaps
+// order prices and quantities to each instrument's tick and lot size.
+//
+// Usage:
+//
+//	registry, _ := exc.NewInstrumentRegistry(ctx, client, exc.InstrumentSwap, 10*time.Minute)
+//	defer registry.Close()
+//	price, _ := registry.RoundPrice("BTC/USDT:USDT", exc.MustDecimal("65000.123"))
+type InstrumentRegistry struct {
+	exchange Exchange
+	instType InstrumentType
+
+	mu          sync.RWMutex
+	instruments map[string]*Instrument
+	updatedAt   time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewInstrumentRegistry creates a registry for ex and loads its instruments of
+// instType. When refresh is positive the instruments are reloaded in the
+// background at that interval until ctx is cancelled or Close is called; a
+// failed reload keeps the previous snapshot.
+func NewInstrumentRegistry(ctx context.Context, ex Exchange, instType InstrumentType, refresh time.Duration) (*InstrumentRegistry, error) {
+	r := &InstrumentRegistry{
+		exchange:    ex,
+		instType:    instType,
+		instruments: make(map[string]*Instrument),
+		done:        make(chan struct{}),
+	}
+	if err := r.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	if refresh <= 0 {
+		close(r.done)
+		return r, nil
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(refresh)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = r.Refresh(ctx)
+			}
+		}
+	}()
+	return r, nil
+}
+
+// Close stops the background refresh and waits for it to exit
+func (r *InstrumentRegistry) Close() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	<-r.done
+}
+
+// Refresh reloads all instruments from the exchange
+func (r *InstrumentRegistry) Refresh(ctx context.Context) error {
+	instruments, err := r.exchange.GetInstruments(ctx, GetInstrumentsRequest{InstrumentType: r.instType})
+	if err != nil {
+		return fmt.Errorf("exc: load instruments: %w", err)
+	}
+
+	m := make(map[string]*Instrument, len(instruments))
+	for _, inst := range instruments {
+		if inst != nil {
+			m[inst.Symbol] = inst
+		}
+	}
+
+	r.mu.Lock()
+	r.instruments = m
+	r.updatedAt = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+// UpdatedAt returns the time of the last successful refresh
+func (r *InstrumentRegistry) UpdatedAt() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.updatedAt
+}
+
+// Instrument returns the cached instrument for symbol
+func (r *InstrumentRegistry) Instrument(symbol string) (*Instrument, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	inst, ok := r.instruments[symbol]
+	return inst, ok
+}
+
+// RoundPrice rounds price to the nearest multiple of the instrument's tick size.
+// The price is returned unchanged when the tick size is unknown.
+func (r *InstrumentRegistry) RoundPrice(symbol string, price Decimal) (Decimal, error) {
+	inst, ok := r.Instrument(symbol)
+	if !ok {
+		return price, fmt.Errorf("exc: unknown instrument %s: %w", symbol, ErrInvalidSymbol)
+	}
+	return roundToStep(price, inst.PricePrecision, false), nil
+}
+
+// RoundQuantity rounds quantity down to a multiple of the instrument's lot size,
+// so the rounded order is never larger than requested. The quantity is returned
+// unchanged when the lot size is unknown.
+func (r *InstrumentRegistry) RoundQuantity(symbol string, quantity Decimal) (Decimal, error) {
+	inst, ok := r.Instrument(symbol)
+	if !ok {
+		return quantity, fmt.Errorf("exc: unknown instrument %s: %w", symbol, ErrInvalidSymbol)
+	}
+	return roundToStep(quantity, inst.QuantityPrecision, true), nil
+}
+
+// ValidateOrder checks req against the instrument's tick size, lot size,
+// minimum and maximum order size and minimum notional. With round set, an
+// off-grid price or quantity is snapped in place; otherwise it is rejected.
+// Errors wrap ErrInvalidOrder, or ErrInvalidSymbol for unknown instruments.
+func (r *InstrumentRegistry) ValidateOrder(req *PlaceOrderRequest, round bool) error {
+	inst, ok := r.Instrument(req.Symbol)
+	if !ok {
+		return fmt.Errorf("exc: unknown instrument %s: %w", req.Symbol, ErrInvalidSymbol)
+	}
+
+	if !req.Price.IsZero() {
+		price := roundToStep(req.Price, inst.PricePrecision, false)
+		if !price.Decimal.Equal(req.Price.Decimal) {
+			if !round {
+				return fmt.Errorf("exc: %s price %s is not a multiple of tick size %s: %w", req.Symbol, req.Price, inst.PricePrecision, ErrInvalidOrder)
+			}
+			req.Price = price
+		}
+		if !req.Price.IsPositive() {
+			return fmt.Errorf("exc: %s price %s rounds to zero with tick size %s: %w", req.Symbol, req.Price, inst.PricePrecision, ErrInvalidOrder)
+		}
+	}
+
+	quantity := roundToStep(req.Quantity, inst.QuantityPrecision, true)
+	if !quantity.Decimal.Equal(req.Quantity.Decimal) {
+		if !round {
+			return fmt.Errorf("exc: %s quantity %s is not a multiple of lot size %s: %w", req.Symbol, req.Quantity, inst.QuantityPrecision, ErrInvalidOrder)
+		}
+		req.Quantity = quantity
+	}
+
+	switch {
+	case !req.Quantity.IsPositive():
+		return fmt.Errorf("exc: %s quantity %s must be positive: %w", req.Symbol, req.Quantity, ErrInvalidOrder)
+	case inst.MinOrderSize.IsPositive() && req.Quantity.Decimal.LessThan(inst.MinOrderSize.Decimal):
+		return fmt.Errorf("exc: %s quantity %s is below the minimum order size %s: %w", req.Symbol, req.Quantity, inst.MinOrderSize, ErrInvalidOrder)
+	case inst.MaxOrderSize.IsPositive() && req.Quantity.Decimal.GreaterThan(inst.MaxOrderSize.Decimal):
+		return fmt.Errorf("exc: %s quantity %s is above the maximum order size %s: %w", req.Symbol, req.Quantity, inst.MaxOrderSize, ErrInvalidOrder)
+	}
+
+	// Notional can only be checked when the order carries a price
+	if inst.MinNotional.IsPositive() && req.Price.IsPositive() {
+		notional := req.Quantity.Decimal.Mul(req.Price.Decimal)
+		if inst.CtVal.IsPositive() {
+			notional = notional.Mul(inst.CtVal.Decimal)
+		}
+		if notional.LessThan(inst.MinNotional.Decimal) {
+			return fmt.Errorf("exc: %s order value %s is below the minimum notional %s: %w", req.Symbol, notional, inst.MinNotional, ErrInvalidOrder)
+		}
+	}
+	return nil
+}
+
+// roundToStep rounds v to a multiple of step, to the nearest multiple or
+// down when floor is set. A non-positive step leaves v unchanged.
+func roundToStep(v, step Decimal, floor bool) Decimal {
+	if !step.IsPositive() {
+		return v
+	}
+	steps := v.Decimal.Div(step.Decimal)
+	if floor {
+		steps = steps.Floor()
+	} else {
+		steps = steps.Round(0)
+	}
+	return Decimal{Decimal: steps.Mul(step.Decimal)}
+}
+
+// WithOrderValidation wraps ex so that PlaceOrder, PlaceSingleOrder and
+// PlaceMultiOrder check every order against registry before any network
+// call. With round set, prices and quantities are snapped to the instrument
+// grid; otherwise off-grid orders are rejected. In PlaceMultiOrder, rejected
+// orders get an error result and the remaining orders are still sent.
+func WithOrderValidation(ex Exchange, registry *InstrumentRegistry, round bool) Exchange {
+	return &validatingExchange{Exchange: ex, registry: registry, round: round}
+}
+
+// validatingExchange is the Exchange returned by WithOrderValidation
+type validatingExchange struct {
+	Exchange
+	registry *InstrumentRegistry
+	round    bool
+}
+
+// PlaceOrder validates req and places it
+func (e *validatingExchange) PlaceOrder(ctx context.Context, req PlaceOrderRequest) (*Order, error) {
+	if err := e.registry.ValidateOrder(&req, e.round); err != nil {
+		return nil, err
+	}
+	return e.Exchange.PlaceOrder(ctx, req)
+}
+
+// PlaceSingleOrder validates req and places it
+func (e *validatingExchange) PlaceSingleOrder(ctx context.Context, req PlaceOrderRequest) (*PlaceOrderResult, error) {
+	if err := e.registry.ValidateOrder(&req, e.round); err != nil {
+		return &PlaceOrderResult{Error: err}, nil
+	}
+	return e.Exchange.PlaceSingleOrder(ctx, req)
+}
+
+// PlaceMultiOrder validates each request and places the valid ones in one call
+func (e *validatingExchange) PlaceMultiOrder(ctx context.Context, reqs []PlaceOrderRequest) ([]*PlaceOrderResult, error) {
+	results := make([]*PlaceOrderResult, len(reqs))
+	valid := make([]PlaceOrderRequest, 0, len(reqs))
+	index := make([]int, 0, len(reqs))
+	for i, req := range reqs {
+		if err := e.registry.ValidateOrder(&req, e.round); err != nil {
+			results[i] = &PlaceOrderResult{Error: err}
+			continue
+		}
+		valid = append(valid, req)
+		index = append(index, i)
+	}
+	if len(valid) == 0 {
+		return results, nil
+	}
+
+	placed, err := e.Exchange.PlaceMultiOrder(ctx, valid)
+	if err != nil {
+		return nil, err
+	}
+	for j, result := range placed {
+		if j < len(index) {
+			results[index[j]] = result
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,126 @@
+package exc
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// fakeExchange serves a fixed instrument list and records placed orders
+type fakeExchange struct {
+	Exchange
+	instruments []*Instrument
+	placed      []PlaceOrderRequest
+}
+
+func (f *fakeExchange) GetInstruments(ctx context.Context, req GetInstrumentsRequest) ([]*Instrument, error) {
+	return f.instruments, nil
+}
+
+func (f *fakeExchange) PlaceMultiOrder(ctx context.Context, reqs []PlaceOrderRequest) ([]*PlaceOrderResult, error) {
+	f.placed = append(f.placed, reqs...)
+	results := make([]*PlaceOrderResult, len(reqs))
+	for i, req := range reqs {
+		results[i] = &PlaceOrderResult{Order: &Order{Symbol: req.Symbol, Quantity: req.Quantity}}
+	}
+	return results, nil
+}
+
+func newTestRegistry(t *testing.T) (*InstrumentRegistry, *fakeExchange) {
+	t.Helper()
+	ex := &fakeExchange{instruments: []*Instrument{{
+		Symbol:            "BTC-USDT",
+		PricePrecision:    MustDecimal("0.1"),
+		QuantityPrecision: MustDecimal("0.001"),
+		MinOrderSize:      MustDecimal("0.001"),
+		MaxOrderSize:      MustDecimal("100"),
+		MinNotional:       MustDecimal("5"),
+	}}}
+	registry, err := NewInstrumentRegistry(context.Background(), ex, InstrumentSpot, 0)
+	if err != nil {
+		t.Fatalf("NewInstrumentRegistry() error = %v", err)
+	}
+	t.Cleanup(registry.Close)
+	return registry, ex
+}
+
+func TestInstrumentRegistry_Round(t *testing.T) {
+	registry, _ := newTestRegistry(t)
+
+	price, err := registry.RoundPrice("BTC-USDT", MustDecimal("65000.16"))
+	if err != nil || price.String() != "65000.2" {
+		t.Errorf("RoundPrice() = %v, %v, expected 65000.2", price, err)
+	}
+
+	quantity, err := registry.RoundQuantity("BTC-USDT", MustDecimal("0.0129"))
+	if err != nil || quantity.String() != "0.012" {
+		t.Errorf("RoundQuantity() = %v, %v, expected 0.012", quantity, err)
+	}
+
+	if _, err := registry.RoundPrice("ETH-USDT", MustDecimal("1")); !errors.Is(err, ErrInvalidSymbol) {
+		t.Errorf("RoundPrice(unknown) error = %v, expected ErrInvalidSymbol", err)
+	}
+}
+
+func TestInstrumentRegistry_ValidateOrder(t *testing.T) {
+	registry, _ := newTestRegistry(t)
+
+	tests := []struct {
+		name             string
+		req              PlaceOrderRequest
+		round            bool
+		wantErr          error
+		expectedPrice    string
+		expectedQuantity string
+	}{
+		{"on grid", PlaceOrderRequest{Symbol: "BTC-USDT", Price: MustDecimal("65000.1"), Quantity: MustDecimal("0.01")}, false, nil, "65000.1", "0.01"},
+		{"rounded", PlaceOrderRequest{Symbol: "BTC-USDT", Price: MustDecimal("65000.14"), Quantity: MustDecimal("0.0109")}, true, nil, "65000.1", "0.01"},
+		{"off grid rejected", PlaceOrderRequest{Symbol: "BTC-USDT", Price: MustDecimal("65000.14"), Quantity: MustDecimal("0.01")}, false, ErrInvalidOrder, "", ""},
+		{"market order", PlaceOrderRequest{Symbol: "BTC-USDT", Quantity: MustDecimal("0.002")}, false, nil, "0", "0.002"},
+		{"rounds below minimum", PlaceOrderRequest{Symbol: "BTC-USDT", Quantity: MustDecimal("0.0009")}, true, ErrInvalidOrder, "", ""},
+		{"above maximum", PlaceOrderRequest{Symbol: "BTC-USDT", Quantity: MustDecimal("101")}, false, ErrInvalidOrder, "", ""},
+		{"below notional", PlaceOrderRequest{Symbol: "BTC-USDT", Price: MustDecimal("1000"), Quantity: MustDecimal("0.001")}, false, ErrInvalidOrder, "", ""},
+		{"unknown symbol", PlaceOrderRequest{Symbol: "ETH-USDT", Quantity: MustDecimal("1")}, false, ErrInvalidSymbol, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			err := registry.ValidateOrder(&req, tt.round)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateOrder() error = %v, expected %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if req.Price.String() != tt.expectedPrice || req.Quantity.String() != tt.expectedQuantity {
+				t.Errorf("ValidateOrder() = price %v quantity %v, expected %v %v", req.Price, req.Quantity, tt.expectedPrice, tt.expectedQuantity)
+			}
+		})
+	}
+}
+
+func TestWithOrderValidation_PlaceMultiOrder(t *testing.T) {
+	registry, ex := newTestRegistry(t)
+	client := WithOrderValidation(ex, registry, true)
+
+	results, err := client.PlaceMultiOrder(context.Background(), []PlaceOrderRequest{
+		{Symbol: "BTC-USDT", Quantity: MustDecimal("101")},
+		{Symbol: "BTC-USDT", Quantity: MustDecimal("0.0051")},
+	})
+	if err != nil {
+		t.Fatalf("PlaceMultiOrder() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("PlaceMultiOrder() returned %d results, expected 2", len(results))
+	}
+	if !errors.Is(results[0].Error, ErrInvalidOrder) {
+		t.Errorf("results[0].Error = %v, expected ErrInvalidOrder", results[0].Error)
+	}
+	if results[1].Error != nil || results[1].Order.Quantity.String() != "0.005" {
+		t.Errorf("results[1] = %+v, expected order with quantity 0.005", results[1])
+	}
+	if len(ex.placed) != 1 {
+		t.Errorf("exchange received %d orders, expected 1", len(ex.placed))
+	}
+}
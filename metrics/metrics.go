@@ -0,0 +1,80 @@
+// Package metrics defines the Recorder interface through which the exchange
+// REST and WebSocket clients report their activity, and a Prometheus adapter
+// serving it in the text exposition format without further dependencies.
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/djpken/go-exc/middleware"
+)
+
+// Recorder receives the activity of the exchange clients. Implementations
+// must be safe for concurrent use.
+type Recorder interface {
+	// Request records a REST round trip. status is 0 and err set when no
+	// response was received.
+	Request(exchange, method, endpoint string, status int, duration time.Duration, err error)
+
+	// RateLimitWait records a REST request that waited for a free slot
+	RateLimitWait(exchange, key string, wait time.Duration)
+
+	// Connection records a WebSocket connection being opened or closed
+	Connection(exchange string, private, connected bool)
+
+	// Reconnect records a WebSocket reconnection attempt
+	Reconnect(exchange string, private bool)
+
+	// Message records a WebSocket message received on channel
+	Message(exchange, channel string)
+
+	// Dropped records a WebSocket update dropped because the consumer channel
+	// of channel was full
+	Dropped(exchange, channel string)
+
+	// ChecksumMismatch records a local order book of channel failing the
+	// exchange checksum and being resynced
+	ChecksumMismatch(exchange, channel string)
+
+	// Subscription records delta WebSocket subscriptions being added, or
+	// removed when negative
+	Subscription(exchange string, private bool, delta int)
+}
+
+// Nop is a Recorder discarding everything
+type Nop struct{}
+
+func (Nop) Request(string, string, string, int, time.Duration, error) {}
+func (Nop) RateLimitWait(string, string, time.Duration)               {}
+func (Nop) Connection(string, bool, bool)                             {}
+func (Nop) Reconnect(string, bool)                                    {}
+func (Nop) Message(string, string)                                    {}
+func (Nop) Dropped(string, string)                                    {}
+func (Nop) ChecksumMismatch(string, string)                           {}
+func (Nop) Subscription(string, bool, int)                            {}
+
+// OrNop returns r, or Nop when r is nil
+func OrNop(r Recorder) Recorder {
+	if r == nil {
+		return Nop{}
+	}
+	return r
+}
+
+// Middleware returns the REST middleware recording every round trip to r.
+// The duration is that of the HTTP exchange, excluding inner middleware.
+func Middleware(r Recorder) middleware.Middleware {
+	return func(next middleware.RoundTrip) middleware.RoundTrip {
+		return func(ctx context.Context, req *middleware.Request) (*middleware.Response, error) {
+			start := time.Now()
+			res, err := next(ctx, req)
+			if err != nil {
+				r.Request(req.Exchange, req.Method, req.Endpoint, 0, time.Since(start), err)
+				return res, err
+			}
+			r.Request(req.Exchange, req.Method, req.Endpoint, res.StatusCode, res.Duration, nil)
+			return res, nil
+		}
+	}
+}
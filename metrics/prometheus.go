@@ -0,0 +1,265 @@
+package metrics
+
+import (
+	"bufio"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the REST latency histogram
+var DefaultBuckets = []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Prometheus is a Recorder keeping its metrics in memory and serving them in
+// the Prometheus text exposition format:
+//
+//	exc_rest_requests_total{exchange,method,endpoint,code}
+//	exc_rest_request_errors_total{exchange,method,endpoint}
+//	exc_rest_request_duration_seconds{exchange,method,endpoint} (histogram)
+//	exc_ratelimit_waits_total{exchange,key}
+//	exc_ratelimit_wait_seconds_total{exchange,key}
+//	exc_ws_connected{exchange,connection}
+//	exc_ws_reconnects_total{exchange,connection}
+//	exc_ws_messages_total{exchange,channel}
+//	exc_ws_dropped_messages_total{exchange,channel}
+//	exc_ws_checksum_mismatches_total{exchange,channel}
+//	exc_ws_subscriptions{exchange,connection}
+//
+// code is the HTTP status code, or "error" when no response was received;
+// connection is "public" or "private".
+type Prometheus struct {
+	mu                   sync.Mutex
+	requests             *family
+	requestErrors        *family
+	requestDuration      *family
+	rateLimitWaits       *family
+	rateLimitWaitSeconds *family
+	wsConnected          *family
+	wsReconnects         *family
+	wsMessages           *family
+	wsDropped            *family
+	wsChecksumMismatches *family
+	wsSubscriptions      *family
+}
+
+// family is one metric with its samples keyed by label values
+type family struct {
+	name    string
+	help    string
+	typ     string
+	labels  []string
+	buckets []float64 // histograms only
+	samples map[string]*sample
+}
+
+type sample struct {
+	values []string
+	value  float64  // counter or gauge value, histogram sum
+	count  uint64   // histograms only
+	counts []uint64 // histograms only, per bucket
+}
+
+// NewPrometheus creates a Prometheus recorder
+func NewPrometheus() *Prometheus {
+	return &Prometheus{
+		requests:             newFamily("exc_rest_requests_total", "REST requests sent.", "counter", "exchange", "method", "endpoint", "code"),
+		requestErrors:        newFamily("exc_rest_request_errors_total", "REST requests failing with a transport error or an HTTP error status.", "counter", "exchange", "method", "endpoint"),
+		requestDuration:      newFamily("exc_rest_request_duration_seconds", "REST request latency.", "histogram", "exchange", "method", "endpoint"),
+		rateLimitWaits:       newFamily("exc_ratelimit_waits_total", "REST requests delayed by the client-side rate limiter.", "counter", "exchange", "key"),
+		rateLimitWaitSeconds: newFamily("exc_ratelimit_wait_seconds_total", "Time REST requests spent waiting for the rate limiter.", "counter", "exchange", "key"),
+		wsConnected:          newFamily("exc_ws_connected", "Whether the WebSocket connection is open.", "gauge", "exchange", "connection"),
+		wsReconnects:         newFamily("exc_ws_reconnects_total", "WebSocket reconnection attempts.", "counter", "exchange", "connection"),
+		wsMessages:           newFamily("exc_ws_messages_total", "WebSocket messages received.", "counter", "exchange", "channel"),
+		wsDropped:            newFamily("exc_ws_dropped_messages_total", "WebSocket updates dropped because the consumer channel was full.", "counter", "exchange", "channel"),
+		wsChecksumMismatches: newFamily("exc_ws_checksum_mismatches_total", "Local order books failing the exchange checksum.", "counter", "exchange", "channel"),
+		wsSubscriptions:      newFamily("exc_ws_subscriptions", "Active WebSocket subscriptions.", "gauge", "exchange", "connection"),
+	}
+}
+
+func newFamily(name, help, typ string, labels ...string) *family {
+	f := &family{name: name, help: help, typ: typ, labels: labels, samples: make(map[string]*sample)}
+	if typ == "histogram" {
+		f.buckets = DefaultBuckets
+	}
+	return f
+}
+
+// Request implements Recorder
+func (p *Prometheus) Request(exchange, method, endpoint string, status int, duration time.Duration, err error) {
+	code := strconv.Itoa(status)
+	if err != nil {
+		code = "error"
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests.add(1, exchange, method, endpoint, code)
+	if err != nil || status >= http.StatusBadRequest {
+		p.requestErrors.add(1, exchange, method, endpoint)
+	}
+	p.requestDuration.observe(duration.Seconds(), exchange, method, endpoint)
+}
+
+// RateLimitWait implements Recorder
+func (p *Prometheus) RateLimitWait(exchange, key string, wait time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rateLimitWaits.add(1, exchange, key)
+	p.rateLimitWaitSeconds.add(wait.Seconds(), exchange, key)
+}
+
+// Connection implements Recorder
+func (p *Prometheus) Connection(exchange string, private, connected bool) {
+	v := 0.0
+	if connected {
+		v = 1
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.wsConnected.set(v, exchange, connection(private))
+}
+
+// Reconnect implements Recorder
+func (p *Prometheus) Reconnect(exchange string, private bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.wsReconnects.add(1, exchange, connection(private))
+}
+
+// Message implements Recorder
+func (p *Prometheus) Message(exchange, channel string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.wsMessages.add(1, exchange, channel)
+}
+
+// Dropped implements Recorder
+func (p *Prometheus) Dropped(exchange, channel string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.wsDropped.add(1, exchange, channel)
+}
+
+// ChecksumMismatch implements Recorder
+func (p *Prometheus) ChecksumMismatch(exchange, channel string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.wsChecksumMismatches.add(1, exchange, channel)
+}
+
+// Subscription implements Recorder
+func (p *Prometheus) Subscription(exchange string, private bool, delta int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.wsSubscriptions.add(float64(delta), exchange, connection(private))
+}
+
+// ServeHTTP writes all metrics in the Prometheus text exposition format
+func (p *Prometheus) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	bw := bufio.NewWriter(w)
+	p.mu.Lock()
+	for _, f := range []*family{
+		p.requests, p.requestErrors, p.requestDuration,
+		p.rateLimitWaits, p.rateLimitWaitSeconds,
+		p.wsConnected, p.wsReconnects, p.wsMessages, p.wsDropped, p.wsChecksumMismatches, p.wsSubscriptions,
+	} {
+		f.write(bw)
+	}
+	p.mu.Unlock()
+	_ = bw.Flush()
+}
+
+// connection returns the connection label value
+func connection(private bool) string {
+	if private {
+		return "private"
+	}
+	return "public"
+}
+
+// get returns the sample of the label values, creating it when missing
+func (f *family) get(values []string) *sample {
+	key := strings.Join(values, "\xff")
+	s, ok := f.samples[key]
+	if !ok {
+		s = &sample{values: values}
+		if f.buckets != nil {
+			s.counts = make([]uint64, len(f.buckets))
+		}
+		f.samples[key] = s
+	}
+	return s
+}
+
+func (f *family) add(v float64, values ...string) {
+	f.get(values).value += v
+}
+
+func (f *family) set(v float64, values ...string) {
+	f.get(values).value = v
+}
+
+func (f *family) observe(v float64, values ...string) {
+	s := f.get(values)
+	s.value += v
+	s.count++
+	for i, le := range f.buckets {
+		if v <= le {
+			s.counts[i]++
+		}
+	}
+}
+
+func (f *family) write(w *bufio.Writer) {
+	if len(f.samples) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(f.samples))
+	for k := range f.samples {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	w.WriteString("# HELP " + f.name + " " + f.help + "\n")
+	w.WriteString("# TYPE " + f.name + " " + f.typ + "\n")
+	for _, k := range keys {
+		s := f.samples[k]
+		labels := f.labelPairs(s.values)
+		if f.buckets == nil {
+			writeSample(w, f.name, labels, formatFloat(s.value))
+			continue
+		}
+		for i, le := range f.buckets {
+			writeSample(w, f.name+"_bucket", append(labels, `le="`+formatFloat(le)+`"`), strconv.FormatUint(s.counts[i], 10))
+		}
+		writeSample(w, f.name+"_bucket", append(labels, `le="+Inf"`), strconv.FormatUint(s.count, 10))
+		writeSample(w, f.name+"_sum", labels, formatFloat(s.value))
+		writeSample(w, f.name+"_count", labels, strconv.FormatUint(s.count, 10))
+	}
+}
+
+// labelPairs returns the name="value" pairs of the label values
+func (f *family) labelPairs(values []string) []string {
+	pairs := make([]string, len(values), len(values)+1)
+	for i, v := range values {
+		pairs[i] = f.labels[i] + `="` + labelEscaper.Replace(v) + `"`
+	}
+	return pairs
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func writeSample(w *bufio.Writer, name string, labels []string, value string) {
+	w.WriteString(name)
+	if len(labels) > 0 {
+		w.WriteString("{" + strings.Join(labels, ",") + "}")
+	}
+	w.WriteString(" " + value + "\n")
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
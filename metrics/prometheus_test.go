@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/djpken/go-exc/middleware"
+)
+
+func TestPrometheus_ServeHTTP(t *testing.T) {
+	p := NewPrometheus()
+	p.Request("okx", "GET", "/api/v5/market/tickers", 200, 30*time.Millisecond, nil)
+	p.Request("okx", "GET", "/api/v5/market/tickers", 0, time.Second, errors.New("connection reset"))
+	p.RateLimitWait("okx", "GET /api/v5/market/tickers", 500*time.Millisecond)
+	p.Connection("bitmart", true, true)
+	p.Reconnect("bitmart", true)
+	p.Message("bingx", "ticker")
+	p.Dropped("bingx", "ticker")
+	p.ChecksumMismatch("okx", "books")
+	p.Subscription("bingx", false, 1)
+	p.Subscription("bingx", false, 1)
+	p.Subscription("bingx", false, -1)
+
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+
+	for _, line := range []string{
+		"# TYPE exc_rest_requests_total counter",
+		`exc_rest_requests_total{exchange="okx",method="GET",endpoint="/api/v5/market/tickers",code="200"} 1`,
+		`exc_rest_requests_total{exchange="okx",method="GET",endpoint="/api/v5/market/tickers",code="error"} 1`,
+		`exc_rest_request_errors_total{exchange="okx",method="GET",endpoint="/api/v5/market/tickers"} 1`,
+		"# TYPE exc_rest_request_duration_seconds histogram",
+		`exc_rest_request_duration_seconds_bucket{exchange="okx",method="GET",endpoint="/api/v5/market/tickers",le="0.05"} 1`,
+		`exc_rest_request_duration_seconds_bucket{exchange="okx",method="GET",endpoint="/api/v5/market/tickers",le="+Inf"} 2`,
+		`exc_rest_request_duration_seconds_sum{exchange="okx",method="GET",endpoint="/api/v5/market/tickers"} 1.03`,
+		`exc_ratelimit_wait_seconds_total{exchange="okx",key="GET /api/v5/market/tickers"} 0.5`,
+		`exc_ws_connected{exchange="bitmart",connection="private"} 1`,
+		`exc_ws_reconnects_total{exchange="bitmart",connection="private"} 1`,
+		`exc_ws_messages_total{exchange="bingx",channel="ticker"} 1`,
+		`exc_ws_dropped_messages_total{exchange="bingx",channel="ticker"} 1`,
+		`exc_ws_checksum_mismatches_total{exchange="okx",channel="books"} 1`,
+		`exc_ws_subscriptions{exchange="bingx",connection="public"} 1`,
+	} {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("metrics output misses %q\n%s", line, body)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	p := NewPrometheus()
+	rt := middleware.Chain(func(context.Context, *middleware.Request) (*middleware.Response, error) {
+		return &middleware.Response{StatusCode: http.StatusTooManyRequests, Duration: time.Millisecond}, nil
+	}, Middleware(p))
+
+	if _, err := rt(context.Background(), &middleware.Request{Exchange: "bitmart", Method: "POST", Endpoint: "/spot/v2/submit_order"}); err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if line := `exc_rest_request_errors_total{exchange="bitmart",method="POST",endpoint="/spot/v2/submit_order"} 1`; !strings.Contains(rec.Body.String(), line) {
+		t.Errorf("metrics output misses %q\n%s", line, rec.Body.String())
+	}
+}
@@ -0,0 +1,116 @@
+// Package middleware provides the interceptor chain run by the exchange REST
+// clients around every HTTP round trip, e.g. for auditing, request IDs,
+// latency metrics or redacted debug dumps.
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Request is the normalized envelope of one REST request. It is sent after
+// signing, so changing Params or Body does not change what is sent; Header is
+// the header of the outgoing request and may be extended, e.g. with a request
+// ID. Params and Header include the credentials and signature, redact them
+// before dumping a request.
+type Request struct {
+	// Exchange is the exchange name ("okx", "bitmart" or "bingx")
+	Exchange string
+
+	// Method is the HTTP method
+	Method string
+
+	// Endpoint is the request path without the query string
+	Endpoint string
+
+	// Private is set for signed requests
+	Private bool
+
+	// Params are the query or form parameters
+	Params map[string]string
+
+	// Body is the raw request body
+	Body []byte
+
+	// Header is the header of the outgoing request
+	Header http.Header
+}
+
+// Response is the normalized envelope of one REST response
+type Response struct {
+	// StatusCode is the HTTP status code
+	StatusCode int
+
+	// Header is the response header
+	Header http.Header
+
+	// Body is the raw response body, decoded by the client after the chain
+	// returns
+	Body []byte
+
+	// Duration is the time from sending the request to reading the whole body
+	Duration time.Duration
+}
+
+// RoundTrip sends a request and returns its response. It returns an error
+// only when no response was received.
+type RoundTrip func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a RoundTrip. It may inspect or extend the request, time or
+// audit the call, replace the response, or return without calling next.
+type Middleware func(next RoundTrip) RoundTrip
+
+// Chain wraps rt in middlewares, the first middleware being the outermost
+func Chain(rt RoundTrip, middlewares ...Middleware) RoundTrip {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		if middlewares[i] != nil {
+			rt = middlewares[i](rt)
+		}
+	}
+	return rt
+}
+
+// Send returns the innermost RoundTrip of a chain: it sends r with client
+// under the context passed through the chain and reads the whole response.
+func Send(client *http.Client, r *http.Request) RoundTrip {
+	return func(ctx context.Context, _ *Request) (*Response, error) {
+		start := time.Now()
+		res, err := client.Do(r.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &Response{
+			StatusCode: res.StatusCode,
+			Header:     res.Header,
+			Body:       body,
+			Duration:   time.Since(start),
+		}, nil
+	}
+}
+
+// HTTPResponse returns the response to req as an *http.Response reading Body
+func (r *Response) HTTPResponse(req *http.Request) *http.Response {
+	header := r.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        http.StatusText(r.StatusCode),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
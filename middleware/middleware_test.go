@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next RoundTrip) RoundTrip {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				order = append(order, name+" in")
+				res, err := next(ctx, req)
+				order = append(order, name+" out")
+				return res, err
+			}
+		}
+	}
+	rt := Chain(func(context.Context, *Request) (*Response, error) {
+		order = append(order, "send")
+		return &Response{StatusCode: http.StatusOK}, nil
+	}, trace("outer"), nil, trace("inner"))
+
+	if _, err := rt(context.Background(), &Request{}); err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	expected := []string{"outer in", "inner in", "send", "inner out", "outer out"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("call order = %v, expected %v", order, expected)
+	}
+}
+
+func TestSend(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Request-Id"); got != "req-1" {
+			t.Errorf("X-Request-Id = %q, expected req-1", got)
+		}
+		w.WriteHeader(http.StatusTeapot)
+		_, _ = w.Write([]byte(`{"code":1}`))
+	}))
+	defer srv.Close()
+
+	r, err := http.NewRequest(http.MethodGet, srv.URL+"/v1/ping", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requestID := func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			req.Header.Set("X-Request-Id", "req-1")
+			return next(ctx, req)
+		}
+	}
+	rt := Chain(Send(srv.Client(), r), requestID)
+
+	res, err := rt(context.Background(), &Request{Method: r.Method, Endpoint: r.URL.Path, Header: r.Header})
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	if res.StatusCode != http.StatusTeapot || string(res.Body) != `{"code":1}` || res.Duration <= 0 {
+		t.Errorf("Response = %+v, expected status 418 with the body and a duration", res)
+	}
+
+	body, _ := io.ReadAll(res.HTTPResponse(r).Body)
+	if string(body) != `{"code":1}` {
+		t.Errorf("HTTPResponse() body = %q", body)
+	}
+}
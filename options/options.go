@@ -0,0 +1,174 @@
+// Package options holds the transport settings shared by the REST and
+// WebSocket clients of every exchange.
+package options
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/djpken/go-exc/clock"
+	"github.com/djpken/go-exc/metrics"
+	"github.com/djpken/go-exc/middleware"
+	"github.com/djpken/go-exc/ratelimit"
+	"github.com/djpken/go-exc/retry"
+	"github.com/gorilla/websocket"
+)
+
+// ClientOptions holds the transport settings shared by the REST and WebSocket
+// clients of every exchange. Zero values keep each client's default.
+type ClientOptions struct {
+	// HTTPClient is used for all REST requests
+	HTTPClient *http.Client
+
+	// BaseURL overrides the REST API base URL (e.g., "http://127.0.0.1:8080")
+	BaseURL string
+
+	// WSURL overrides the WebSocket endpoint. Exchanges with separate public
+	// and private endpoints (OKX) append "/public" and "/private" to it.
+	WSURL string
+
+	// Dialer is used for all WebSocket connections
+	Dialer *websocket.Dialer
+
+	// UserAgent is sent with every REST request and WebSocket handshake
+	UserAgent string
+
+	// RateLimiter throttles REST requests. Nil uses the exchange's default
+	// limits; a limiter created with ratelimit.New(nil) disables limiting.
+	RateLimiter *ratelimit.Limiter
+
+	// RetryPolicy retries transient REST failures. Nil uses retry.DefaultPolicy.
+	RetryPolicy *retry.Policy
+
+	// ClockSyncInterval is the time between two measurements of the offset to
+	// the exchange's server clock. Zero uses clock.DefaultInterval; a negative
+	// interval disables clock synchronization.
+	ClockSyncInterval time.Duration
+
+	// Logger receives the diagnostics of the REST and WebSocket clients. Nil
+	// discards them.
+	Logger *slog.Logger
+
+	// Middleware runs around every REST round trip, the first being the outermost
+	Middleware []middleware.Middleware
+
+	// Metrics records REST and WebSocket activity. Nil records nothing.
+	Metrics metrics.Recorder
+}
+
+// Option configures ClientOptions
+type Option func(*ClientOptions)
+
+// NewClientOptions applies opts to an empty ClientOptions
+func NewClientOptions(opts ...Option) ClientOptions {
+	var o ClientOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// WithHTTPClient sets the HTTP client used for REST requests, e.g. to route
+// through a proxy or tune the connection pool
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *ClientOptions) { o.HTTPClient = client }
+}
+
+// WithBaseURL overrides the REST API base URL, e.g. to point at an httptest server
+func WithBaseURL(url string) Option {
+	return func(o *ClientOptions) { o.BaseURL = url }
+}
+
+// WithWSURL overrides the WebSocket endpoint
+func WithWSURL(url string) Option {
+	return func(o *ClientOptions) { o.WSURL = url }
+}
+
+// WithDialer sets the dialer used for WebSocket connections
+func WithDialer(dialer *websocket.Dialer) Option {
+	return func(o *ClientOptions) { o.Dialer = dialer }
+}
+
+// WithUserAgent sets the User-Agent header of REST requests and WebSocket handshakes
+func WithUserAgent(userAgent string) Option {
+	return func(o *ClientOptions) { o.UserAgent = userAgent }
+}
+
+// WithRateLimiter replaces the exchange's default REST rate limiter, e.g. to
+// share one limiter between clients of the same account
+func WithRateLimiter(limiter *ratelimit.Limiter) Option {
+	return func(o *ClientOptions) { o.RateLimiter = limiter }
+}
+
+// WithRetryPolicy replaces the retry policy of REST requests. Reads are
+// retried on transient failures; orders only when they carry a client order
+// ID and a lookup confirms the failed attempt did not reach the exchange.
+// Pass retry.NoRetry to disable retries.
+func WithRetryPolicy(policy retry.Policy) Option {
+	return func(o *ClientOptions) { o.RetryPolicy = &policy }
+}
+
+// WithClockSync sets how often the offset to the exchange's server clock is
+// measured. Signed requests and WebSocket logins are timestamped with the
+// server time. An interval <= 0 disables synchronization, signing with the
+// local clock.
+func WithClockSync(interval time.Duration) Option {
+	return func(o *ClientOptions) {
+		if interval <= 0 {
+			interval = -1
+		}
+		o.ClockSyncInterval = interval
+	}
+}
+
+// ClockSync returns the clock synchronization interval, 0 when disabled
+func (o ClientOptions) ClockSync() time.Duration {
+	switch {
+	case o.ClockSyncInterval < 0:
+		return 0
+	case o.ClockSyncInterval == 0:
+		return clock.DefaultInterval
+	}
+	return o.ClockSyncInterval
+}
+
+// WithLogger sets the logger of the REST and WebSocket clients. Records carry
+// the attributes "exchange", "private" (WebSocket connection), "channel" and
+// "symbol" where they apply.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *ClientOptions) { o.Logger = logger }
+}
+
+// LoggerFor returns the logger of an exchange's clients, a logger discarding
+// all records when none is set
+func (o ClientOptions) LoggerFor(exchange string) *slog.Logger {
+	if o.Logger == nil {
+		return slog.New(slog.DiscardHandler)
+	}
+	return o.Logger.With("exchange", exchange)
+}
+
+// WithMiddleware appends REST middleware, e.g. to audit orders, tag requests
+// or record latencies. Each middleware sees the exchange, endpoint, method,
+// params, raw bodies, status and duration of every attempt, retries included.
+func WithMiddleware(middlewares ...middleware.Middleware) Option {
+	return func(o *ClientOptions) { o.Middleware = append(o.Middleware, middlewares...) }
+}
+
+// RESTMiddleware returns the middleware of the REST clients: the metrics
+// middleware when Metrics is set, then Middleware
+func (o ClientOptions) RESTMiddleware() []middleware.Middleware {
+	if o.Metrics == nil {
+		return o.Middleware
+	}
+	return append([]middleware.Middleware{metrics.Middleware(o.Metrics)}, o.Middleware...)
+}
+
+// WithMetrics sets the recorder of REST and WebSocket activity, e.g. a
+// metrics.Prometheus served on a /metrics endpoint
+func WithMetrics(recorder metrics.Recorder) Option {
+	return func(o *ClientOptions) { o.Metrics = recorder }
+}
@@ -0,0 +1,239 @@
+package exc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/djpken/go-exc/metrics"
+	"github.com/djpken/go-exc/middleware"
+	"github.com/djpken/go-exc/retry"
+)
+
+// newTestExchange returns an OKX client built by NewExchange whose REST
+// client talks to an httptest server serving handler. Clock synchronization
+// is disabled unless opts enable it.
+func newTestExchange(t *testing.T, cfg Config, handler http.HandlerFunc, opts ...Option) Exchange {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	opts = append([]Option{WithBaseURL(srv.URL), WithHTTPClient(srv.Client()), WithClockSync(0)}, opts...)
+	client, err := NewExchange(context.Background(), OKX, cfg, opts...)
+	if err != nil {
+		t.Fatalf("NewExchange() error = %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestNewExchange_Options(t *testing.T) {
+	var gotPath, gotUserAgent string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotUserAgent = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"0","msg":"","data":[{"instId":"BTC-USDT-SWAP","last":"65000","ts":"1700000000000"}]}`))
+	}
+
+	ctx := context.Background()
+	client := newTestExchange(t, Config{}, handler, WithUserAgent("exc-test/1.0"))
+
+	tickers, err := client.GetTickers(ctx, GetTickersRequest{InstrumentType: InstrumentSwap})
+	if err != nil {
+		t.Fatalf("GetTickers() error = %v", err)
+	}
+	if gotPath != "/api/v5/market/tickers" {
+		t.Errorf("request path = %q, expected /api/v5/market/tickers", gotPath)
+	}
+	if gotUserAgent != "exc-test/1.0" {
+		t.Errorf("User-Agent = %q, expected exc-test/1.0", gotUserAgent)
+	}
+	if len(tickers) != 1 || tickers[0].Symbol != "BTC/USDT:USDT" {
+		t.Errorf("GetTickers() = %+v, expected one BTC/USDT:USDT ticker", tickers)
+	}
+}
+
+func TestNewExchange_RetryPolicy(t *testing.T) {
+	var calls int
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"0","msg":"","data":[{"instId":"BTC-USDT-SWAP","last":"65000","ts":"1700000000000"}]}`))
+	}
+
+	policy := retry.Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	client := newTestExchange(t, Config{}, handler, WithRetryPolicy(policy))
+
+	if _, err := client.GetTickers(context.Background(), GetTickersRequest{InstrumentType: InstrumentSwap}); err != nil {
+		t.Fatalf("GetTickers() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("GetTickers() sent %d requests, expected 2", calls)
+	}
+}
+
+func TestNewExchange_ClockSync(t *testing.T) {
+	const offset = time.Hour
+	var mu sync.Mutex
+	var signedAt string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v5/public/time":
+			ts := time.Now().Add(offset).UnixMilli()
+			_, _ = fmt.Fprintf(w, `{"code":"0","msg":"","data":[{"ts":"%d"}]}`, ts)
+		case "/api/v5/account/balance":
+			mu.Lock()
+			signedAt = r.Header.Get("OK-ACCESS-TIMESTAMP")
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"code":"0","msg":"","data":[]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+
+	ctx := context.Background()
+	cfg := Config{APIKey: "key", SecretKey: "secret", Passphrase: "pass"}
+	client := newTestExchange(t, cfg, handler, WithClockSync(time.Hour))
+
+	// The first measurement runs in the background: poll until requests are
+	// signed with the server time
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		_, _ = client.GetBalance(ctx, "")
+		mu.Lock()
+		got := signedAt
+		mu.Unlock()
+		ts, err := time.Parse(time.RFC3339, got)
+		if err != nil {
+			t.Fatalf("OK-ACCESS-TIMESTAMP = %q: %v", got, err)
+		}
+		if skew := time.Until(ts) - offset; skew > -time.Minute && skew < time.Minute {
+			// The measured offset is reachable through the wrapped client
+			if got, ok := client.Clock().Offset(); !ok || got-offset < -time.Minute || got-offset > time.Minute {
+				t.Errorf("Clock().Offset() = %s, %v, expected about %s", got, ok, offset)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("OK-ACCESS-TIMESTAMP = %s, expected about %s", got, time.Now().Add(offset).UTC().Format(time.RFC3339))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestNewExchange_Logger(t *testing.T) {
+	var calls int
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"0","msg":"","data":[]}`))
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	policy := retry.Policy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	ctx := context.Background()
+	client := newTestExchange(t, Config{}, handler, WithRetryPolicy(policy), WithLogger(logger))
+
+	if _, err := client.GetTickers(ctx, GetTickersRequest{InstrumentType: InstrumentSwap}); err != nil {
+		t.Fatalf("GetTickers() error = %v", err)
+	}
+
+	var record struct {
+		Level    string `json:"level"`
+		Msg      string `json:"msg"`
+		Exchange string `json:"exchange"`
+		Path     string `json:"path"`
+		Status   int    `json:"status"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("log output %q: %v", buf.String(), err)
+	}
+	if record.Level != "WARN" || record.Msg != "retrying request" || record.Exchange != "okx" ||
+		record.Path != "/api/v5/market/tickers" || record.Status != http.StatusBadGateway {
+		t.Errorf("log record = %+v, expected a retry warning for okx /api/v5/market/tickers", record)
+	}
+}
+
+func TestNewExchange_Middleware(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Request-Id"); got != "req-1" {
+			t.Errorf("X-Request-Id = %q, expected req-1", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"0","msg":"","data":[{"instId":"BTC-USDT-SWAP","last":"65000","ts":"1700000000000"}]}`))
+	}
+
+	var gotReq *middleware.Request
+	var gotRes *middleware.Response
+	audit := func(next middleware.RoundTrip) middleware.RoundTrip {
+		return func(ctx context.Context, req *middleware.Request) (*middleware.Response, error) {
+			req.Header.Set("X-Request-Id", "req-1")
+			res, err := next(ctx, req)
+			gotReq, gotRes = req, res
+			return res, err
+		}
+	}
+
+	ctx := context.Background()
+	client := newTestExchange(t, Config{}, handler, WithMiddleware(audit))
+
+	tickers, err := client.GetTickers(ctx, GetTickersRequest{InstrumentType: InstrumentSwap})
+	if err != nil {
+		t.Fatalf("GetTickers() error = %v", err)
+	}
+	if len(tickers) != 1 {
+		t.Errorf("GetTickers() = %+v, expected one ticker decoded after the middleware", tickers)
+	}
+	if gotReq == nil || gotRes == nil {
+		t.Fatal("middleware not called")
+	}
+	if gotReq.Exchange != "okx" || gotReq.Method != http.MethodGet || gotReq.Endpoint != "/api/v5/market/tickers" ||
+		gotReq.Private || gotReq.Params["instType"] != "SWAP" {
+		t.Errorf("middleware request = %+v", gotReq)
+	}
+	if gotRes.StatusCode != http.StatusOK || len(gotRes.Body) == 0 || gotRes.Duration <= 0 {
+		t.Errorf("middleware response = %+v", gotRes)
+	}
+}
+
+func TestNewExchange_Metrics(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"0","msg":"","data":[{"instId":"BTC-USDT-SWAP","last":"65000","ts":"1700000000000"}]}`))
+	}
+
+	prom := metrics.NewPrometheus()
+	ctx := context.Background()
+	client := newTestExchange(t, Config{}, handler, WithMetrics(prom))
+
+	if _, err := client.GetTickers(ctx, GetTickersRequest{InstrumentType: InstrumentSwap}); err != nil {
+		t.Fatalf("GetTickers() error = %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	prom.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	line := `exc_rest_requests_total{exchange="okx",method="GET",endpoint="/api/v5/market/tickers",code="200"} 1`
+	if !strings.Contains(rec.Body.String(), line) {
+		t.Errorf("metrics output misses %q\n%s", line, rec.Body.String())
+	}
+}
@@ -0,0 +1,162 @@
+// Package orderbook maintains the local order books streamed by the exchange
+// WebSocket adapters, built from a snapshot and kept up to date with
+// incremental updates.
+package orderbook
+
+import (
+	"errors"
+	"slices"
+	"sort"
+
+	"github.com/djpken/go-exc/types"
+)
+
+// ErrGap is returned by Apply when an update does not follow the last one
+// applied, e.g. after a dropped message. The book then awaits a new snapshot.
+var ErrGap = errors.New("orderbook: sequence gap")
+
+// ErrNotSynced is returned by Apply while the book awaits a snapshot
+var ErrNotSynced = errors.New("orderbook: awaiting snapshot")
+
+// Book is the local order book of one symbol. It is not safe for concurrent
+// use.
+type Book struct {
+	bids   side
+	asks   side
+	seq    int64
+	synced bool
+}
+
+// New creates an empty Book awaiting its snapshot
+func New() *Book {
+	return &Book{bids: side{desc: true}}
+}
+
+// Synced reports whether the book holds a snapshot and all updates since
+func (b *Book) Synced() bool {
+	return b.synced
+}
+
+// Seq returns the sequence number of the last snapshot or update applied
+func (b *Book) Seq() int64 {
+	return b.seq
+}
+
+// Snapshot replaces the book with bids and asks, in any order, and returns
+// the levels that changed from the previous book, removed levels having a
+// zero quantity. seq is the sequence number of the snapshot.
+func (b *Book) Snapshot(bids, asks []types.OrderBookLevel, seq int64) types.OrderBookDelta {
+	b.seq = seq
+	b.synced = true
+	return types.OrderBookDelta{
+		Bids: b.bids.replace(bids),
+		Asks: b.asks.replace(asks),
+	}
+}
+
+// Apply applies the incremental update delta, in which a zero quantity removes
+// a level. prevSeq is the sequence number of the update preceding delta and
+// seq that of delta. When prevSeq is not the last sequence number applied the
+// book is left unchanged, awaits a new snapshot and ErrGap is returned.
+func (b *Book) Apply(delta types.OrderBookDelta, prevSeq, seq int64) error {
+	if !b.synced {
+		return ErrNotSynced
+	}
+	if prevSeq != b.seq {
+		b.synced = false
+		return ErrGap
+	}
+	for _, l := range delta.Bids {
+		b.bids.set(l)
+	}
+	for _, l := range delta.Asks {
+		b.asks.set(l)
+	}
+	b.seq = seq
+	return nil
+}
+
+// Top returns copies of the best depth bid and ask levels, or of all levels
+// when depth is not positive
+func (b *Book) Top(depth int) (bids, asks []types.OrderBookLevel) {
+	return b.bids.top(depth), b.asks.top(depth)
+}
+
+// side is one side of a book, best price first
+type side struct {
+	levels []types.OrderBookLevel
+	desc   bool // bids, highest price first
+}
+
+// cmp returns a negative number when price a ranks before price b
+func (s *side) cmp(a, b types.Decimal) int {
+	c := a.Decimal.Cmp(b.Decimal)
+	if s.desc {
+		return -c
+	}
+	return c
+}
+
+// search returns the index of price, or where it would be inserted
+func (s *side) search(price types.Decimal) (int, bool) {
+	i := sort.Search(len(s.levels), func(i int) bool {
+		return s.cmp(s.levels[i].Price, price) >= 0
+	})
+	return i, i < len(s.levels) && s.levels[i].Price.Decimal.Equal(price.Decimal)
+}
+
+// set updates the level at l.Price, removing it when l.Quantity is zero
+func (s *side) set(l types.OrderBookLevel) {
+	i, found := s.search(l.Price)
+	switch {
+	case l.Quantity.IsZero():
+		if found {
+			s.levels = slices.Delete(s.levels, i, i+1)
+		}
+	case found:
+		s.levels[i] = l
+	default:
+		s.levels = slices.Insert(s.levels, i, l)
+	}
+}
+
+// replace replaces all levels and returns the changed ones
+func (s *side) replace(levels []types.OrderBookLevel) []types.OrderBookLevel {
+	next := make([]types.OrderBookLevel, 0, len(levels))
+	for _, l := range levels {
+		if !l.Quantity.IsZero() {
+			next = append(next, l)
+		}
+	}
+	sort.SliceStable(next, func(i, j int) bool {
+		return s.cmp(next[i].Price, next[j].Price) < 0
+	})
+
+	var changed []types.OrderBookLevel
+	i, j := 0, 0
+	for i < len(s.levels) || j < len(next) {
+		switch {
+		case j == len(next) || i < len(s.levels) && s.cmp(s.levels[i].Price, next[j].Price) < 0:
+			changed = append(changed, types.OrderBookLevel{Price: s.levels[i].Price, Quantity: types.ZeroDecimal})
+			i++
+		case i == len(s.levels) || s.cmp(s.levels[i].Price, next[j].Price) > 0:
+			changed = append(changed, next[j])
+			j++
+		default:
+			if !s.levels[i].Quantity.Decimal.Equal(next[j].Quantity.Decimal) {
+				changed = append(changed, next[j])
+			}
+			i++
+			j++
+		}
+	}
+	s.levels = next
+	return changed
+}
+
+func (s *side) top(depth int) []types.OrderBookLevel {
+	if depth <= 0 || depth > len(s.levels) {
+		depth = len(s.levels)
+	}
+	return slices.Clone(s.levels[:depth])
+}
@@ -0,0 +1,91 @@
+package orderbook
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/djpken/go-exc/types"
+)
+
+// levels parses "price:quantity" pairs
+func levels(pairs ...string) []types.OrderBookLevel {
+	out := make([]types.OrderBookLevel, len(pairs))
+	for i, p := range pairs {
+		price, qty, _ := strings.Cut(p, ":")
+		out[i] = types.OrderBookLevel{Price: types.MustDecimal(price), Quantity: types.MustDecimal(qty)}
+	}
+	return out
+}
+
+// format returns levels as "price:quantity" pairs
+func format(ls []types.OrderBookLevel) string {
+	pairs := make([]string, len(ls))
+	for i, l := range ls {
+		pairs[i] = l.Price.String() + ":" + l.Quantity.String()
+	}
+	return strings.Join(pairs, " ")
+}
+
+func TestBook_Snapshot(t *testing.T) {
+	b := New()
+	delta := b.Snapshot(levels("99:1", "100:2", "98:3"), levels("102:1", "101:2"), 10)
+	if got := format(delta.Bids); got != "100:2 99:1 98:3" {
+		t.Errorf("first snapshot delta bids = %q, expected the whole side best first", got)
+	}
+
+	bids, asks := b.Top(2)
+	if got := format(bids); got != "100:2 99:1" {
+		t.Errorf("Top(2) bids = %q", got)
+	}
+	if got := format(asks); got != "101:2 102:1" {
+		t.Errorf("Top(2) asks = %q", got)
+	}
+
+	delta = b.Snapshot(levels("100:2", "99:4"), levels("101:2", "102:1", "103:5"), 20)
+	if got := format(delta.Bids); got != "99:4 98:0" {
+		t.Errorf("resync delta bids = %q, expected the changed and removed levels", got)
+	}
+	if got := format(delta.Asks); got != "103:5" {
+		t.Errorf("resync delta asks = %q, expected the added level", got)
+	}
+	if !b.Synced() || b.Seq() != 20 {
+		t.Errorf("Synced() = %v, Seq() = %d after snapshot", b.Synced(), b.Seq())
+	}
+}
+
+func TestBook_Apply(t *testing.T) {
+	b := New()
+	if err := b.Apply(types.OrderBookDelta{}, 0, 1); !errors.Is(err, ErrNotSynced) {
+		t.Fatalf("Apply() before snapshot error = %v, expected ErrNotSynced", err)
+	}
+	b.Snapshot(levels("100:1", "99:1"), levels("101:1", "102:1"), 1)
+
+	delta := types.OrderBookDelta{
+		Bids: levels("100.5:3", "99:0", "100:2"),
+		Asks: levels("101:0", "101.5:1"),
+	}
+	if err := b.Apply(delta, 1, 2); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	bids, asks := b.Top(0)
+	if got := format(bids); got != "100.5:3 100:2" {
+		t.Errorf("bids = %q", got)
+	}
+	if got := format(asks); got != "101.5:1 102:1" {
+		t.Errorf("asks = %q", got)
+	}
+
+	if err := b.Apply(types.OrderBookDelta{Bids: levels("90:1")}, 3, 4); !errors.Is(err, ErrGap) {
+		t.Fatalf("Apply() after a gap error = %v, expected ErrGap", err)
+	}
+	if b.Synced() {
+		t.Error("Synced() after a gap = true")
+	}
+	if err := b.Apply(types.OrderBookDelta{}, 2, 3); !errors.Is(err, ErrNotSynced) {
+		t.Fatalf("Apply() awaiting resync error = %v, expected ErrNotSynced", err)
+	}
+	if bids, _ := b.Top(0); format(bids) != "100.5:3 100:2" {
+		t.Errorf("bids changed by rejected updates: %q", format(bids))
+	}
+}
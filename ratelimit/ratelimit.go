@@ -0,0 +1,204 @@
+// Package ratelimit provides the client-side token-bucket limiter used by the
+// exchange REST clients to stay within each exchange's request limits.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/djpken/go-exc/types"
+)
+
+// ErrExceeded is returned when a request cannot be sent within the limit
+// before its context deadline. It is types.ErrRateLimitExceeded, so callers
+// can test for client-side and exchange rate limits alike.
+var ErrExceeded = types.ErrRateLimitExceeded
+
+// Rule allows Limit request weight per Window
+type Rule struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Headroom reports the state of one bucket
+type Headroom struct {
+	// Key is the endpoint or endpoint group
+	Key string
+
+	// Limit and Window are the configured rule
+	Limit  int
+	Window time.Duration
+
+	// Remaining is the request weight that can be sent immediately
+	Remaining float64
+
+	// BlockedUntil is set while the exchange has signalled the limit is exhausted
+	BlockedUntil time.Time
+}
+
+// Limiter holds one token bucket per endpoint key. Keys without a rule are
+// not limited, so a Limiter created without rules disables limiting.
+// All methods are safe for concurrent use and on a nil Limiter.
+type Limiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+type bucket struct {
+	rule         Rule
+	tokens       float64
+	updated      time.Time
+	blockedUntil time.Time
+}
+
+// New creates a Limiter with one full bucket per rule
+func New(rules map[string]Rule) *Limiter {
+	l := &Limiter{
+		buckets: make(map[string]*bucket, len(rules)),
+		now:     time.Now,
+	}
+	now := l.now()
+	for key, rule := range rules {
+		if rule.Limit <= 0 || rule.Window <= 0 {
+			continue
+		}
+		l.buckets[key] = &bucket{rule: rule, tokens: float64(rule.Limit), updated: now}
+	}
+	return l
+}
+
+// Wait is shorthand for WaitN(ctx, key, 1)
+func (l *Limiter) Wait(ctx context.Context, key string) error {
+	return l.WaitN(ctx, key, 1)
+}
+
+// WaitN blocks until weight n can be sent on key. When ctx has a deadline
+// that would pass before then, it fails fast with an error wrapping
+// ErrExceeded instead of waiting.
+func (l *Limiter) WaitN(ctx context.Context, key string, n int) error {
+	_, err := l.WaitTimed(ctx, key, n)
+	return err
+}
+
+// WaitTimed is WaitN also returning how long it blocked for a free slot
+func (l *Limiter) WaitTimed(ctx context.Context, key string, n int) (time.Duration, error) {
+	if l == nil || n <= 0 {
+		return 0, nil
+	}
+
+	l.mu.Lock()
+	b, ok := l.buckets[key]
+	if !ok {
+		l.mu.Unlock()
+		return 0, nil
+	}
+	if n > b.rule.Limit {
+		l.mu.Unlock()
+		return 0, fmt.Errorf("ratelimit: %s: weight %d above limit %d: %w", key, n, b.rule.Limit, ErrExceeded)
+	}
+
+	now := l.now()
+	b.refill(now)
+	b.tokens -= float64(n)
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate())
+	}
+	if blocked := b.blockedUntil.Sub(now); blocked > delay {
+		delay = blocked
+	}
+	if deadline, ok := ctx.Deadline(); ok && delay > 0 && now.Add(delay).After(deadline) {
+		b.tokens += float64(n)
+		l.mu.Unlock()
+		return 0, fmt.Errorf("ratelimit: %s: next slot in %s is past the deadline: %w", key, delay, ErrExceeded)
+	}
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return 0, nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		b.tokens += float64(n)
+		l.mu.Unlock()
+		return 0, ctx.Err()
+	}
+}
+
+// Sync aligns key with the exchange's own view of the limit, e.g. from
+// rate-limit response headers: at most remaining weight is left, and when
+// none is left the key is blocked for reset (the rule window when reset is 0).
+// Call Sync(key, 0, retryAfter) on an HTTP 429 response.
+func (l *Limiter) Sync(key string, remaining int, reset time.Duration) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.buckets[key]
+	if !ok {
+		return
+	}
+	now := l.now()
+	b.refill(now)
+	if r := float64(remaining); r < b.tokens {
+		b.tokens = r
+	}
+	if remaining <= 0 {
+		if reset <= 0 {
+			reset = b.rule.Window
+		}
+		if until := now.Add(reset); until.After(b.blockedUntil) {
+			b.blockedUntil = until
+		}
+	}
+}
+
+// Headroom returns the current state of every bucket, sorted by key
+func (l *Limiter) Headroom() []Headroom {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	out := make([]Headroom, 0, len(l.buckets))
+	for key, b := range l.buckets {
+		b.refill(now)
+		h := Headroom{Key: key, Limit: b.rule.Limit, Window: b.rule.Window, Remaining: b.tokens}
+		if b.blockedUntil.After(now) {
+			h.BlockedUntil = b.blockedUntil
+		}
+		if h.Remaining < 0 {
+			h.Remaining = 0
+		}
+		out = append(out, h)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// rate returns the refill rate in tokens per nanosecond
+func (b *bucket) rate() float64 {
+	return float64(b.rule.Limit) / float64(b.rule.Window)
+}
+
+// refill adds the tokens accrued since the last update, up to the limit
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.updated); elapsed > 0 {
+		b.tokens += float64(elapsed) * b.rate()
+		if limit := float64(b.rule.Limit); b.tokens > limit {
+			b.tokens = limit
+		}
+		b.updated = now
+	}
+}
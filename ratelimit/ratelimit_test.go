@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLimiter_WaitN(t *testing.T) {
+	l := New(map[string]Rule{"order": {Limit: 2, Window: time.Second}})
+	now := time.Now()
+	l.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	if err := l.WaitN(ctx, "order", 2); err != nil {
+		t.Fatalf("WaitN() within limit error = %v", err)
+	}
+	if err := l.Wait(ctx, "unknown"); err != nil {
+		t.Fatalf("Wait() on unlimited key error = %v", err)
+	}
+
+	// The bucket is empty: a deadline shorter than the refill fails fast
+	short, cancel := context.WithDeadline(ctx, now.Add(100*time.Millisecond))
+	defer cancel()
+	if err := l.Wait(short, "order"); !errors.Is(err, ErrExceeded) {
+		t.Fatalf("Wait() past deadline error = %v, expected ErrExceeded", err)
+	}
+
+	if err := l.WaitN(ctx, "order", 3); !errors.Is(err, ErrExceeded) {
+		t.Fatalf("WaitN() above limit error = %v, expected ErrExceeded", err)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if h := l.Headroom(); len(h) != 1 || h[0].Remaining < 0.99 || h[0].Remaining > 1.01 {
+		t.Fatalf("Headroom() = %+v, expected 1 remaining", h)
+	}
+}
+
+func TestLimiter_Sync(t *testing.T) {
+	l := New(map[string]Rule{"order": {Limit: 10, Window: time.Second}})
+	now := time.Now()
+	l.now = func() time.Time { return now }
+
+	l.Sync("order", 0, 5*time.Second)
+	h := l.Headroom()
+	if h[0].Remaining != 0 || !h[0].BlockedUntil.Equal(now.Add(5*time.Second)) {
+		t.Fatalf("Headroom() after Sync = %+v, expected blocked for 5s", h[0])
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), now.Add(2*time.Second))
+	defer cancel()
+	if err := l.Wait(ctx, "order"); !errors.Is(err, ErrExceeded) {
+		t.Fatalf("Wait() while blocked error = %v, expected ErrExceeded", err)
+	}
+
+	var nilLimiter *Limiter
+	if err := nilLimiter.Wait(ctx, "order"); err != nil {
+		t.Fatalf("nil Limiter Wait() error = %v", err)
+	}
+}
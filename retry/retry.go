@@ -0,0 +1,114 @@
+// Package retry provides the backoff policy used by the exchange REST clients
+// to retry transient failures: HTTP 5xx responses, timeouts and connection resets.
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// Policy configures retries with exponential backoff and jitter
+type Policy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the backoff before the first retry. It doubles with every
+	// further retry, up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// DefaultPolicy is the policy used by the REST clients unless replaced
+var DefaultPolicy = Policy{
+	MaxAttempts: 3,
+	BaseDelay:   250 * time.Millisecond,
+	MaxDelay:    4 * time.Second,
+}
+
+// NoRetry disables retries
+var NoRetry = Policy{MaxAttempts: 1}
+
+// Retry reports whether another attempt is allowed after attempt (1-based)
+func (p Policy) Retry(attempt int) bool {
+	return attempt < p.MaxAttempts
+}
+
+// Backoff returns the delay before retry n (1-based): a random duration
+// between half and all of BaseDelay*2^(n-1), capped at MaxDelay
+func (p Policy) Backoff(n int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < n && (p.MaxDelay <= 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + rand.N(d-half+1)
+}
+
+// Sleep waits for the backoff of retry n, or until ctx is done
+func (p Policy) Sleep(ctx context.Context, n int) error {
+	timer := time.NewTimer(p.Backoff(n))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Transient reports whether a request failed with a transient error: an HTTP
+// 5xx response, a network timeout or a reset connection. Sleep returns the
+// context error once the caller's context is done, which ends the retries.
+func Transient(res *http.Response, err error) bool {
+	if err == nil {
+		return res != nil && res.StatusCode >= http.StatusInternalServerError
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// transientError marks a failure that may succeed when retried
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string { return e.err.Error() }
+
+func (e *transientError) Unwrap() error { return e.err }
+
+// MarkTransient wraps err so that IsTransient reports true for it
+func MarkTransient(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &transientError{err}
+}
+
+// IsTransient reports whether err was marked with MarkTransient
+func IsTransient(err error) bool {
+	var t *transientError
+	return errors.As(err, &t)
+}
@@ -0,0 +1,75 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestPolicy_Backoff(t *testing.T) {
+	p := Policy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		n        int
+		min, max time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 200 * time.Millisecond, 400 * time.Millisecond},
+		{10, 500 * time.Millisecond, time.Second},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 50; i++ {
+			if d := p.Backoff(tt.n); d < tt.min || d > tt.max {
+				t.Fatalf("Backoff(%d) = %s, expected within [%s, %s]", tt.n, d, tt.min, tt.max)
+			}
+		}
+	}
+
+	if !p.Retry(4) || p.Retry(5) {
+		t.Errorf("Retry() does not respect MaxAttempts = %d", p.MaxAttempts)
+	}
+	if NoRetry.Retry(1) {
+		t.Error("NoRetry.Retry(1) = true, expected false")
+	}
+}
+
+func TestTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		res  *http.Response
+		err  error
+		want bool
+	}{
+		{"ok", &http.Response{StatusCode: http.StatusOK}, nil, false},
+		{"bad request", &http.Response{StatusCode: http.StatusBadRequest}, nil, false},
+		{"bad gateway", &http.Response{StatusCode: http.StatusBadGateway}, nil, true},
+		{"connection reset", nil, fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{"unexpected eof", nil, io.ErrUnexpectedEOF, true},
+		{"canceled", nil, context.Canceled, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Transient(tt.res, tt.err); got != tt.want {
+				t.Errorf("Transient() = %v, expected %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkTransient(t *testing.T) {
+	err := MarkTransient(fmt.Errorf("http 502: %w", io.ErrUnexpectedEOF))
+	if !IsTransient(err) || !IsTransient(fmt.Errorf("place order: %w", err)) {
+		t.Errorf("IsTransient(%v) = false, expected true", err)
+	}
+	if IsTransient(io.ErrUnexpectedEOF) {
+		t.Error("IsTransient() of an unmarked error = true, expected false")
+	}
+	if MarkTransient(nil) != nil {
+		t.Error("MarkTransient(nil) != nil")
+	}
+}
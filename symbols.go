@@ -0,0 +1,908 @@
+package exc
+
+import (
+	"context"
+	"sync"
+)
+
+// WithCanonicalSymbols wraps ex so that every unified method accepts and
+// returns canonical symbols ("BTC/USDT", "BTC/USDT:USDT", "BTC/USD:BTC-250328").
+//
+// Symbol arguments are translated with mapper before they reach the exchange;
+// strings that are not canonical are passed through unchanged, so existing
+// callers using exchange-native symbols keep working. Symbols of returned
+// objects are translated back, and the exchange-native form is kept in
+// Extra[NativeSymbolKey]. WebSocket updates are translated the same way.
+//
+// NewExchange already returns wrapped clients; use Unwrap to reach the
+// exchange-specific client underneath.
+func WithCanonicalSymbols(ex Exchange, mapper SymbolMapper) Exchange {
+	return &symbolExchange{
+		Exchange: ex,
+		mapper:   mapper,
+		forwards: make(map[forwardKey]*forwarder),
+		done:     make(chan struct{}),
+	}
+}
+
+// Unwrap returns the exchange-specific client underneath the wrappers added
+// by NewExchange and WithCanonicalSymbols, e.g. *okex.OKExExchange. Clients
+// that are not wrapped are returned unchanged.
+func Unwrap(ex Exchange) Exchange {
+	for {
+		w, ok := ex.(interface{ Unwrap() Exchange })
+		if !ok {
+			return ex
+		}
+		ex = w.Unwrap()
+	}
+}
+
+// symbolExchange is the Exchange returned by WithCanonicalSymbols
+type symbolExchange struct {
+	Exchange
+	mapper SymbolMapper
+
+	// forwards holds the running WebSocket forwarders
+	mu       sync.Mutex
+	forwards map[forwardKey]*forwarder
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// Unwrap returns the wrapped exchange
+func (e *symbolExchange) Unwrap() Exchange {
+	return e.Exchange
+}
+
+// Close stops the WebSocket forwarders and closes the exchange
+func (e *symbolExchange) Close() error {
+	e.closeOnce.Do(func() { close(e.done) })
+	return e.Exchange.Close()
+}
+
+// native translates a canonical symbol to the exchange-native form.
+// Empty and non-canonical symbols are returned unchanged.
+func (e *symbolExchange) native(symbol string) (string, error) {
+	if symbol == "" {
+		return "", nil
+	}
+	sym, err := ParseSymbol(symbol)
+	if err != nil {
+		return symbol, nil
+	}
+	return e.mapper.ToNative(sym)
+}
+
+// natives translates a list of symbols with native
+func (e *symbolExchange) natives(symbols []string) ([]string, error) {
+	if len(symbols) == 0 {
+		return symbols, nil
+	}
+	out := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		native, err := e.native(symbol)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = native
+	}
+	return out, nil
+}
+
+// canonical translates an exchange-native symbol to canonical form.
+// Symbols the mapper does not recognise are returned unchanged.
+func (e *symbolExchange) canonical(native string, instType InstrumentType) string {
+	if native == "" {
+		return ""
+	}
+	sym, err := e.mapper.FromNative(native, instType)
+	if err != nil {
+		return native
+	}
+	return sym.String()
+}
+
+// tag replaces *symbol with its canonical form and records the native form in *extra
+func (e *symbolExchange) tag(symbol *string, extra *map[string]interface{}, instType InstrumentType) {
+	canonical := e.canonical(*symbol, instType)
+	if canonical == *symbol {
+		return
+	}
+	if *extra == nil {
+		*extra = make(map[string]interface{})
+	}
+	(*extra)[NativeSymbolKey] = *symbol
+	*symbol = canonical
+}
+
+func (e *symbolExchange) tagOrder(o *Order) *Order {
+	if o != nil {
+		e.tag(&o.Symbol, &o.Extra, "")
+	}
+	return o
+}
+
+func (e *symbolExchange) tagOrders(orders []*Order) []*Order {
+	for _, o := range orders {
+		e.tagOrder(o)
+	}
+	return orders
+}
+
+func (e *symbolExchange) tagPositions(positions []*Position) []*Position {
+	for _, p := range positions {
+		if p != nil {
+			e.tag(&p.Symbol, &p.Extra, "")
+		}
+	}
+	return positions
+}
+
+func (e *symbolExchange) tagCancelResults(results []*CancelOrderResult) []*CancelOrderResult {
+	for _, r := range results {
+		if r != nil {
+			r.Symbol = e.canonical(r.Symbol, "")
+		}
+	}
+	return results
+}
+
+func (e *symbolExchange) tagCloseResult(r *ClosePositionResult) *ClosePositionResult {
+	if r != nil {
+		r.Symbol = e.canonical(r.Symbol, "")
+		e.tagOrder(r.Order)
+	}
+	return r
+}
+
+func (e *symbolExchange) tagConditionalOrder(o *ConditionalOrder) *ConditionalOrder {
+	if o != nil {
+		e.tag(&o.Symbol, &o.Extra, "")
+	}
+	return o
+}
+
+// ========== Market Data ==========
+
+func (e *symbolExchange) GetTicker(ctx context.Context, symbol string) (*Ticker, error) {
+	native, err := e.native(symbol)
+	if err != nil {
+		return nil, err
+	}
+	ticker, err := e.Exchange.GetTicker(ctx, native)
+	if err != nil {
+		return nil, err
+	}
+	e.tag(&ticker.Symbol, &ticker.Extra, "")
+	return ticker, nil
+}
+
+func (e *symbolExchange) GetTickers(ctx context.Context, req GetTickersRequest) ([]*Ticker, error) {
+	tickers, err := e.Exchange.GetTickers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tickers {
+		if t != nil {
+			e.tag(&t.Symbol, &t.Extra, req.InstrumentType)
+		}
+	}
+	return tickers, nil
+}
+
+func (e *symbolExchange) GetInstruments(ctx context.Context, req GetInstrumentsRequest) ([]*Instrument, error) {
+	instruments, err := e.Exchange.GetInstruments(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, inst := range instruments {
+		if inst != nil {
+			e.tag(&inst.Symbol, &inst.Extra, inst.InstrumentType)
+		}
+	}
+	return instruments, nil
+}
+
+func (e *symbolExchange) GetOrderBook(ctx context.Context, symbol string, depth int) (*OrderBook, error) {
+	native, err := e.native(symbol)
+	if err != nil {
+		return nil, err
+	}
+	book, err := e.Exchange.GetOrderBook(ctx, native, depth)
+	if err != nil {
+		return nil, err
+	}
+	e.tag(&book.Symbol, &book.Extra, "")
+	return book, nil
+}
+
+func (e *symbolExchange) GetCandles(ctx context.Context, req GetCandlesRequest) ([]*Candle, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	candles, err := e.Exchange.GetCandles(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range candles {
+		if c != nil {
+			e.tag(&c.Symbol, &c.Extra, "")
+		}
+	}
+	return candles, nil
+}
+
+func (e *symbolExchange) GetRecentTrades(ctx context.Context, symbol string, limit int) ([]*Trade, error) {
+	native, err := e.native(symbol)
+	if err != nil {
+		return nil, err
+	}
+	trades, err := e.Exchange.GetRecentTrades(ctx, native, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range trades {
+		if t != nil {
+			e.tag(&t.Symbol, &t.Extra, "")
+		}
+	}
+	return trades, nil
+}
+
+func (e *symbolExchange) GetFundingRate(ctx context.Context, symbol string) (*FundingRate, error) {
+	native, err := e.native(symbol)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := e.Exchange.GetFundingRate(ctx, native)
+	if err != nil {
+		return nil, err
+	}
+	e.tag(&rate.Symbol, &rate.Extra, "")
+	return rate, nil
+}
+
+func (e *symbolExchange) GetFundingRateHistory(ctx context.Context, req GetFundingRateHistoryRequest) ([]*FundingRate, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	rates, err := e.Exchange.GetFundingRateHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rates {
+		if r != nil {
+			e.tag(&r.Symbol, &r.Extra, "")
+		}
+	}
+	return rates, nil
+}
+
+func (e *symbolExchange) GetMarkPrice(ctx context.Context, symbol string) (*MarkPrice, error) {
+	native, err := e.native(symbol)
+	if err != nil {
+		return nil, err
+	}
+	price, err := e.Exchange.GetMarkPrice(ctx, native)
+	if err != nil {
+		return nil, err
+	}
+	e.tag(&price.Symbol, &price.Extra, "")
+	return price, nil
+}
+
+// ========== Account ==========
+
+func (e *symbolExchange) GetPositions(ctx context.Context, symbols ...string) ([]*Position, error) {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := e.Exchange.GetPositions(ctx, natives...)
+	if err != nil {
+		return nil, err
+	}
+	return e.tagPositions(positions), nil
+}
+
+func (e *symbolExchange) GetLeverage(ctx context.Context, req GetLeverageRequest) ([]*Leverage, error) {
+	var err error
+	if req.Symbols, err = e.natives(req.Symbols); err != nil {
+		return nil, err
+	}
+	leverages, err := e.Exchange.GetLeverage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range leverages {
+		if l != nil {
+			e.tag(&l.Symbol, &l.Extra, "")
+		}
+	}
+	return leverages, nil
+}
+
+func (e *symbolExchange) SetLeverage(ctx context.Context, req SetLeverageRequest) (*Leverage, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	leverage, err := e.Exchange.SetLeverage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if leverage != nil {
+		e.tag(&leverage.Symbol, &leverage.Extra, "")
+	}
+	return leverage, nil
+}
+
+func (e *symbolExchange) ClosePosition(ctx context.Context, req ClosePositionRequest) (*ClosePositionResult, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	result, err := e.Exchange.ClosePosition(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return e.tagCloseResult(result), nil
+}
+
+func (e *symbolExchange) CloseAllPositions(ctx context.Context) ([]*ClosePositionResult, error) {
+	results, err := e.Exchange.CloseAllPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		e.tagCloseResult(r)
+	}
+	return results, nil
+}
+
+// ========== Trading ==========
+
+func (e *symbolExchange) PlaceOrder(ctx context.Context, req PlaceOrderRequest) (*Order, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	order, err := e.Exchange.PlaceOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return e.tagOrder(order), nil
+}
+
+func (e *symbolExchange) PlaceSingleOrder(ctx context.Context, req PlaceOrderRequest) (*PlaceOrderResult, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return &PlaceOrderResult{Error: err}, nil
+	}
+	result, err := e.Exchange.PlaceSingleOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if result != nil {
+		e.tagOrder(result.Order)
+	}
+	return result, nil
+}
+
+// PlaceMultiOrder translates each request; requests whose symbol cannot be
+// mapped get an error result and the remaining orders are still sent.
+func (e *symbolExchange) PlaceMultiOrder(ctx context.Context, reqs []PlaceOrderRequest) ([]*PlaceOrderResult, error) {
+	results := make([]*PlaceOrderResult, len(reqs))
+	mapped := make([]PlaceOrderRequest, 0, len(reqs))
+	index := make([]int, 0, len(reqs))
+	for i, req := range reqs {
+		native, err := e.native(req.Symbol)
+		if err != nil {
+			results[i] = &PlaceOrderResult{Error: err}
+			continue
+		}
+		req.Symbol = native
+		mapped = append(mapped, req)
+		index = append(index, i)
+	}
+	if len(mapped) == 0 {
+		return results, nil
+	}
+
+	placed, err := e.Exchange.PlaceMultiOrder(ctx, mapped)
+	if err != nil {
+		return nil, err
+	}
+	for j, result := range placed {
+		if j >= len(index) {
+			break
+		}
+		if result != nil {
+			e.tagOrder(result.Order)
+		}
+		results[index[j]] = result
+	}
+	return results, nil
+}
+
+func (e *symbolExchange) CancelOrder(ctx context.Context, req CancelOrderRequest) error {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return err
+	}
+	return e.Exchange.CancelOrder(ctx, req)
+}
+
+func (e *symbolExchange) CancelOrders(ctx context.Context, reqs []CancelOrderRequest) ([]*CancelOrderResult, error) {
+	mapped := make([]CancelOrderRequest, len(reqs))
+	for i, req := range reqs {
+		native, err := e.native(req.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		req.Symbol = native
+		mapped[i] = req
+	}
+	results, err := e.Exchange.CancelOrders(ctx, mapped)
+	if err != nil {
+		return nil, err
+	}
+	return e.tagCancelResults(results), nil
+}
+
+func (e *symbolExchange) CancelAllOrders(ctx context.Context, symbol string, instType InstrumentType) ([]*CancelOrderResult, error) {
+	native, err := e.native(symbol)
+	if err != nil {
+		return nil, err
+	}
+	results, err := e.Exchange.CancelAllOrders(ctx, native, instType)
+	if err != nil {
+		return nil, err
+	}
+	return e.tagCancelResults(results), nil
+}
+
+func (e *symbolExchange) AmendOrder(ctx context.Context, req AmendOrderRequest) (*AmendOrderResult, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	result, err := e.Exchange.AmendOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if result != nil {
+		e.tagOrder(result.Order)
+	}
+	return result, nil
+}
+
+func (e *symbolExchange) GetOrderDetail(ctx context.Context, req GetOrderRequest) (*Order, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	order, err := e.Exchange.GetOrderDetail(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return e.tagOrder(order), nil
+}
+
+func (e *symbolExchange) GetOpenOrders(ctx context.Context, req GetOpenOrdersRequest) ([]*Order, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	orders, err := e.Exchange.GetOpenOrders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return e.tagOrders(orders), nil
+}
+
+func (e *symbolExchange) GetOrderHistory(ctx context.Context, req GetOrderHistoryRequest) ([]*Order, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	orders, err := e.Exchange.GetOrderHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return e.tagOrders(orders), nil
+}
+
+func (e *symbolExchange) GetFills(ctx context.Context, req GetFillsRequest) ([]*Trade, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	fills, err := e.Exchange.GetFills(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fills {
+		if f != nil {
+			e.tag(&f.Symbol, &f.Extra, req.InstType)
+		}
+	}
+	return fills, nil
+}
+
+func (e *symbolExchange) PlaceConditionalOrder(ctx context.Context, req ConditionalOrderRequest) (*ConditionalOrder, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	order, err := e.Exchange.PlaceConditionalOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return e.tagConditionalOrder(order), nil
+}
+
+func (e *symbolExchange) CancelConditionalOrder(ctx context.Context, req CancelConditionalOrderRequest) error {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return err
+	}
+	return e.Exchange.CancelConditionalOrder(ctx, req)
+}
+
+func (e *symbolExchange) GetConditionalOrders(ctx context.Context, req GetConditionalOrdersRequest) ([]*ConditionalOrder, error) {
+	var err error
+	if req.Symbol, err = e.native(req.Symbol); err != nil {
+		return nil, err
+	}
+	orders, err := e.Exchange.GetConditionalOrders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range orders {
+		e.tagConditionalOrder(o)
+	}
+	return orders, nil
+}
+
+// ========== WebSocket ==========
+
+// forwardKey identifies a forwarder: one per stream and caller channel
+type forwardKey struct {
+	stream string
+	ch     any
+}
+
+// forwarder relays one subscription's updates from the channel handed to the
+// exchange to the caller's channel
+type forwarder struct {
+	in      any
+	all     bool            // subscribed without a symbol filter
+	symbols map[string]bool // native symbols still subscribed
+	stop    chan struct{}
+}
+
+// forward returns the channel to hand to the exchange in place of ch for
+// stream. Values received on it are passed through convert and delivered to
+// ch until the symbols are unsubscribed through unforward or the exchange is
+// closed. Subscribing several times with the same ch reuses one forwarder.
+func forward[T any](e *symbolExchange, stream string, ch chan T, symbols []string, convert func(T)) chan T {
+	if ch == nil {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	key := forwardKey{stream: stream, ch: ch}
+	f, ok := e.forwards[key]
+	if !ok {
+		in := make(chan T, cap(ch))
+		f = &forwarder{in: in, symbols: make(map[string]bool), stop: make(chan struct{})}
+		e.forwards[key] = f
+		go func() {
+			for {
+				select {
+				case <-e.done:
+					return
+				case <-f.stop:
+					return
+				case v := <-in:
+					convert(v)
+					select {
+					case ch <- v:
+					case <-e.done:
+						return
+					case <-f.stop:
+						return
+					}
+				}
+			}
+		}()
+	}
+	if len(symbols) == 0 {
+		f.all = true
+	}
+	for _, symbol := range symbols {
+		f.symbols[symbol] = true
+	}
+	return f.in.(chan T)
+}
+
+// unforward stops the forwarders of stream that no longer have a subscribed
+// symbol after symbols are unsubscribed. No symbols unsubscribes everything.
+func (e *symbolExchange) unforward(stream string, symbols []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for key, f := range e.forwards {
+		if key.stream != stream {
+			continue
+		}
+		if len(symbols) == 0 {
+			f.all = false
+			f.symbols = map[string]bool{}
+		}
+		for _, symbol := range symbols {
+			delete(f.symbols, symbol)
+		}
+		if !f.all && len(f.symbols) == 0 {
+			close(f.stop)
+			delete(e.forwards, key)
+		}
+	}
+}
+
+func (e *symbolExchange) SubscribeTickers(ch chan *TickerUpdate, symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	in := forward(e, "tickers", ch, natives, func(u *TickerUpdate) {
+		if u != nil {
+			e.tag(&u.Symbol, &u.Extra, "")
+		}
+	})
+	if err := e.Exchange.SubscribeTickers(in, natives...); err != nil {
+		e.unforward("tickers", natives)
+		return err
+	}
+	return nil
+}
+
+func (e *symbolExchange) UnsubscribeTickers(symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	if err := e.Exchange.UnsubscribeTickers(natives...); err != nil {
+		return err
+	}
+	e.unforward("tickers", natives)
+	return nil
+}
+
+func (e *symbolExchange) SubscribeCandles(ch chan *CandleUpdate, interval string, symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	in := forward(e, "candles:"+interval, ch, natives, func(u *CandleUpdate) {
+		if u != nil {
+			e.tag(&u.Symbol, &u.Extra, "")
+		}
+	})
+	if err := e.Exchange.SubscribeCandles(in, interval, natives...); err != nil {
+		e.unforward("candles:"+interval, natives)
+		return err
+	}
+	return nil
+}
+
+func (e *symbolExchange) UnsubscribeCandles(interval string, symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	if err := e.Exchange.UnsubscribeCandles(interval, natives...); err != nil {
+		return err
+	}
+	e.unforward("candles:"+interval, natives)
+	return nil
+}
+
+func (e *symbolExchange) SubscribeOrderBook(ch chan *OrderBookUpdate, depth int, symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	in := forward(e, "books", ch, natives, func(u *OrderBookUpdate) {
+		if u != nil {
+			e.tag(&u.Symbol, &u.Extra, "")
+		}
+	})
+	if err := e.Exchange.SubscribeOrderBook(in, depth, natives...); err != nil {
+		e.unforward("books", natives)
+		return err
+	}
+	return nil
+}
+
+func (e *symbolExchange) UnsubscribeOrderBook(symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	if err := e.Exchange.UnsubscribeOrderBook(natives...); err != nil {
+		return err
+	}
+	e.unforward("books", natives)
+	return nil
+}
+
+func (e *symbolExchange) SubscribeTrades(ch chan *TradeUpdate, symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	in := forward(e, "trades", ch, natives, func(u *TradeUpdate) {
+		if u != nil {
+			e.tag(&u.Symbol, &u.Extra, "")
+		}
+	})
+	if err := e.Exchange.SubscribeTrades(in, natives...); err != nil {
+		e.unforward("trades", natives)
+		return err
+	}
+	return nil
+}
+
+func (e *symbolExchange) UnsubscribeTrades(symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	if err := e.Exchange.UnsubscribeTrades(natives...); err != nil {
+		return err
+	}
+	e.unforward("trades", natives)
+	return nil
+}
+
+func (e *symbolExchange) SubscribeFundingRate(ch chan *FundingRateUpdate, symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	in := forward(e, "funding-rate", ch, natives, func(u *FundingRateUpdate) {
+		if u != nil {
+			e.tag(&u.Symbol, &u.Extra, "")
+		}
+	})
+	if err := e.Exchange.SubscribeFundingRate(in, natives...); err != nil {
+		e.unforward("funding-rate", natives)
+		return err
+	}
+	return nil
+}
+
+func (e *symbolExchange) UnsubscribeFundingRate(symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	if err := e.Exchange.UnsubscribeFundingRate(natives...); err != nil {
+		return err
+	}
+	e.unforward("funding-rate", natives)
+	return nil
+}
+
+func (e *symbolExchange) SubscribeMarkPrice(ch chan *MarkPriceUpdate, symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	in := forward(e, "mark-price", ch, natives, func(u *MarkPriceUpdate) {
+		if u != nil {
+			e.tag(&u.Symbol, &u.Extra, "")
+		}
+	})
+	if err := e.Exchange.SubscribeMarkPrice(in, natives...); err != nil {
+		e.unforward("mark-price", natives)
+		return err
+	}
+	return nil
+}
+
+func (e *symbolExchange) UnsubscribeMarkPrice(symbols ...string) error {
+	natives, err := e.natives(symbols)
+	if err != nil {
+		return err
+	}
+	if err := e.Exchange.UnsubscribeMarkPrice(natives...); err != nil {
+		return err
+	}
+	e.unforward("mark-price", natives)
+	return nil
+}
+
+func (e *symbolExchange) SubscribeBalanceAndPosition(ch chan *BalanceAndPositionUpdate) error {
+	in := forward(e, "balance-and-position", ch, nil, func(u *BalanceAndPositionUpdate) {
+		if u != nil {
+			e.tagPositions(u.Positions)
+		}
+	})
+	if err := e.Exchange.SubscribeBalanceAndPosition(in); err != nil {
+		e.unforward("balance-and-position", nil)
+		return err
+	}
+	return nil
+}
+
+func (e *symbolExchange) UnsubscribeBalanceAndPosition() error {
+	if err := e.Exchange.UnsubscribeBalanceAndPosition(); err != nil {
+		return err
+	}
+	e.unforward("balance-and-position", nil)
+	return nil
+}
+
+func (e *symbolExchange) SubscribePosition(ch chan *PositionUpdate, req WebSocketSubscribeRequest) error {
+	var err error
+	if req.Symbols, err = e.natives(req.Symbols); err != nil {
+		return err
+	}
+	in := forward(e, "positions:"+string(req.InstrumentType), ch, req.Symbols, func(u *PositionUpdate) {
+		if u != nil {
+			e.tagPositions(u.Positions)
+		}
+	})
+	if err := e.Exchange.SubscribePosition(in, req); err != nil {
+		e.unforward("positions:"+string(req.InstrumentType), req.Symbols)
+		return err
+	}
+	return nil
+}
+
+func (e *symbolExchange) UnsubscribePosition(req WebSocketSubscribeRequest) error {
+	var err error
+	if req.Symbols, err = e.natives(req.Symbols); err != nil {
+		return err
+	}
+	if err := e.Exchange.UnsubscribePosition(req); err != nil {
+		return err
+	}
+	e.unforward("positions:"+string(req.InstrumentType), req.Symbols)
+	return nil
+}
+
+func (e *symbolExchange) SubscribeOrders(ch chan *OrderUpdate, req WebSocketSubscribeRequest) error {
+	var err error
+	if req.Symbols, err = e.natives(req.Symbols); err != nil {
+		return err
+	}
+	in := forward(e, "orders:"+string(req.InstrumentType), ch, req.Symbols, func(u *OrderUpdate) {
+		if u != nil {
+			e.tagOrders(u.Orders)
+		}
+	})
+	if err := e.Exchange.SubscribeOrders(in, req); err != nil {
+		e.unforward("orders:"+string(req.InstrumentType), req.Symbols)
+		return err
+	}
+	return nil
+}
+
+func (e *symbolExchange) UnsubscribeOrders(req WebSocketSubscribeRequest) error {
+	var err error
+	if req.Symbols, err = e.natives(req.Symbols); err != nil {
+		return err
+	}
+	if err := e.Exchange.UnsubscribeOrders(req); err != nil {
+		return err
+	}
+	e.unforward("orders:"+string(req.InstrumentType), req.Symbols)
+	return nil
+}
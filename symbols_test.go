@@ -0,0 +1,98 @@
+package exc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/djpken/go-exc/exchanges/okex"
+)
+
+func TestWithCanonicalSymbols(t *testing.T) {
+	ex := &fakeExchange{instruments: []*Instrument{{Symbol: "BTC-USDT-SWAP", InstrumentType: InstrumentSwap}}}
+	client := WithCanonicalSymbols(ex, okex.NewSymbolMapper())
+	ctx := context.Background()
+
+	instruments, err := client.GetInstruments(ctx, GetInstrumentsRequest{InstrumentType: InstrumentSwap})
+	if err != nil {
+		t.Fatalf("GetInstruments() error = %v", err)
+	}
+	if inst := instruments[0]; inst.Symbol != "BTC/USDT:USDT" || inst.Extra[NativeSymbolKey] != "BTC-USDT-SWAP" {
+		t.Errorf("GetInstruments() = %q (native %v), expected BTC/USDT:USDT (native BTC-USDT-SWAP)", inst.Symbol, inst.Extra[NativeSymbolKey])
+	}
+
+	results, err := client.PlaceMultiOrder(ctx, []PlaceOrderRequest{
+		{Symbol: "BTC/USDT:USDT", Quantity: MustDecimal("1")},
+		{Symbol: "BTC-USDT", Quantity: MustDecimal("1")},
+		{Symbol: "BTC/USDT:BTC", Quantity: MustDecimal("1")},
+	})
+	if err != nil {
+		t.Fatalf("PlaceMultiOrder() error = %v", err)
+	}
+	if len(ex.placed) != 2 || ex.placed[0].Symbol != "BTC-USDT-SWAP" || ex.placed[1].Symbol != "BTC-USDT" {
+		t.Errorf("exchange received %+v, expected BTC-USDT-SWAP and BTC-USDT", ex.placed)
+	}
+	if results[0].Order.Symbol != "BTC/USDT:USDT" || results[1].Order.Symbol != "BTC/USDT" {
+		t.Errorf("PlaceMultiOrder() symbols = %q, %q, expected BTC/USDT:USDT, BTC/USDT", results[0].Order.Symbol, results[1].Order.Symbol)
+	}
+	if results[2].Error == nil {
+		t.Error("PlaceMultiOrder() expected an error for an unsupported settlement currency")
+	}
+}
+
+// tickerExchange hands ticker subscriptions' channels back to the test
+type tickerExchange struct {
+	Exchange
+	in  chan *TickerUpdate
+	err error // returned by SubscribeTickers
+}
+
+func (f *tickerExchange) SubscribeTickers(ch chan *TickerUpdate, symbols ...string) error {
+	f.in = ch
+	return f.err
+}
+
+func (f *tickerExchange) UnsubscribeTickers(symbols ...string) error {
+	return nil
+}
+
+func TestWithCanonicalSymbolsForwarders(t *testing.T) {
+	ex := &tickerExchange{}
+	client := WithCanonicalSymbols(ex, okex.NewSymbolMapper())
+	wrapped := client.(*symbolExchange)
+
+	if Unwrap(client) != ex {
+		t.Errorf("Unwrap() = %T, expected the wrapped exchange", Unwrap(client))
+	}
+
+	ch := make(chan *TickerUpdate, 1)
+	if err := client.SubscribeTickers(ch, "BTC/USDT:USDT", "ETH/USDT:USDT"); err != nil {
+		t.Fatalf("SubscribeTickers() error = %v", err)
+	}
+	ex.in <- &TickerUpdate{Symbol: "BTC-USDT-SWAP"}
+	if u := <-ch; u.Symbol != "BTC/USDT:USDT" {
+		t.Errorf("ticker symbol = %q, expected BTC/USDT:USDT", u.Symbol)
+	}
+
+	if err := client.UnsubscribeTickers("BTC/USDT:USDT"); err != nil {
+		t.Fatalf("UnsubscribeTickers() error = %v", err)
+	}
+	if n := len(wrapped.forwards); n != 1 {
+		t.Fatalf("%d forwarders after a partial unsubscribe, expected 1", n)
+	}
+	if err := client.UnsubscribeTickers("ETH/USDT:USDT"); err != nil {
+		t.Fatalf("UnsubscribeTickers() error = %v", err)
+	}
+	if n := len(wrapped.forwards); n != 0 {
+		t.Errorf("%d forwarders after unsubscribing every symbol, expected 0", n)
+	}
+
+	// A failed subscription leaves no forwarder behind
+	ex.err = errors.New("subscribe failed")
+	if err := client.SubscribeTickers(ch, "BTC/USDT:USDT"); err == nil {
+		t.Fatal("SubscribeTickers() error = nil, expected the exchange error")
+	}
+	if n := len(wrapped.forwards); n != 0 {
+		t.Errorf("%d forwarders after a failed subscribe, expected 0", n)
+	}
+}
@@ -13,20 +13,99 @@ import (
 var (
 	// ErrNotSupported is returned when a feature is not supported by the exchange
 	ErrNotSupported = errors.New("not supported by this exchange")
+
+	// ErrRateLimitExceeded is returned when a request would exceed the exchange's
+	// rate limit before its context deadline, or the exchange rejected it as such
+	ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+	// ErrInvalidSymbol is returned when an invalid symbol is specified
+	ErrInvalidSymbol = errors.New("invalid symbol")
+
+	// ErrInvalidOrder is returned when an invalid order is specified
+	ErrInvalidOrder = errors.New("invalid order")
+
+	// ErrOrderNotFound is returned when an order is not found
+	ErrOrderNotFound = errors.New("order not found")
+
+	// ErrInsufficientBalance is returned when there is insufficient balance
+	ErrInsufficientBalance = errors.New("insufficient balance")
+
+	// ErrAuthentication is returned when the exchange rejects the API credentials
+	// or the request signature
+	ErrAuthentication = errors.New("authentication failed")
+
+	// ErrExchangeUnavailable is returned when the exchange is busy, under
+	// maintenance or failed internally
+	ErrExchangeUnavailable = errors.New("exchange unavailable")
 )
 
 // APIError represents a structured error returned by an exchange's REST API.
-// Use errors.As to extract it and inspect the Code field.
+// Use errors.As to extract it and inspect the Code field, or errors.Is to
+// match the common error it maps to (e.g. ErrInsufficientBalance).
 type APIError struct {
 	Exchange string
 	Code     int
 	Message  string
+
+	// HTTPStatus is the HTTP status code of the response, 0 when not known
+	HTTPStatus int
+
+	// Err is the common error the code maps to, nil for unmapped codes
+	Err error
+
+	// Retryable reports whether the request may succeed when sent again unchanged
+	Retryable bool
 }
 
 func (e *APIError) Error() string {
 	return e.Exchange + " API error: code=" + strconv.Itoa(e.Code) + ", msg=" + e.Message
 }
 
+// Unwrap returns the common error the code maps to
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCode classifies an exchange error code
+type ErrorCode struct {
+	// Err is the common error the code maps to
+	Err error
+
+	// Retryable reports whether the request may succeed when sent again
+	Retryable bool
+}
+
+// NewAPIError creates an APIError classified by codes, an exchange's table of
+// error codes. Unlisted codes are classified by httpStatus: 429 as
+// ErrRateLimitExceeded, 401 and 403 as ErrAuthentication and 5xx as
+// ErrExchangeUnavailable. Rate limit and unavailable errors are retryable.
+func NewAPIError(exchange string, httpStatus, code int, message string, codes map[int]ErrorCode) *APIError {
+	e := &APIError{Exchange: exchange, Code: code, Message: message, HTTPStatus: httpStatus}
+	if c, ok := codes[code]; ok {
+		e.Err, e.Retryable = c.Err, c.Retryable
+		return e
+	}
+	switch {
+	case httpStatus == 429:
+		e.Err, e.Retryable = ErrRateLimitExceeded, true
+	case httpStatus == 401 || httpStatus == 403:
+		e.Err = ErrAuthentication
+	case httpStatus >= 500:
+		e.Err, e.Retryable = ErrExchangeUnavailable, true
+	}
+	return e
+}
+
+// IsRetryable reports whether err is an APIError the exchange classified as
+// retryable, or a client-side rate limit error
+func IsRetryable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Retryable
+	}
+	return errors.Is(err, ErrRateLimitExceeded)
+}
+
 // ZeroDecimal represents a zero value for Decimal type
 var ZeroDecimal = Decimal{decimal.Zero}
 
@@ -352,17 +431,45 @@ func (t Timestamp) UnixMilli() int64 {
 
 // PlaceOrderRequest contains parameters for placing an order
 type PlaceOrderRequest struct {
-	Symbol        string       // Trading symbol (e.g., "BTC-USDT")
+	Symbol        string       // Trading symbol (e.g., "BTC/USDT")
 	Side          OrderSide    // Order side: "buy" or "sell" (trading direction)
 	PosSide       PositionSide // Position side: "long" or "short" (for futures/derivatives, empty for spot)
 	TdMode        MarginMode
 	Type          string  // Order type: "limit", "market", etc.
-	Quantity      float64 // Order quantity
-	Price         float64 // Order price (for limit orders)
+	Quantity      Decimal // Order quantity
+	Price         Decimal // Order price (for limit orders, zero for market orders)
 	ClientOrderID string
+	TimeInForce   TimeInForce            // Time in force (empty = exchange default, normally GTC)
+	ReduceOnly    bool                   // Only reduce an existing position, never open or increase one
+	PostOnly      bool                   // Rest on the book as maker only; same as TimeInForceGTX
+	TakeProfit    *AttachedOrder         // Take-profit leg attached to the order (optional)
+	StopLoss      *AttachedOrder         // Stop-loss leg attached to the order (optional)
 	Extra         map[string]interface{} // Exchange-specific parameters
 }
 
+// NewPlaceOrderRequest builds a PlaceOrderRequest from float64 quantity and
+// price for callers that have not moved to Decimal yet. Each float is converted
+// to the shortest decimal that round-trips, so 0.1 becomes exactly "0.1".
+func NewPlaceOrderRequest(symbol string, side OrderSide, orderType string, quantity, price float64) PlaceOrderRequest {
+	return PlaceOrderRequest{
+		Symbol:   symbol,
+		Side:     side,
+		Type:     orderType,
+		Quantity: NewDecimalFromFloat(quantity),
+		Price:    NewDecimalFromFloat(price),
+	}
+}
+
+// AttachedOrder describes a take-profit or stop-loss leg submitted together
+// with an entry order. The exchange activates it once the entry order fills,
+// so the position is never unprotected. Exchanges that cannot attach a leg as
+// requested return ErrNotSupported.
+type AttachedOrder struct {
+	TriggerPrice     Decimal          // Trigger price (required)
+	OrderPrice       Decimal          // Price of the order placed on trigger (0 = market)
+	TriggerPriceType TriggerPriceType // Price the trigger is evaluated against (empty = last)
+}
+
 // CancelOrderRequest contains parameters for canceling an order
 type CancelOrderRequest struct {
 	Symbol  string
@@ -370,6 +477,25 @@ type CancelOrderRequest struct {
 	Extra   map[string]interface{}
 }
 
+// AmendOrderRequest contains parameters for amending a resting order.
+// The order is identified by OrderID or ClientOrderID; NewQuantity and NewPrice
+// are left unchanged when zero, but at least one of them must be set.
+type AmendOrderRequest struct {
+	Symbol        string
+	OrderID       string
+	ClientOrderID string
+	NewQuantity   Decimal // New total order quantity (0 = unchanged)
+	NewPrice      Decimal // New order price (0 = unchanged)
+
+	// NewClientOrderID is the client order ID of the replacement order on
+	// exchanges that amend by cancel-and-replace (AmendOrderResult.Replaced).
+	// Empty leaves the replacement without one. In-place amends keep the
+	// original client order ID and ignore it.
+	NewClientOrderID string
+
+	Extra map[string]interface{}
+}
+
 // GetOrderRequest contains parameters for getting order details
 type GetOrderRequest struct {
 	Symbol        string
@@ -378,10 +504,108 @@ type GetOrderRequest struct {
 	Extra         map[string]interface{}
 }
 
+// GetOpenOrdersRequest contains parameters for listing working (live or partially filled) orders.
+// Results are returned newest first. To fetch the next page, pass the ID of the
+// last order of the previous page as After.
+type GetOpenOrdersRequest struct {
+	Symbol   string         // Trading pair filter (required by some exchanges)
+	InstType InstrumentType // Instrument type filter (optional)
+	Limit    int            // Maximum number of orders to return (0 = exchange default)
+	After    string         // Cursor: only return orders older than this order ID
+	Extra    map[string]interface{}
+}
+
+// GetOrderHistoryRequest contains parameters for listing completed (filled or canceled) orders.
+// Results are returned newest first. To fetch the next page, pass the ID of the
+// last order of the previous page as After.
+type GetOrderHistoryRequest struct {
+	Symbol    string         // Trading pair filter (required by some exchanges)
+	InstType  InstrumentType // Instrument type filter (optional)
+	StartTime time.Time      // Only return orders created at or after this time (zero = no bound)
+	EndTime   time.Time      // Only return orders created at or before this time (zero = no bound)
+	Limit     int            // Maximum number of orders to return (0 = exchange default)
+	After     string         // Cursor: only return orders older than this order ID
+	Extra     map[string]interface{}
+}
+
+// GetFillsRequest contains parameters for listing own trade executions.
+// All fills in the time window are returned newest first; the adapters page
+// through the exchange's result pages and time-window limits automatically.
+type GetFillsRequest struct {
+	Symbol    string         // Trading pair filter (required by some exchanges)
+	InstType  InstrumentType // Instrument type filter (optional)
+	OrderID   string         // Only return fills of this order (optional)
+	StartTime time.Time      // Start of the time window (zero = exchange default)
+	EndTime   time.Time      // End of the time window (zero = now)
+	Limit     int            // Maximum number of fills to return (0 = no limit)
+	Extra     map[string]interface{}
+}
+
+// ConditionalOrderRequest contains parameters for placing a conditional order.
+// Which price fields apply depends on Type:
+//   - ConditionalOrderTypeTrigger: TriggerPrice, optional OrderPrice
+//   - ConditionalOrderTypeTPSL: TakeProfitTriggerPrice and/or StopLossTriggerPrice,
+//     optional TakeProfitPrice/StopLossPrice
+//   - ConditionalOrderTypeTrailing: CallbackRate, optional ActivationPrice
+//
+// Order prices of zero mean the order is executed at market when triggered.
+type ConditionalOrderRequest struct {
+	Symbol           string
+	Side             OrderSide
+	PosSide          PositionSide // Position side for derivatives (empty for spot / one-way mode)
+	TdMode           MarginMode
+	Type             ConditionalOrderType
+	Quantity         Decimal
+	ClientOrderID    string
+	TriggerPrice     Decimal          // Trigger price (trigger orders)
+	TriggerPriceType TriggerPriceType // Price the triggers are evaluated against (empty = last)
+	OrderPrice       Decimal          // Price of the order placed on trigger (0 = market)
+
+	TakeProfitTriggerPrice Decimal // Take-profit trigger price (0 = no take-profit leg)
+	TakeProfitPrice        Decimal // Take-profit order price (0 = market)
+	StopLossTriggerPrice   Decimal // Stop-loss trigger price (0 = no stop-loss leg)
+	StopLossPrice          Decimal // Stop-loss order price (0 = market)
+
+	CallbackRate    Decimal // Trailing callback rate as a fraction (0.01 = 1%)
+	ActivationPrice Decimal // Trailing activation price (0 = activate immediately)
+
+	ReduceOnly bool
+	Extra      map[string]interface{}
+}
+
+// NewTrailingOrderRequest builds a trailing ConditionalOrderRequest from float64
+// quantity and callback rate for callers that have not moved to Decimal yet.
+// Each float is converted to the shortest decimal that round-trips.
+func NewTrailingOrderRequest(symbol string, side OrderSide, quantity, callbackRate float64) ConditionalOrderRequest {
+	return ConditionalOrderRequest{
+		Symbol:       symbol,
+		Side:         side,
+		Type:         ConditionalOrderTypeTrailing,
+		Quantity:     NewDecimalFromFloat(quantity),
+		CallbackRate: NewDecimalFromFloat(callbackRate),
+	}
+}
+
+// CancelConditionalOrderRequest contains parameters for canceling a conditional order
+type CancelConditionalOrderRequest struct {
+	Symbol  string
+	OrderID string
+	Type    ConditionalOrderType // Type of the order (required by some exchanges to pick the endpoint)
+	Extra   map[string]interface{}
+}
+
+// GetConditionalOrdersRequest contains parameters for listing untriggered conditional orders
+type GetConditionalOrdersRequest struct {
+	Symbol   string               // Trading pair filter (required by some exchanges)
+	InstType InstrumentType       // Instrument type filter (optional)
+	Type     ConditionalOrderType // Conditional order type filter (empty = all)
+	Extra    map[string]interface{}
+}
+
 // WithdrawRequest contains parameters for withdrawal
 type WithdrawRequest struct {
 	Currency string
-	Amount   float64
+	Amount   Decimal
 	Address  string
 	Tag      string
 	Extra    map[string]interface{}
@@ -449,3 +673,24 @@ type GetCandlesRequest struct {
 	// Extra contains exchange-specific parameters
 	Extra map[string]interface{}
 }
+
+// GetFundingRateHistoryRequest contains parameters for querying settled funding rates
+type GetFundingRateHistoryRequest struct {
+	// Symbol is the trading symbol of a perpetual contract
+	Symbol string
+
+	// Limit is the maximum number of funding rates to return
+	// Optional: defaults to exchange-specific default
+	Limit int
+
+	// StartTime is the earliest funding time to return
+	// Optional: not supported by every exchange
+	StartTime *time.Time
+
+	// EndTime is the latest funding time to return
+	// Optional: not supported by every exchange
+	EndTime *time.Time
+
+	// Extra contains exchange-specific parameters
+	Extra map[string]interface{}
+}
@@ -1,6 +1,10 @@
 package types
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
 
 func TestDecimal_IsZero(t *testing.T) {
 	tests := []struct {
@@ -92,3 +96,88 @@ func TestNewDecimal_InvalidInput(t *testing.T) {
 		t.Error("NewDecimal(\"\") expected error, got nil")
 	}
 }
+
+func TestNewPlaceOrderRequest(t *testing.T) {
+	tests := []struct {
+		name             string
+		quantity         float64
+		price            float64
+		expectedQuantity string
+		expectedPrice    string
+	}{
+		{"short decimals", 0.1, 30000.5, "0.1", "30000.5"},
+		{"small quantity", 0.00000123, 0.000456, "0.00000123", "0.000456"},
+		{"market order", 2, 0, "2", "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := NewPlaceOrderRequest("BTC-USDT", OrderSideBuy, "limit", tt.quantity, tt.price)
+			if req.Quantity.String() != tt.expectedQuantity {
+				t.Errorf("Quantity = %v, expected %v", req.Quantity, tt.expectedQuantity)
+			}
+			if req.Price.String() != tt.expectedPrice {
+				t.Errorf("Price = %v, expected %v", req.Price, tt.expectedPrice)
+			}
+		})
+	}
+}
+
+func TestNewTrailingOrderRequest(t *testing.T) {
+	req := NewTrailingOrderRequest("BTC-USDT-SWAP", OrderSideSell, 0.1, 0.015)
+	if req.Type != ConditionalOrderTypeTrailing {
+		t.Errorf("Type = %v, expected %v", req.Type, ConditionalOrderTypeTrailing)
+	}
+	if req.Quantity.String() != "0.1" {
+		t.Errorf("Quantity = %v, expected 0.1", req.Quantity)
+	}
+	if req.CallbackRate.String() != "0.015" {
+		t.Errorf("CallbackRate = %v, expected 0.015", req.CallbackRate)
+	}
+}
+
+func TestNewSetLeverageRequest(t *testing.T) {
+	req := NewSetLeverageRequest("BTC-USDT-SWAP", 10, MarginModeCross)
+	if req.Leverage.String() != "10" {
+		t.Errorf("Leverage = %v, expected 10", req.Leverage)
+	}
+	if req.MarginMode != MarginModeCross {
+		t.Errorf("MarginMode = %v, expected %v", req.MarginMode, MarginModeCross)
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	codes := map[int]ErrorCode{
+		51008: {Err: ErrInsufficientBalance},
+		50011: {Err: ErrRateLimitExceeded, Retryable: true},
+	}
+
+	tests := []struct {
+		name       string
+		httpStatus int
+		code       int
+		want       error
+		retryable  bool
+	}{
+		{"mapped code", 200, 51008, ErrInsufficientBalance, false},
+		{"retryable code", 429, 50011, ErrRateLimitExceeded, true},
+		{"unmapped 429", 429, 1, ErrRateLimitExceeded, true},
+		{"unmapped 401", 401, 1, ErrAuthentication, false},
+		{"unmapped 5xx", 503, 0, ErrExchangeUnavailable, true},
+		{"unmapped code", 200, 1, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := error(NewAPIError("Test", tt.httpStatus, tt.code, "msg", codes))
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("errors.Is(%v, %v) = false", err, tt.want)
+			}
+			if tt.want == nil && errors.Unwrap(err) != nil {
+				t.Errorf("Unwrap(%v) = %v, expected nil", err, errors.Unwrap(err))
+			}
+			if got := IsRetryable(fmt.Errorf("place order: %w", err)); got != tt.retryable {
+				t.Errorf("IsRetryable() = %v, expected %v", got, tt.retryable)
+			}
+		})
+	}
+}
@@ -142,6 +142,47 @@ type OrderBookLevel struct {
 	Quantity Decimal
 }
 
+// OrderBookDelta holds the order book levels changed by one update. A level
+// with a zero quantity was removed.
+type OrderBookDelta struct {
+	// Bids is the list of changed bid levels
+	Bids []OrderBookLevel
+
+	// Asks is the list of changed ask levels
+	Asks []OrderBookLevel
+}
+
+// OrderBookUpdate represents an order book update from WebSocket: the top
+// levels of the local book after applying Delta
+type OrderBookUpdate struct {
+	// Symbol is the trading symbol
+	Symbol string
+
+	// Bids is the list of best bid levels, highest price first
+	Bids []OrderBookLevel
+
+	// Asks is the list of best ask levels, lowest price first
+	Asks []OrderBookLevel
+
+	// Delta holds the levels changed by the update, which may lie deeper than
+	// the delivered levels. For a snapshot it is the difference to the
+	// previous book, the whole book the first time.
+	Delta OrderBookDelta
+
+	// Snapshot indicates the book was rebuilt from a full snapshot, on the
+	// first update and after a resync
+	Snapshot bool
+
+	// Sequence is the exchange sequence number of the update (0 if none)
+	Sequence int64
+
+	// Timestamp is the update time
+	Timestamp Timestamp
+
+	// Extra contains exchange-specific fields
+	Extra map[string]interface{}
+}
+
 // Candle represents a candlestick/kline (for REST API historical data)
 type Candle struct {
 	// Symbol is the trading symbol
@@ -184,6 +225,9 @@ type Trade struct {
 	// ID is the trade ID
 	ID string
 
+	// OrderID is the ID of the order this fill belongs to (own fills only)
+	OrderID string
+
 	// Symbol is the trading symbol
 	Symbol string
 
@@ -196,6 +240,15 @@ type Trade struct {
 	// Quantity is the trade quantity
 	Quantity Decimal
 
+	// Fee is the trading fee paid for this fill; negative values are rebates (own fills only)
+	Fee Decimal
+
+	// FeeCurrency is the fee currency
+	FeeCurrency string
+
+	// IsMaker reports whether the fill provided liquidity (own fills only)
+	IsMaker bool
+
 	// Timestamp is the trade timestamp
 	Timestamp Timestamp
 
@@ -203,6 +256,70 @@ type Trade struct {
 	Extra map[string]interface{}
 }
 
+// TradeUpdate represents a public trade from WebSocket. Side is the aggressor
+// (taker) side.
+type TradeUpdate struct {
+	Trade
+
+	// Missed is the number of trades missed before this one, detected from
+	// non-consecutive trade IDs on exchanges numbering trades sequentially
+	Missed int64
+}
+
+// FundingRate represents the funding rate of a perpetual contract
+type FundingRate struct {
+	// Symbol is the trading symbol
+	Symbol string
+
+	// FundingRate is the rate settled at FundingTime: the rate of the current
+	// period, or the realized rate in funding rate history
+	FundingRate Decimal
+
+	// FundingTime is the settlement time of FundingRate
+	FundingTime Timestamp
+
+	// NextFundingRate is the predicted rate of the period after FundingTime,
+	// zero where the exchange does not publish it
+	NextFundingRate Decimal
+
+	// NextFundingTime is the settlement time after FundingTime, zero if unknown
+	NextFundingTime Timestamp
+
+	// Timestamp is the data timestamp
+	Timestamp Timestamp
+
+	// Extra contains exchange-specific fields
+	Extra map[string]interface{}
+}
+
+// FundingRateUpdate represents a funding rate from WebSocket
+type FundingRateUpdate struct {
+	FundingRate
+}
+
+// MarkPrice represents the mark and index prices of a contract
+type MarkPrice struct {
+	// Symbol is the trading symbol
+	Symbol string
+
+	// MarkPrice is the mark price, used for unrealized PnL and liquidations
+	MarkPrice Decimal
+
+	// IndexPrice is the index price of the underlying, zero where not provided
+	IndexPrice Decimal
+
+	// Timestamp is the data timestamp
+	Timestamp Timestamp
+
+	// Extra contains exchange-specific fields
+	Extra map[string]interface{}
+}
+
+// MarkPriceUpdate represents mark and index prices from WebSocket
+type MarkPriceUpdate struct {
+	MarkPrice
+}
+
 // Kline represents a candlestick
 type Kline struct {
 	// Symbol is the trading symbol
@@ -238,7 +355,7 @@ type Kline struct {
 
 // Instrument represents a trading instrument/symbol
 type Instrument struct {
-	// Symbol is the trading symbol (e.g., "BTC_USDT", "BTC-USDT")
+	// Symbol is the trading symbol (e.g., "BTC/USDT", "BTC/USDT:USDT")
 	Symbol string
 
 	// BaseCurrency is the base currency (e.g., "BTC" in "BTC_USDT")
@@ -259,10 +376,13 @@ type Instrument struct {
 	// MaxOrderSize is the maximum order size
 	MaxOrderSize Decimal
 
-	// PricePrecision is the price decimal precision
+	// MinNotional is the minimum order value in the quote currency (zero if unknown)
+	MinNotional Decimal
+
+	// PricePrecision is the price tick size (e.g., 0.01)
 	PricePrecision Decimal
 
-	// QuantityPrecision is the quantity decimal precision
+	// QuantityPrecision is the quantity lot size (e.g., 0.001)
 	QuantityPrecision Decimal
 
 	// LastPrice is the last trade price
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // Order represents a trading order
 type Order struct {
 	ClientOrderID string
@@ -30,7 +32,18 @@ type Order struct {
 	// RemainingQuantity is the remaining quantity
 	RemainingQuantity Decimal
 
-	// Fee is the trading fee
+	// LastFillPrice is the price of the latest fill (order updates only)
+	LastFillPrice Decimal
+
+	// LastFillQuantity is the quantity of the latest fill (order updates only)
+	LastFillQuantity Decimal
+
+	// ReduceOnly reports whether the order can only reduce a position
+	ReduceOnly bool
+
+	// Fee is the trading fee paid, accumulated over all fills of the order.
+	// It is positive for a fee charged and negative for a rebate, whichever
+	// exchange or source (REST or stream) the order comes from.
 	Fee Decimal
 
 	// FeeCurrency is the fee currency
@@ -55,6 +68,159 @@ type PlaceOrderResult struct {
 	Error error
 }
 
+// CancelOrderResult holds the outcome of a single cancellation in a batch operation.
+// Used with CancelOrders and CancelAllOrders to report per-order success or failure independently.
+type CancelOrderResult struct {
+	// Symbol is the trading symbol of the order.
+	Symbol string
+	// OrderID is the ID of the order the cancellation was requested for.
+	OrderID string
+	// ClientOrderID is the client order ID, when known.
+	ClientOrderID string
+	// Error is non-nil when the exchange failed to cancel this specific order.
+	Error error
+}
+
+// AmendOrderResult holds the outcome of an AmendOrder call.
+type AmendOrderResult struct {
+	// Order reflects the order after the amendment. When Replaced is true this
+	// is the newly placed order and carries a new order ID.
+	Order *Order
+	// Replaced is false when the exchange amended the order in place (queue
+	// priority kept where the venue allows it) and true when the amendment was
+	// carried out as a cancel followed by a new order.
+	Replaced bool
+	// OriginalOrderID is the ID of the order that was amended or replaced.
+	OriginalOrderID string
+}
+
+// ConditionalOrder represents a conditional (algo) order that rests on the
+// exchange until its trigger condition is met.
+type ConditionalOrder struct {
+	// ID is the conditional order ID (algo ID on OKEx)
+	ID string
+
+	// ClientOrderID is the client-assigned ID, when supported
+	ClientOrderID string
+
+	// Symbol is the trading symbol
+	Symbol string
+
+	// Type is the kind of conditional order
+	Type ConditionalOrderType
+
+	// Side is the order side (buy/sell)
+	Side string
+
+	// PosSide is the position side (long/short/net) for derivatives
+	PosSide PositionSide
+
+	// Status is the order status; OrderStatusTriggered once the trigger fired
+	Status OrderStatus
+
+	// Quantity is the order quantity
+	Quantity Decimal
+
+	// TriggerPrice is the trigger price of trigger orders
+	TriggerPrice Decimal
+
+	// TriggerPriceType is the price the trigger is evaluated against
+	TriggerPriceType TriggerPriceType
+
+	// OrderPrice is the price of the order placed on trigger (zero = market)
+	OrderPrice Decimal
+
+	// TakeProfitTriggerPrice and TakeProfitPrice describe the take-profit leg
+	TakeProfitTriggerPrice Decimal
+	TakeProfitPrice        Decimal
+
+	// StopLossTriggerPrice and StopLossPrice describe the stop-loss leg
+	StopLossTriggerPrice Decimal
+	StopLossPrice        Decimal
+
+	// CallbackRate is the trailing callback rate as a fraction (0.01 = 1%)
+	CallbackRate Decimal
+
+	// ActivationPrice is the trailing stop activation price
+	ActivationPrice Decimal
+
+	// CreatedAt is the creation time
+	CreatedAt Timestamp
+
+	// Extra contains exchange-specific fields
+	Extra map[string]interface{}
+}
+
+// ConditionalOrderFromRequest builds the ConditionalOrder echoed back after a
+// successful placement, for exchanges whose placement response only carries
+// the order ID. Status is OrderStatusLive.
+func ConditionalOrderFromRequest(req ConditionalOrderRequest, id string) *ConditionalOrder {
+	return &ConditionalOrder{
+		ID:                     id,
+		ClientOrderID:          req.ClientOrderID,
+		Symbol:                 req.Symbol,
+		Type:                   req.Type,
+		Side:                   string(req.Side),
+		PosSide:                req.PosSide,
+		Status:                 OrderStatusLive,
+		Quantity:               req.Quantity,
+		TriggerPrice:           req.TriggerPrice,
+		TriggerPriceType:       req.TriggerPriceType,
+		OrderPrice:             req.OrderPrice,
+		TakeProfitTriggerPrice: req.TakeProfitTriggerPrice,
+		TakeProfitPrice:        req.TakeProfitPrice,
+		StopLossTriggerPrice:   req.StopLossTriggerPrice,
+		StopLossPrice:          req.StopLossPrice,
+		CallbackRate:           req.CallbackRate,
+		ActivationPrice:        req.ActivationPrice,
+		Extra:                  map[string]interface{}{},
+	}
+}
+
+// PaginateOrders applies cursor pagination to a list of orders sorted newest
+// first. Orders up to and including the one with ID after are dropped and the
+// result is truncated to limit (0 = no limit). If after is not found, the
+// list is only truncated. Exchange adapters use this where the venue has no
+// native cursor.
+func PaginateOrders(orders []*Order, after string, limit int) []*Order {
+	if after != "" {
+		for i, o := range orders {
+			if o != nil && o.ID == after {
+				orders = orders[i+1:]
+				break
+			}
+		}
+	}
+	if limit > 0 && len(orders) > limit {
+		orders = orders[:limit]
+	}
+	return orders
+}
+
+// OrderTimeInForce returns the effective time in force of req, folding PostOnly
+// into TimeInForceGTX. It is empty when neither TimeInForce nor PostOnly is set.
+// Contradictory combinations, such as a post-only IOC order or a post-only
+// market order, are rejected.
+func OrderTimeInForce(req PlaceOrderRequest) (TimeInForce, error) {
+	tif := req.TimeInForce
+	switch tif {
+	case "", TimeInForceGTC, TimeInForceIOC, TimeInForceFOK, TimeInForceGTX:
+	default:
+		return "", fmt.Errorf("unknown time in force %q", tif)
+	}
+
+	if req.PostOnly {
+		if tif == TimeInForceIOC || tif == TimeInForceFOK {
+			return "", fmt.Errorf("post-only order cannot use time in force %s", tif)
+		}
+		tif = TimeInForceGTX
+	}
+	if tif == TimeInForceGTX && OrderType(req.Type) == OrderTypeMarket {
+		return "", fmt.Errorf("market order cannot be post-only")
+	}
+	return tif, nil
+}
+
 // ===========================================
 // 共用常數（Common Constants）
 // ===========================================
@@ -80,6 +246,16 @@ const (
 	OrderTypeOptimalLimitIOC OrderType = "optimal_limit_ioc" // 最優限價IOC
 )
 
+// TimeInForce 訂單有效方式
+type TimeInForce string
+
+const (
+	TimeInForceGTC TimeInForce = "gtc" // Good Till Cancel：撤單前一直有效
+	TimeInForceIOC TimeInForce = "ioc" // Immediate or Cancel：立即成交，剩餘撤銷
+	TimeInForceFOK TimeInForce = "fok" // Fill or Kill：全部成交或全部撤銷
+	TimeInForceGTX TimeInForce = "gtx" // Good Till Crossing：只做 Maker，會吃單則撤銷
+)
+
 // OrderStatus 訂單狀態
 type OrderStatus string
 
@@ -94,4 +270,23 @@ const (
 	OrderStatusRejected        OrderStatus = "rejected"         // 已拒絕
 	OrderStatusExpired         OrderStatus = "expired"          // 已過期
 	OrderStatusLive            OrderStatus = "live"             // 活躍
+	OrderStatusTriggered       OrderStatus = "triggered"        // 已觸發（條件單）
+)
+
+// ConditionalOrderType 條件單類型
+type ConditionalOrderType string
+
+const (
+	ConditionalOrderTypeTrigger  ConditionalOrderType = "trigger"  // 觸發單：價格觸及 TriggerPrice 後下單
+	ConditionalOrderTypeTPSL     ConditionalOrderType = "tpsl"     // 止盈止損單
+	ConditionalOrderTypeTrailing ConditionalOrderType = "trailing" // 追蹤止損單
+)
+
+// TriggerPriceType 觸發價格類型
+type TriggerPriceType string
+
+const (
+	TriggerPriceTypeLast  TriggerPriceType = "last"  // 最新成交價
+	TriggerPriceTypeMark  TriggerPriceType = "mark"  // 標記價格
+	TriggerPriceTypeIndex TriggerPriceType = "index" // 指數價格
 )
@@ -0,0 +1,65 @@
+package types
+
+import "testing"
+
+func TestPaginateOrders(t *testing.T) {
+	orders := []*Order{{ID: "5"}, {ID: "4"}, {ID: "3"}, {ID: "2"}, {ID: "1"}}
+
+	tests := []struct {
+		name     string
+		after    string
+		limit    int
+		expected []string
+	}{
+		{"no cursor no limit", "", 0, []string{"5", "4", "3", "2", "1"}},
+		{"limit only", "", 2, []string{"5", "4"}},
+		{"cursor only", "4", 0, []string{"3", "2", "1"}},
+		{"cursor and limit", "4", 2, []string{"3", "2"}},
+		{"cursor is last", "1", 0, []string{}},
+		{"cursor not found", "9", 3, []string{"5", "4", "3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := PaginateOrders(orders, tt.after, tt.limit)
+			if len(result) != len(tt.expected) {
+				t.Fatalf("PaginateOrders(%q, %d) returned %d orders, expected %d", tt.after, tt.limit, len(result), len(tt.expected))
+			}
+			for i, o := range result {
+				if o.ID != tt.expected[i] {
+					t.Errorf("PaginateOrders(%q, %d)[%d] = %s, expected %s", tt.after, tt.limit, i, o.ID, tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestOrderTimeInForce(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      PlaceOrderRequest
+		expected TimeInForce
+		wantErr  bool
+	}{
+		{"unset", PlaceOrderRequest{Type: "limit"}, "", false},
+		{"explicit ioc", PlaceOrderRequest{Type: "limit", TimeInForce: TimeInForceIOC}, TimeInForceIOC, false},
+		{"post only", PlaceOrderRequest{Type: "limit", PostOnly: true}, TimeInForceGTX, false},
+		{"post only with gtc", PlaceOrderRequest{Type: "limit", PostOnly: true, TimeInForce: TimeInForceGTC}, TimeInForceGTX, false},
+		{"post only with fok", PlaceOrderRequest{Type: "limit", PostOnly: true, TimeInForce: TimeInForceFOK}, "", true},
+		{"post only market", PlaceOrderRequest{Type: "market", PostOnly: true}, "", true},
+		{"gtx market", PlaceOrderRequest{Type: "market", TimeInForce: TimeInForceGTX}, "", true},
+		{"unknown", PlaceOrderRequest{Type: "limit", TimeInForce: "day"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := OrderTimeInForce(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OrderTimeInForce() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if result != tt.expected {
+				t.Errorf("OrderTimeInForce() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
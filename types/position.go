@@ -124,8 +124,8 @@ type SetLeverageRequest struct {
 	// Currency is the margin currency (optional, either Symbol or Currency must be provided)
 	Currency string
 
-	// Leverage is the leverage multiplier
-	Leverage int
+	// Leverage is the leverage multiplier, fractional where the exchange allows it (e.g. 2.5)
+	Leverage Decimal
 
 	// MarginMode is the margin mode (cross/isolated)
 	// Use MarginModeCross or MarginModeIsolated constants
@@ -139,6 +139,16 @@ type SetLeverageRequest struct {
 	Extra map[string]interface{}
 }
 
+// NewSetLeverageRequest builds a SetLeverageRequest from an integer leverage
+// for callers that have not moved to Decimal yet
+func NewSetLeverageRequest(symbol string, leverage int, marginMode MarginMode) SetLeverageRequest {
+	return SetLeverageRequest{
+		Symbol:     symbol,
+		Leverage:   NewDecimalFromInt(int64(leverage)),
+		MarginMode: marginMode,
+	}
+}
+
 // GetLeverageRequest contains parameters for getting leverage
 type GetLeverageRequest struct {
 	// Symbols is the list of trading symbols
@@ -151,3 +161,38 @@ type GetLeverageRequest struct {
 	// Extra contains exchange-specific parameters
 	Extra map[string]interface{}
 }
+
+// ClosePositionRequest contains parameters for closing a position
+type ClosePositionRequest struct {
+	// Symbol is the trading symbol
+	Symbol string
+
+	// PosSide is the side of the position to close
+	// Use PositionSideLong, PositionSideShort in hedge mode, or PositionSideNet (or empty) in one-way mode
+	PosSide PositionSide
+
+	// MarginMode is the margin mode of the position (optional, looked up from the position when empty)
+	MarginMode MarginMode
+
+	// Quantity is the amount to close (optional, 0 = close the whole position)
+	Quantity Decimal
+
+	// Extra contains exchange-specific parameters
+	Extra map[string]interface{}
+}
+
+// ClosePositionResult represents the result of closing one position
+type ClosePositionResult struct {
+	// Symbol is the trading symbol
+	Symbol string
+
+	// PosSide is the side of the closed position
+	PosSide PositionSide
+
+	// Order is the reduce-only order that closed the position
+	// Nil when the exchange closed the position natively without returning an order
+	Order *Order
+
+	// Error is set when closing this position failed
+	Error error
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Symbol is an exchange-independent instrument identifier.
+//
+// Its canonical string form is:
+//
+//	BTC/USDT                spot
+//	BTC/USDT:USDT           perpetual swap settled in USDT
+//	BTC/USD:BTC             inverse perpetual swap settled in BTC
+//	BTC/USDT:USDT-250328    dated futures expiring on 2025-03-28
+//
+// Each exchange translates canonical symbols with its SymbolMapper; the
+// exchange-native form is kept in Extra["native_symbol"] of returned objects.
+type Symbol struct {
+	// Base is the base currency (e.g., "BTC")
+	Base string
+
+	// Quote is the quote currency (e.g., "USDT")
+	Quote string
+
+	// Settle is the settlement currency of derivatives (empty for spot)
+	Settle string
+
+	// InstType is the instrument type (spot, swap or futures)
+	InstType InstrumentType
+
+	// Expiry is the delivery date of dated futures as YYMMDD (empty otherwise)
+	Expiry string
+}
+
+// NativeSymbolKey is the Extra key holding the exchange-native symbol
+const NativeSymbolKey = "native_symbol"
+
+// String returns the canonical form of the symbol
+func (s Symbol) String() string {
+	out := s.Base + "/" + s.Quote
+	switch s.InstType {
+	case InstrumentSwap:
+		out += ":" + s.Settle
+	case InstrumentFutures:
+		out += ":" + s.Settle + "-" + s.Expiry
+	}
+	return out
+}
+
+// ParseSymbol parses a canonical symbol string. A symbol without a settlement
+// part is spot, one with a settlement part is a swap, and one whose settlement
+// part carries an expiry is futures. Strings that are not canonical, such as
+// exchange-native symbols, return an error.
+func ParseSymbol(s string) (Symbol, error) {
+	pair, settle, derivative := strings.Cut(s, ":")
+	base, quote, ok := strings.Cut(pair, "/")
+	if !ok || base == "" || quote == "" || strings.ContainsAny(quote, "/") {
+		return Symbol{}, fmt.Errorf("not a canonical symbol: %q", s)
+	}
+
+	sym := Symbol{Base: base, Quote: quote, InstType: InstrumentSpot}
+	if !derivative {
+		return sym, nil
+	}
+
+	settle, expiry, dated := strings.Cut(settle, "-")
+	if settle == "" || (dated && !isExpiry(expiry)) {
+		return Symbol{}, fmt.Errorf("not a canonical symbol: %q", s)
+	}
+	sym.Settle = settle
+	sym.InstType = InstrumentSwap
+	if dated {
+		sym.InstType = InstrumentFutures
+		sym.Expiry = expiry
+	}
+	return sym, nil
+}
+
+// isExpiry reports whether s is a YYMMDD date
+func isExpiry(s string) bool {
+	if len(s) != 6 {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// SymbolMapper translates between canonical symbols and an exchange's native
+// symbol strings
+type SymbolMapper interface {
+	// ToNative converts a canonical symbol to the exchange-native form.
+	// Returns an error wrapping ErrNotSupported for markets the exchange does not list.
+	ToNative(sym Symbol) (string, error)
+
+	// FromNative converts an exchange-native symbol to a canonical symbol.
+	// instType disambiguates native forms shared by several markets (may be empty).
+	FromNative(native string, instType InstrumentType) (Symbol, error)
+}
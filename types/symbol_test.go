@@ -0,0 +1,36 @@
+package types
+
+import "testing"
+
+func TestParseSymbol(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Symbol
+		wantErr  bool
+	}{
+		{"BTC/USDT", Symbol{Base: "BTC", Quote: "USDT", InstType: InstrumentSpot}, false},
+		{"BTC/USDT:USDT", Symbol{Base: "BTC", Quote: "USDT", Settle: "USDT", InstType: InstrumentSwap}, false},
+		{"BTC/USD:BTC", Symbol{Base: "BTC", Quote: "USD", Settle: "BTC", InstType: InstrumentSwap}, false},
+		{"BTC/USD:BTC-250328", Symbol{Base: "BTC", Quote: "USD", Settle: "BTC", InstType: InstrumentFutures, Expiry: "250328"}, false},
+		{"BTC-USDT", Symbol{}, true},
+		{"BTC_USDT", Symbol{}, true},
+		{"BTC/", Symbol{}, true},
+		{"BTC/USDT:", Symbol{}, true},
+		{"BTC/USD:BTC-2503", Symbol{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseSymbol(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSymbol(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseSymbol(%q) = %+v, expected %+v", tt.input, result, tt.expected)
+			}
+			if err == nil && result.String() != tt.input {
+				t.Errorf("ParseSymbol(%q).String() = %q, expected round trip", tt.input, result.String())
+			}
+		})
+	}
+}